@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/admin"
+	"github.com/spf13/cobra"
+)
+
+const defaultAdminAddress = ":9091"
+
+func adminCmd() *cobra.Command {
+	var adminURL, token, network string
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Control a running ingestion through its admin API",
+	}
+	cmd.PersistentFlags().StringVar(&adminURL, "admin-url", envOr("ADMIN_URL", "http://localhost"+defaultAdminAddress), "URL of the admin API of the ingestion (defaults to $ADMIN_URL)")
+	cmd.PersistentFlags().StringVar(&token, "admin-token", os.Getenv("ADMIN_TOKEN"), "Token of the operator (defaults to $ADMIN_TOKEN)")
+
+	// run calls the admin API with the client and prints the networks the action applied to.
+	run := func(cmd *cobra.Command, call func(context.Context, *admin.Client) (admin.Response, error)) error {
+		client, err := admin.NewClient(adminURL, token)
+		if err != nil {
+			return err
+		}
+		resp, err := call(cmd.Context(), client)
+		if err != nil {
+			return err
+		}
+		if resp.Level != "" {
+			fmt.Printf("Log level set to %s\n", resp.Level)
+			return nil
+		}
+		fmt.Printf("Applied %s to %s\n", cmd.Name(), strings.Join(resp.Networks, ", "))
+		return nil
+	}
+	networkFlag := func(c *cobra.Command, usage string) {
+		c.Flags().StringVar(&network, "network", "", usage)
+	}
+
+	pause := &cobra.Command{
+		Use:   "pause",
+		Short: "Pause the ingestion once the ledger in flight is written",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.Pause(ctx, network)
+			})
+		},
+	}
+	networkFlag(pause, "Network to pause. Empty pauses every network")
+
+	resume := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume a paused or drained ingestion",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.Resume(ctx, network)
+			})
+		},
+	}
+	networkFlag(resume, "Network to resume. Empty resumes every network")
+
+	drain := &cobra.Command{
+		Use:   "drain",
+		Short: "Pause the ingestion and flush the sinks, until resume",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.Drain(ctx, network)
+			})
+		},
+	}
+	networkFlag(drain, "Network to drain. Empty drains every network")
+
+	var ledger uint32
+	rewind := &cobra.Command{
+		Use:   "rewind",
+		Short: "Make the live ingestion continue from an earlier ledger",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.Rewind(ctx, network, ledger)
+			})
+		},
+	}
+	networkFlag(rewind, "Network to rewind, required when several networks are ingested")
+	rewind.Flags().Uint32Var(&ledger, "ledger", 0, "Ledger the ingestion continues from")
+	_ = rewind.MarkFlagRequired("ledger")
+
+	var start, end uint32
+	backfill := &cobra.Command{
+		Use:   "backfill",
+		Short: "Ingest a range of ledgers, then resume the live ingestion",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.Backfill(ctx, network, start, end)
+			})
+		},
+	}
+	networkFlag(backfill, "Network to backfill, required when several networks are ingested")
+	backfill.Flags().Uint32Var(&start, "start", 0, "First ledger of the range")
+	backfill.Flags().Uint32Var(&end, "end", 0, "Last ledger of the range")
+	_ = backfill.MarkFlagRequired("start")
+	_ = backfill.MarkFlagRequired("end")
+
+	logLevel := &cobra.Command{
+		Use:   "log-level <level>",
+		Short: "Change the log level of the ingestion: trace, debug, info, warn or error",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, func(ctx context.Context, client *admin.Client) (admin.Response, error) {
+				return client.SetLogLevel(ctx, args[0])
+			})
+		},
+	}
+
+	actions := &cobra.Command{
+		Use:   "actions",
+		Short: "List the recent admin actions, the latest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := admin.NewClient(adminURL, token)
+			if err != nil {
+				return err
+			}
+			recent, err := client.Actions(cmd.Context())
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "AT\tOPERATOR\tACTION\tNETWORK\tSTATUS\tERROR")
+			for _, action := range recent {
+				operator, network := action.Operator, action.Request.Network
+				if operator == "" {
+					operator = "-"
+				}
+				if network == "" {
+					network = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", action.At.Format(time.RFC3339), operator,
+					action.Action, network, action.Status, action.Error)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.AddCommand(pause, resume, drain, rewind, backfill, logLevel, actions)
+	return cmd
+}
+
+// envOr returns the value of the environment variable key, or fallback when it is unset.
+func envOr(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/spf13/cobra"
+)
+
+func apiKeyCmd() *cobra.Command {
+	var databaseURL string
+	cmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "Manage the API keys of the query server",
+	}
+	cmd.PersistentFlags().StringVar(&databaseURL, "database-url", os.Getenv("DATABASE_URL"), "Postgres connection string (defaults to $DATABASE_URL)")
+
+	var (
+		name      string
+		platforms []string
+		rateLimit float64
+		burst     int
+	)
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API key, printed once",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withKeyStore(cmd.Context(), databaseURL, func(ctx context.Context, store *pgstore.Store) error {
+				plaintext, key, err := auth.CreateKey(ctx, store, name, platforms, rateLimit, burst)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Created API key %s (%s)\n\n  %s\n\nStore it now, it can't be shown again.\n", key.ID, key.Name, plaintext)
+				return nil
+			})
+		},
+	}
+	create.Flags().StringVar(&name, "name", "", "Name of the key, e.g. the partner it is given to")
+	create.Flags().StringSliceVar(&platforms, "platform-address", nil, "Platform address the key is scoped to (repeatable). Unscoped keys read everything")
+	create.Flags().Float64Var(&rateLimit, "rate-limit", auth.DefaultRateLimit, "Requests per second")
+	create.Flags().IntVar(&burst, "burst", auth.DefaultBurst, "Burst of requests")
+	_ = create.MarkFlagRequired("name")
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the API keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withKeyStore(cmd.Context(), databaseURL, func(ctx context.Context, store *pgstore.Store) error {
+				keys, err := store.ListAPIKeys(ctx)
+				if err != nil {
+					return err
+				}
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "ID\tNAME\tPLATFORMS\tRATE LIMIT\tBURST\tCREATED\tREVOKED")
+				for _, key := range keys {
+					platforms, revoked := "*", "-"
+					if len(key.PlatformAddresses) > 0 {
+						platforms = strings.Join(key.PlatformAddresses, ",")
+					}
+					if key.RevokedAt != nil {
+						revoked = key.RevokedAt.Format(time.RFC3339)
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%g/s\t%d\t%s\t%s\n", key.ID, key.Name, platforms,
+						key.RateLimit, key.Burst, key.CreatedAt.Format(time.RFC3339), revoked)
+				}
+				return w.Flush()
+			})
+		},
+	}
+
+	revoke := &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke an API key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withKeyStore(cmd.Context(), databaseURL, func(ctx context.Context, store *pgstore.Store) error {
+				if err := store.RevokeAPIKey(ctx, args[0]); err != nil {
+					return err
+				}
+				fmt.Printf("Revoked API key %s. Servers stop accepting it within a minute.\n", args[0])
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(create, list, revoke)
+	return cmd
+}
+
+// withKeyStore opens the database, applying the migrations so keys can be managed before the
+// postgres sink ever ran, and calls fn.
+func withKeyStore(ctx context.Context, databaseURL string, fn func(context.Context, *pgstore.Store) error) error {
+	if databaseURL == "" {
+		return errors.New("database URL is required")
+	}
+	store, err := pgstore.Open(ctx, databaseURL)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	if err := store.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrating database: %w", err)
+	}
+	return fn(ctx, store)
+}
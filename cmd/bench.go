@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Trustless-Work/Indexer/internal/bench"
+	"github.com/spf13/cobra"
+	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
+)
+
+func benchCmd() *cobra.Command {
+	var (
+		dir     string
+		network string
+		cfg     bench.Config
+	)
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Replay the ledgers of a local directory through the indexer and report its throughput",
+		Long: `Replay the ledgers of a local directory through the indexer, without any sink, and report
+the ledgers per second, the allocations per transaction and the cost of the buffer merges.
+
+The directory holds *.xdr files with a LedgerCloseMeta, or *.xdr.zst files with a compressed
+LedgerCloseMetaBatch such as the files of a ledger exporter bucket or of "indexer bench record".`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			passphrase, ok := networkPassphrases[network]
+			if !ok {
+				return fmt.Errorf("unknown network %q, expected testnet, pubnet or futurenet", network)
+			}
+			cfg.Network, cfg.NetworkPassphrase = network, passphrase
+
+			ledgers, err := bench.LoadLedgers(dir)
+			if err != nil {
+				return err
+			}
+			report, err := bench.Run(cmd.Context(), cfg, ledgers)
+			if err != nil {
+				return err
+			}
+			if err := report.Write(os.Stdout); err != nil {
+				return err
+			}
+			for _, profile := range []string{cfg.CPUProfile, cfg.HeapProfile} {
+				if profile != "" {
+					fmt.Printf("\nInspect %s with: go tool pprof -http=:8080 %s\n", profile, profile)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&dir, "dir", "", "Directory of the recorded ledgers")
+	cmd.Flags().StringVar(&network, "network", "testnet", "Network the ledgers were recorded on: testnet, pubnet or futurenet")
+	cmd.Flags().IntVar(&cfg.Iterations, "iterations", 1, "Times the ledgers are replayed")
+	cmd.Flags().IntVar(&cfg.Workers, "workers", 0, "Workers processing the transactions of a ledger. 0 is unbounded, like the ingestion")
+	cmd.Flags().IntVar(&cfg.BatchSize, "batch-size", 50, "Ledgers merged into a batch, like the backfill")
+	cmd.Flags().BoolVar(&cfg.SkipTxMeta, "skip-tx-meta", false, "Skip the transaction meta (meta_xdr)")
+	cmd.Flags().BoolVar(&cfg.SkipTxEnvelope, "skip-tx-envelope", false, "Skip the transaction envelope")
+	cmd.Flags().StringVar(&cfg.CPUProfile, "cpu-profile", "", "File the CPU profile of the replay is written to")
+	cmd.Flags().StringVar(&cfg.HeapProfile, "mem-profile", "", "File the heap profile at the end of the replay is written to")
+	_ = cmd.MarkFlagRequired("dir")
+	cmd.AddCommand(benchRecordCmd())
+	return cmd
+}
+
+func benchRecordCmd() *cobra.Command {
+	var (
+		rpcURL string
+		dir    string
+		start  uint32
+		count  uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "record",
+		Short: "Fetch ledgers from an RPC server into a directory replayed by bench",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			backend := ledgerbackend.NewRPCLedgerBackend(ledgerbackend.RPCLedgerBackendOptions{
+				RPCServerURL: rpcURL,
+				BufferSize:   min(count, 100),
+			})
+			defer backend.Close()
+			if err := bench.Record(cmd.Context(), backend, start, count, dir); err != nil {
+				return err
+			}
+			fmt.Printf("Recorded ledgers %d to %d in %s\n", start, start+count-1, dir)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "https://soroban-testnet.stellar.org", "RPC server the ledgers are fetched from. It only serves the ledgers of its retention window")
+	cmd.Flags().StringVar(&dir, "dir", "", "Directory the ledgers are written to")
+	cmd.Flags().Uint32Var(&start, "start", 0, "First ledger to record")
+	cmd.Flags().Uint32Var(&count, "count", 100, "Ledgers to record")
+	_ = cmd.MarkFlagRequired("dir")
+	_ = cmd.MarkFlagRequired("start")
+	return cmd
+}
@@ -1,45 +1,273 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/Trustless-Work/Indexer/internal/admin"
+	"github.com/Trustless-Work/Indexer/internal/alert"
 	"github.com/Trustless-Work/Indexer/internal/ingest"
+	"github.com/Trustless-Work/Indexer/internal/leader"
 	"github.com/Trustless-Work/Indexer/internal/services"
-	"github.com/sirupsen/logrus"
-	"github.com/stellar/go-stellar-sdk/support/log"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
+	"github.com/stellar/go-stellar-sdk/network"
+
+	// Sinks available in this binary
+	_ "github.com/Trustless-Work/Indexer/internal/sink/grpcstream"
+	_ "github.com/Trustless-Work/Indexer/internal/sink/kafka"
+	_ "github.com/Trustless-Work/Indexer/internal/sink/multi"
+	_ "github.com/Trustless-Work/Indexer/internal/sink/noop"
+	_ "github.com/Trustless-Work/Indexer/internal/sink/postgres"
+	_ "github.com/Trustless-Work/Indexer/internal/sink/sse"
+
+	"github.com/spf13/cobra"
 )
 
-func main() {
+// networkPassphrases maps the names accepted by --network to their passphrase.
+var networkPassphrases = map[string]string{
+	"testnet":   network.TestNetworkPassphrase,
+	"pubnet":    network.PublicNetworkPassphrase,
+	"futurenet": network.FutureNetworkPassphrase,
+}
+
+func ingestCmd() *cobra.Command {
+	var networks []string
+	var metricsAddress string
+	var pprof bool
+	var tracingCfg tracing.Config
+	var leaderCfg ingest.LeaderElectionConfig
+	var adminCfg adminFlags
+	var alertRules string
+	var statsDatabaseURL string
+	var sinkCfg sinkFlags
+	cmd := &cobra.Command{
+		Use:   "ingest",
+		Short: "Ingest ledgers from the tip of the network into the configured sink",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIngest(cmd.Context(), networks, sinkCfg, metricsAddress, pprof, tracingCfg, leaderCfg, adminCfg, alertRules, statsDatabaseURL)
+		},
+	}
+	cmd.Flags().StringArrayVar(&networks, "network", []string{"testnet=https://soroban-testnet.stellar.org"}, "Network to ingest and its RPC server, as <name>=<RPC URL> with name testnet, pubnet or futurenet. Repeat it to ingest several networks side by side")
+	cmd.Flags().StringVar(&sinkCfg.sinkType, "sink", "noop", "Sink the ledgers are written to: noop, kafka, grpc, postgres, sse or multi. With several networks, it is shared by the networks without --network-sink")
+	cmd.Flags().StringVar(&sinkCfg.options, "sink-options", "", "Options of the sink, as a JSON object or @<file> holding one")
+	cmd.Flags().StringArrayVar(&sinkCfg.networkTypes, "network-sink", nil, "Sink of one network, as <name>=<sink> (repeatable), e.g. to give each network its own Postgres database")
+	cmd.Flags().StringArrayVar(&sinkCfg.networkOptions, "network-sink-options", nil, "Options of the sink of one network, as <name>=<JSON object or @file> (repeatable)")
+	cmd.Flags().StringVar(&metricsAddress, "metrics-address", ":9090", "Address the Prometheus metrics (/metrics) and the health, readiness and status of the ingestion (/healthz, /readyz, /status) are served on. Empty disables them")
+	cmd.Flags().BoolVar(&pprof, "pprof", false, "Serve the Go profiles under /debug/pprof/ on the metrics address, for go tool pprof")
+	cmd.Flags().StringVar(&tracingCfg.OTLPEndpoint, "otlp-endpoint", "", "URL of the OTLP/HTTP collector the traces are exported to, e.g. http://localhost:4318")
+	cmd.Flags().StringVar(&tracingCfg.File, "trace-file", "", "File the traces are appended to as JSON, for local tests")
+	cmd.Flags().StringVar(&leaderCfg.DatabaseURL, "leader-election-database-url", "", "Postgres database of the leader lock. When set, the replica ingests only while it is the leader. Empty disables leader election")
+	cmd.Flags().StringVar(&leaderCfg.LockName, "leader-lock-name", leader.DefaultLockName, "Name of the leader lock, shared by the replicas of the same deployment")
+	cmd.Flags().StringVar(&adminCfg.address, "admin-address", "", "Address the admin API is served on, e.g. "+defaultAdminAddress+". Empty disables it")
+	cmd.Flags().StringArrayVar(&adminCfg.tokens, "admin-token", splitNonEmpty(os.Getenv("ADMIN_TOKENS")), "Operator allowed to use the admin API and its token, as <operator>=<token> (repeatable, defaults to the comma separated $ADMIN_TOKENS)")
+	cmd.Flags().StringVar(&adminCfg.auditDatabaseURL, "admin-audit-database-url", "", "Postgres database the admin actions are persisted to, in the audit log of the query server. Empty only logs them")
+	cmd.Flags().StringVar(&alertRules, "alert-rules", "", "JSON file declaring the alerting rules evaluated on every ledger. Empty disables alerting")
+	cmd.Flags().StringVar(&statsDatabaseURL, "stats-database-url", "", "Postgres database the stats of every ingested ledger are persisted to, for the SLOs of indexer stats. Empty disables them")
+	return cmd
+}
+
+// adminFlags are the flags of the admin API of the ingest command.
+type adminFlags struct {
+	address          string
+	tokens           []string
+	auditDatabaseURL string
+}
+
+// sinkFlags are the flags selecting the sinks of the ingest command.
+type sinkFlags struct {
+	sinkType       string
+	options        string
+	networkTypes   []string
+	networkOptions []string
+}
+
+// networkSinks returns the sink type and options of each network configured with --network-sink.
+func (f sinkFlags) networkSinks() (map[string]string, map[string]map[string]any, error) {
+	types := make(map[string]string, len(f.networkTypes))
+	for _, value := range f.networkTypes {
+		name, sinkType, ok := strings.Cut(value, "=")
+		if !ok || name == "" || sinkType == "" {
+			return nil, nil, fmt.Errorf("invalid network sink %q, expected <name>=<sink>", value)
+		}
+		types[name] = sinkType
+	}
+
+	options := make(map[string]map[string]any, len(f.networkOptions))
+	for _, value := range f.networkOptions {
+		name, raw, ok := strings.Cut(value, "=")
+		if !ok || name == "" {
+			return nil, nil, fmt.Errorf("invalid network sink options %q, expected <name>=<JSON object>", value)
+		}
+		if _, ok := types[name]; !ok {
+			return nil, nil, fmt.Errorf("sink options of network %s without --network-sink %s=<sink>", name, name)
+		}
+		opts, err := parseSinkOptions(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sink options of network %s: %w", name, err)
+		}
+		options[name] = opts
+	}
+	return types, options, nil
+}
+
+// parseSinkOptions decodes the options of a sink, given as a JSON object or as @<file>.
+func parseSinkOptions(value string) (map[string]any, error) {
+	if value == "" {
+		return nil, nil
+	}
+	data := []byte(value)
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("reading sink options: %w", err)
+		}
+	}
+	var options map[string]any
+	if err := json.Unmarshal(data, &options); err != nil {
+		return nil, fmt.Errorf("decoding sink options, expected a JSON object: %w", err)
+	}
+	return options, nil
+}
+
+// config returns the admin API config. The returned function closes the audit database.
+func (f adminFlags) config(ctx context.Context) (admin.Config, func(), error) {
+	cfg := admin.Config{Address: f.address, Tokens: make(map[string]string, len(f.tokens))}
+	if f.address == "" {
+		return cfg, func() {}, nil
+	}
+	for _, value := range f.tokens {
+		operator, token, ok := strings.Cut(value, "=")
+		if !ok || operator == "" || token == "" {
+			return cfg, nil, fmt.Errorf("invalid admin token %q, expected <operator>=<token>", value)
+		}
+		cfg.Tokens[operator] = token
+	}
+	if f.auditDatabaseURL == "" {
+		return cfg, func() {}, nil
+	}
+
+	store, err := pgstore.Open(ctx, f.auditDatabaseURL)
+	if err != nil {
+		return cfg, nil, fmt.Errorf("opening audit database: %w", err)
+	}
+	if err := store.Migrate(ctx); err != nil {
+		store.Close()
+		return cfg, nil, fmt.Errorf("migrating audit database: %w", err)
+	}
+	cfg.Audit = store
+	return cfg, func() { store.Close() }, nil
+}
+
+func runIngest(ctx context.Context, networks []string, sinks sinkFlags, metricsAddress string, pprof bool, tracingCfg tracing.Config, leaderCfg ingest.LeaderElectionConfig, adminOpts adminFlags, alertRules, statsDatabaseURL string) error {
 	fmt.Println("Starting ingest...")
-	preConfigureLogger()
 
-	const (
-		NetworkPassphrase = "Test SDF Network ; September 2015"
-		RPCURL            = "https://soroban-testnet.stellar.org"
-	)
+	var alertCfg alert.Config
+	if alertRules != "" {
+		var err error
+		if alertCfg, err = alert.LoadConfig(alertRules); err != nil {
+			return err
+		}
+	}
+
+	adminCfg, closeAudit, err := adminOpts.config(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeAudit()
+
+	var ledgerStats services.LedgerStatsWriter
+	if statsDatabaseURL != "" {
+		store, err := pgstore.Open(ctx, statsDatabaseURL)
+		if err != nil {
+			return fmt.Errorf("opening stats database: %w", err)
+		}
+		defer store.Close()
+		if err := store.Migrate(ctx); err != nil {
+			return fmt.Errorf("migrating stats database: %w", err)
+		}
+		ledgerStats = store
+	}
+
+	sinkOptions, err := parseSinkOptions(sinks.options)
+	if err != nil {
+		return err
+	}
+	networkSinkTypes, networkSinkOptions, err := sinks.networkSinks()
+	if err != nil {
+		return err
+	}
+	for name := range networkSinkTypes {
+		if !slices.ContainsFunc(networks, func(value string) bool { return strings.HasPrefix(value, name+"=") }) {
+			return fmt.Errorf("sink of network %s, which isn't ingested", name)
+		}
+	}
+
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
-	rpcClient, _ := services.NewRPCService(RPCURL, NetworkPassphrase, httpClient)
-	rpcHealthInfo, _ := rpcClient.GetHealth()
-	latesLedgerSequence := rpcHealthInfo.LatestLedger
+	networkCfgs := make([]ingest.NetworkConfig, 0, len(networks))
+	for _, value := range networks {
+		name, rpcURL, ok := strings.Cut(value, "=")
+		passphrase, known := networkPassphrases[name]
+		if !ok || !known || rpcURL == "" {
+			return fmt.Errorf("invalid network %q, expected <testnet|pubnet|futurenet>=<RPC URL>", value)
+		}
+
+		// Each network starts from its tip, unless its sink committed a cursor.
+		rpcClient, err := services.NewRPCService(rpcURL, passphrase, httpClient)
+		if err != nil {
+			return fmt.Errorf("creating RPC client of %s: %w", name, err)
+		}
+		rpcHealthInfo, err := rpcClient.GetHealth()
+		if err != nil {
+			return fmt.Errorf("getting latest ledger of %s: %w", name, err)
+		}
+
+		networkCfgs = append(networkCfgs, ingest.NetworkConfig{
+			Network:           name,
+			NetworkPassphrase: passphrase,
+			RPCURL:            rpcURL,
+			LedgerBackendType: ingest.LedgerBackendTypeRPC,
+			GetLedgersLimit:   100,
+			StartLedger:       rpcHealthInfo.LatestLedger,
+			SinkType:          networkSinkTypes[name],
+			SinkOptions:       networkSinkOptions[name],
+		})
+	}
 
 	cfg := ingest.Config{
-		StartLedger:       latesLedgerSequence,
-		EndLedger:         0,
-		RPCURL:            RPCURL,
-		NetworkPassphrase: NetworkPassphrase,
-		GetLedgersLimit:   100,
-		LedgerBackendType: ingest.LedgerBackendTypeRPC,
-	}
-	err := ingest.Ingest(cfg)
-	if err != nil {
-		log.Fatalf("running ingest: %v", err)
+		EndLedger:      0,
+		Networks:       networkCfgs,
+		SinkType:       sinks.sinkType,
+		SinkOptions:    sinkOptions,
+		MetricsAddress: metricsAddress,
+		Pprof:          pprof,
+		Tracing:        tracingCfg,
+		LeaderElection: leaderCfg,
+		Admin:          adminCfg,
+		Alerts:         alertCfg,
+		LedgerStats:    ledgerStats,
+	}
+	if err := ingest.Ingest(ctx, cfg); err != nil {
+		return fmt.Errorf("running ingest: %w", err)
 	}
+	return nil
 }
 
-func preConfigureLogger() {
-	log.DefaultLogger = log.New()
-	log.DefaultLogger.SetLevel(logrus.TraceLevel)
+// splitNonEmpty splits a comma separated list, dropping the empty values.
+func splitNonEmpty(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
 }
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/spf13/cobra"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+func main() {
+	preConfigureLogger()
+
+	logCfg := logging.Config{}
+	rootCmd := &cobra.Command{
+		Use:           "indexer",
+		Short:         "Trustless Work indexer for the Stellar network",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return logging.Configure(logCfg)
+		},
+	}
+	rootCmd.PersistentFlags().StringVar(&logCfg.Level, "log-level", "info", "Minimum level logged: trace, debug, info, warn or error")
+	rootCmd.PersistentFlags().StringVar(&logCfg.Format, "log-format", logging.FormatText, "Format of the logs: text or json")
+	rootCmd.PersistentFlags().BoolVar(&logCfg.Sampling, "log-sampling", true, "Sample the logs of hot paths, logged once per operation, past 10 lines per second")
+	rootCmd.AddCommand(ingestCmd(), serveCmd(), apiKeyCmd(), statementCmd(), adminCmd(), benchCmd(), statsCmd())
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func preConfigureLogger() {
+	log.DefaultLogger = log.New()
+	log.DefaultLogger.SetLevel(log.InfoLevel)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+
+	"github.com/Trustless-Work/Indexer/internal/serve"
+	"github.com/spf13/cobra"
+)
+
+func serveCmd() *cobra.Command {
+	cfg := serve.Config{}
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the GraphQL and REST APIs over the data stored by the postgres sink",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve.Serve(cfg)
+		},
+	}
+	cmd.Flags().StringVar(&cfg.ListenAddress, "listen-address", ":8000", "Address of the HTTP server")
+	cmd.Flags().StringVar(&cfg.Storage, "storage", serve.StoragePostgres, "Storage the APIs are served from: postgres, or memory to ingest from the tip of the network without a database (demo mode)")
+	cmd.Flags().StringVar(&cfg.DatabaseURL, "database-url", os.Getenv("DATABASE_URL"), "Postgres connection string (defaults to $DATABASE_URL)")
+	cmd.Flags().StringVar(&cfg.RPCURL, "rpc-url", "https://soroban-testnet.stellar.org", "RPC server balances are reconciled against")
+	cmd.Flags().StringVar(&cfg.NetworkPassphrase, "network-passphrase", "Test SDF Network ; September 2015", "Passphrase of the network of the RPC server")
+	cmd.Flags().BoolVar(&cfg.RequireAPIKey, "require-api-key", false, "Require an API key (see the apikey command) outside of the public endpoints")
+	cmd.Flags().StringSliceVar(&cfg.AuthConfig.PublicEndpoints, "public-endpoint", []string{"GET /v1/openapi.yaml", "GET /graphql/playground"}, "Endpoint pattern served without an API key, e.g. \"GET /v1/escrows/{contractId}\" (repeatable)")
+	cmd.Flags().Float64Var(&cfg.AuthConfig.PublicRateLimit, "public-rate-limit", 1, "Requests per second allowed per client IP without an API key")
+	cmd.Flags().IntVar(&cfg.AuthConfig.PublicBurst, "public-burst", 5, "Burst of requests allowed per client IP without an API key")
+	cmd.Flags().BoolVar(&cfg.EnablePlayground, "playground", false, "Serve the GraphQL playground on /graphql/playground")
+	return cmd
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Trustless-Work/Indexer/internal/statement"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/spf13/cobra"
+)
+
+func statementCmd() *cobra.Command {
+	var (
+		databaseURL string
+		req         statement.Request
+		from, to    string
+		format      string
+		output      string
+	)
+	cmd := &cobra.Command{
+		Use:   "statement",
+		Short: "Export the statement of the escrows of an engagement or a platform, as CSV or PDF",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if req.From, req.To, err = statement.ParsePeriod(from, to); err != nil {
+				return err
+			}
+			if err := req.Validate(); err != nil {
+				return err
+			}
+			write := statement.WriteCSV
+			switch format {
+			case "csv":
+			case "pdf":
+				write = statement.WritePDF
+			default:
+				return fmt.Errorf("invalid format %q, expected csv or pdf", format)
+			}
+			if databaseURL == "" {
+				return errors.New("database URL is required")
+			}
+
+			ctx := cmd.Context()
+			store, err := pgstore.Open(ctx, databaseURL)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			result, err := statement.Build(ctx, store, req)
+			if err != nil {
+				return err
+			}
+
+			if output == "" || output == "-" {
+				return write(os.Stdout, result)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("creating output file: %w", err)
+			}
+			if err := write(file, result); err != nil {
+				file.Close()
+				return fmt.Errorf("writing statement: %w", err)
+			}
+			return file.Close()
+		},
+	}
+	cmd.Flags().StringVar(&databaseURL, "database-url", os.Getenv("DATABASE_URL"), "Postgres connection string (defaults to $DATABASE_URL)")
+	cmd.Flags().StringVar(&req.Filter.EngagementID, "engagement-id", "", "Engagement of the escrows")
+	cmd.Flags().StringVar(&req.Filter.Roles.PlatformAddress, "platform-address", "", "Platform of the escrows")
+	cmd.Flags().StringVar(&from, "from", "", "Start of the period, a date (UTC) or an RFC 3339 timestamp")
+	cmd.Flags().StringVar(&to, "to", "", "End of the period, a date (UTC, included) or an RFC 3339 timestamp (excluded)")
+	cmd.Flags().StringVar(&format, "format", "csv", "Format of the statement: csv or pdf")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "File the statement is written to (defaults to stdout)")
+	return cmd
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/spf13/cobra"
+)
+
+func statsCmd() *cobra.Command {
+	var (
+		databaseURL string
+		filter      store.LedgerStatsFilter
+		since       time.Duration
+		target      time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Report the visibility SLO of the ingestion from the persisted ledger stats",
+		Long: `Report the visibility SLO of the ingestion from the stats persisted by
+"indexer ingest --stats-database-url": the share of the ledgers and of their escrows ingested
+within --target of the close of their ledger, and the percentiles of the delay.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if databaseURL == "" {
+				return errors.New("database URL is required")
+			}
+			if since > 0 {
+				filter.From = time.Now().Add(-since)
+			}
+
+			ctx := cmd.Context()
+			db, err := pgstore.Open(ctx, databaseURL)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			slo, err := db.GetIngestSLO(ctx, filter, target)
+			if err != nil {
+				return err
+			}
+			if slo.Ledgers == 0 {
+				return fmt.Errorf("no ledger stats for %s in the period", filter.Network)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "Ledgers within %v\t%d/%d (%.3f%%)\n", slo.Target, slo.LedgersWithinTarget, slo.Ledgers, percent(slo.LedgersWithinTarget, slo.Ledgers))
+			fmt.Fprintf(w, "Escrows within %v\t%d/%d (%.3f%%)\n", slo.Target, slo.EscrowsWithinTarget, slo.Escrows, percent(slo.EscrowsWithinTarget, slo.Escrows))
+			fmt.Fprintf(w, "Visibility delay\tp50 %v, p99 %v, max %v\n", slo.DelayP50.Round(time.Millisecond), slo.DelayP99.Round(time.Millisecond), slo.DelayMax.Round(time.Millisecond))
+			fmt.Fprintf(w, "Process, sink p99\t%v, %v\n", slo.ProcessP99.Round(time.Millisecond), slo.SinkP99.Round(time.Millisecond))
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&databaseURL, "database-url", os.Getenv("DATABASE_URL"), "Postgres connection string of the ledger stats (defaults to $DATABASE_URL)")
+	cmd.Flags().StringVar(&filter.Network, "network", "testnet", "Network of the ledgers")
+	cmd.Flags().DurationVar(&since, "since", 24*time.Hour, "Period of the report, up to now. 0 reports every ledger")
+	cmd.Flags().DurationVar(&target, "target", 10*time.Second, "Delay between the close of a ledger and its ingestion the SLO targets")
+	return cmd
+}
+
+// percent is part of total as a percentage, 100 when total is 0.
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return 100 * float64(part) / float64(total)
+}
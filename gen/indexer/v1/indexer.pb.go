@@ -0,0 +1,1758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: indexer/v1/indexer.proto
+
+package indexerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EscrowType int32
+
+const (
+	EscrowType_ESCROW_TYPE_UNSPECIFIED    EscrowType = 0
+	EscrowType_ESCROW_TYPE_SINGLE_RELEASE EscrowType = 1
+	EscrowType_ESCROW_TYPE_MULTI_RELEASE  EscrowType = 2
+)
+
+// Enum value maps for EscrowType.
+var (
+	EscrowType_name = map[int32]string{
+		0: "ESCROW_TYPE_UNSPECIFIED",
+		1: "ESCROW_TYPE_SINGLE_RELEASE",
+		2: "ESCROW_TYPE_MULTI_RELEASE",
+	}
+	EscrowType_value = map[string]int32{
+		"ESCROW_TYPE_UNSPECIFIED":    0,
+		"ESCROW_TYPE_SINGLE_RELEASE": 1,
+		"ESCROW_TYPE_MULTI_RELEASE":  2,
+	}
+)
+
+func (x EscrowType) Enum() *EscrowType {
+	p := new(EscrowType)
+	*p = x
+	return p
+}
+
+func (x EscrowType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscrowType) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_v1_indexer_proto_enumTypes[0].Descriptor()
+}
+
+func (EscrowType) Type() protoreflect.EnumType {
+	return &file_indexer_v1_indexer_proto_enumTypes[0]
+}
+
+func (x EscrowType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscrowType.Descriptor instead.
+func (EscrowType) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{0}
+}
+
+type EscrowEventType int32
+
+const (
+	EscrowEventType_ESCROW_EVENT_TYPE_UNSPECIFIED EscrowEventType = 0
+	EscrowEventType_ESCROW_EVENT_TYPE_CREATED     EscrowEventType = 1
+	EscrowEventType_ESCROW_EVENT_TYPE_UPDATED     EscrowEventType = 2
+)
+
+// Enum value maps for EscrowEventType.
+var (
+	EscrowEventType_name = map[int32]string{
+		0: "ESCROW_EVENT_TYPE_UNSPECIFIED",
+		1: "ESCROW_EVENT_TYPE_CREATED",
+		2: "ESCROW_EVENT_TYPE_UPDATED",
+	}
+	EscrowEventType_value = map[string]int32{
+		"ESCROW_EVENT_TYPE_UNSPECIFIED": 0,
+		"ESCROW_EVENT_TYPE_CREATED":     1,
+		"ESCROW_EVENT_TYPE_UPDATED":     2,
+	}
+)
+
+func (x EscrowEventType) Enum() *EscrowEventType {
+	p := new(EscrowEventType)
+	*p = x
+	return p
+}
+
+func (x EscrowEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscrowEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_v1_indexer_proto_enumTypes[1].Descriptor()
+}
+
+func (EscrowEventType) Type() protoreflect.EnumType {
+	return &file_indexer_v1_indexer_proto_enumTypes[1]
+}
+
+func (x EscrowEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscrowEventType.Descriptor instead.
+func (EscrowEventType) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{1}
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_ledger is the first ledger to stream. 0 streams from the next ingested ledger.
+	// Older ledgers are only available while they are kept in the server replay window.
+	StartLedger uint32 `protobuf:"varint,1,opt,name=start_ledger,json=startLedger,proto3" json:"start_ledger,omitempty"`
+	// An entity is streamed if it matches any of the filters. No filters streams everything.
+	AccountIds    []string `protobuf:"bytes,2,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	ContractIds   []string `protobuf:"bytes,3,rep,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	EngagementIds []string `protobuf:"bytes,4,rep,name=engagement_ids,json=engagementIds,proto3" json:"engagement_ids,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetStartLedger() uint32 {
+	if x != nil {
+		return x.StartLedger
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetContractIds() []string {
+	if x != nil {
+		return x.ContractIds
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetEngagementIds() []string {
+	if x != nil {
+		return x.EngagementIds
+	}
+	return nil
+}
+
+type LedgerUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerSequence uint32                 `protobuf:"varint,1,opt,name=ledger_sequence,json=ledgerSequence,proto3" json:"ledger_sequence,omitempty"`
+	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Transactions   []*Transaction         `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Operations     []*Operation           `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	StateChanges   []*StateChange         `protobuf:"bytes,5,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	Escrows        []*Escrow              `protobuf:"bytes,6,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	EscrowEvents   []*EscrowEvent         `protobuf:"bytes,7,rep,name=escrow_events,json=escrowEvents,proto3" json:"escrow_events,omitempty"`
+}
+
+func (x *LedgerUpdate) Reset() {
+	*x = LedgerUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerUpdate) ProtoMessage() {}
+
+func (x *LedgerUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerUpdate.ProtoReflect.Descriptor instead.
+func (*LedgerUpdate) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LedgerUpdate) GetLedgerSequence() uint32 {
+	if x != nil {
+		return x.LedgerSequence
+	}
+	return 0
+}
+
+func (x *LedgerUpdate) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *LedgerUpdate) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *LedgerUpdate) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *LedgerUpdate) GetStateChanges() []*StateChange {
+	if x != nil {
+		return x.StateChanges
+	}
+	return nil
+}
+
+func (x *LedgerUpdate) GetEscrows() []*Escrow {
+	if x != nil {
+		return x.Escrows
+	}
+	return nil
+}
+
+func (x *LedgerUpdate) GetEscrowEvents() []*EscrowEvent {
+	if x != nil {
+		return x.EscrowEvents
+	}
+	return nil
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash                 string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ToId                 int64                  `protobuf:"varint,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	EnvelopeXdr          string                 `protobuf:"bytes,3,opt,name=envelope_xdr,json=envelopeXdr,proto3" json:"envelope_xdr,omitempty"`
+	ResultXdr            string                 `protobuf:"bytes,4,opt,name=result_xdr,json=resultXdr,proto3" json:"result_xdr,omitempty"`
+	MetaXdr              string                 `protobuf:"bytes,5,opt,name=meta_xdr,json=metaXdr,proto3" json:"meta_xdr,omitempty"`
+	LedgerNumber         uint32                 `protobuf:"varint,6,opt,name=ledger_number,json=ledgerNumber,proto3" json:"ledger_number,omitempty"`
+	LedgerCreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ledger_created_at,json=ledgerCreatedAt,proto3" json:"ledger_created_at,omitempty"`
+	InnerTransactionHash string                 `protobuf:"bytes,8,opt,name=inner_transaction_hash,json=innerTransactionHash,proto3" json:"inner_transaction_hash,omitempty"`
+	Participants         []string               `protobuf:"bytes,9,rep,name=participants,proto3" json:"participants,omitempty"`
+	Network              string                 `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Transaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Transaction) GetToId() int64 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *Transaction) GetEnvelopeXdr() string {
+	if x != nil {
+		return x.EnvelopeXdr
+	}
+	return ""
+}
+
+func (x *Transaction) GetResultXdr() string {
+	if x != nil {
+		return x.ResultXdr
+	}
+	return ""
+}
+
+func (x *Transaction) GetMetaXdr() string {
+	if x != nil {
+		return x.MetaXdr
+	}
+	return ""
+}
+
+func (x *Transaction) GetLedgerNumber() uint32 {
+	if x != nil {
+		return x.LedgerNumber
+	}
+	return 0
+}
+
+func (x *Transaction) GetLedgerCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LedgerCreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetInnerTransactionHash() string {
+	if x != nil {
+		return x.InnerTransactionHash
+	}
+	return ""
+}
+
+func (x *Transaction) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Transaction) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperationType   string                 `protobuf:"bytes,2,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	OperationXdr    string                 `protobuf:"bytes,3,opt,name=operation_xdr,json=operationXdr,proto3" json:"operation_xdr,omitempty"`
+	LedgerNumber    uint32                 `protobuf:"varint,4,opt,name=ledger_number,json=ledgerNumber,proto3" json:"ledger_number,omitempty"`
+	LedgerCreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ledger_created_at,json=ledgerCreatedAt,proto3" json:"ledger_created_at,omitempty"`
+	TxHash          string                 `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Participants    []string               `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants,omitempty"`
+	Network         string                 `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Operation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Operation) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *Operation) GetOperationXdr() string {
+	if x != nil {
+		return x.OperationXdr
+	}
+	return ""
+}
+
+func (x *Operation) GetLedgerNumber() uint32 {
+	if x != nil {
+		return x.LedgerNumber
+	}
+	return 0
+}
+
+func (x *Operation) GetLedgerCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LedgerCreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Operation) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Operation) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type StateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToId               int64                  `protobuf:"varint,1,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	StateChangeOrder   int64                  `protobuf:"varint,2,opt,name=state_change_order,json=stateChangeOrder,proto3" json:"state_change_order,omitempty"`
+	Category           string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	LedgerNumber       uint32                 `protobuf:"varint,5,opt,name=ledger_number,json=ledgerNumber,proto3" json:"ledger_number,omitempty"`
+	LedgerCreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ledger_created_at,json=ledgerCreatedAt,proto3" json:"ledger_created_at,omitempty"`
+	AccountId          string                 `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OperationId        int64                  `protobuf:"varint,8,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	TxHash             string                 `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TokenId            *string                `protobuf:"bytes,10,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"`
+	Amount             *string                `protobuf:"bytes,11,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	OfferId            *string                `protobuf:"bytes,12,opt,name=offer_id,json=offerId,proto3,oneof" json:"offer_id,omitempty"`
+	SignerAccountId    *string                `protobuf:"bytes,13,opt,name=signer_account_id,json=signerAccountId,proto3,oneof" json:"signer_account_id,omitempty"`
+	SpenderAccountId   *string                `protobuf:"bytes,14,opt,name=spender_account_id,json=spenderAccountId,proto3,oneof" json:"spender_account_id,omitempty"`
+	SponsoredAccountId *string                `protobuf:"bytes,15,opt,name=sponsored_account_id,json=sponsoredAccountId,proto3,oneof" json:"sponsored_account_id,omitempty"`
+	SponsorAccountId   *string                `protobuf:"bytes,16,opt,name=sponsor_account_id,json=sponsorAccountId,proto3,oneof" json:"sponsor_account_id,omitempty"`
+	DeployerAccountId  *string                `protobuf:"bytes,17,opt,name=deployer_account_id,json=deployerAccountId,proto3,oneof" json:"deployer_account_id,omitempty"`
+	FunderAccountId    *string                `protobuf:"bytes,18,opt,name=funder_account_id,json=funderAccountId,proto3,oneof" json:"funder_account_id,omitempty"`
+	SignerWeights      *structpb.Struct       `protobuf:"bytes,19,opt,name=signer_weights,json=signerWeights,proto3" json:"signer_weights,omitempty"`
+	Thresholds         *structpb.Struct       `protobuf:"bytes,20,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	TrustlineLimit     *structpb.Struct       `protobuf:"bytes,21,opt,name=trustline_limit,json=trustlineLimit,proto3" json:"trustline_limit,omitempty"`
+	Flags              []string               `protobuf:"bytes,22,rep,name=flags,proto3" json:"flags,omitempty"`
+	KeyValue           *structpb.Struct       `protobuf:"bytes,23,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`
+	Network            string                 `protobuf:"bytes,24,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StateChange) GetToId() int64 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *StateChange) GetStateChangeOrder() int64 {
+	if x != nil {
+		return x.StateChangeOrder
+	}
+	return 0
+}
+
+func (x *StateChange) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StateChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StateChange) GetLedgerNumber() uint32 {
+	if x != nil {
+		return x.LedgerNumber
+	}
+	return 0
+}
+
+func (x *StateChange) GetLedgerCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LedgerCreatedAt
+	}
+	return nil
+}
+
+func (x *StateChange) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetOperationId() int64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *StateChange) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *StateChange) GetTokenId() string {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return ""
+}
+
+func (x *StateChange) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *StateChange) GetOfferId() string {
+	if x != nil && x.OfferId != nil {
+		return *x.OfferId
+	}
+	return ""
+}
+
+func (x *StateChange) GetSignerAccountId() string {
+	if x != nil && x.SignerAccountId != nil {
+		return *x.SignerAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetSpenderAccountId() string {
+	if x != nil && x.SpenderAccountId != nil {
+		return *x.SpenderAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetSponsoredAccountId() string {
+	if x != nil && x.SponsoredAccountId != nil {
+		return *x.SponsoredAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetSponsorAccountId() string {
+	if x != nil && x.SponsorAccountId != nil {
+		return *x.SponsorAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetDeployerAccountId() string {
+	if x != nil && x.DeployerAccountId != nil {
+		return *x.DeployerAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetFunderAccountId() string {
+	if x != nil && x.FunderAccountId != nil {
+		return *x.FunderAccountId
+	}
+	return ""
+}
+
+func (x *StateChange) GetSignerWeights() *structpb.Struct {
+	if x != nil {
+		return x.SignerWeights
+	}
+	return nil
+}
+
+func (x *StateChange) GetThresholds() *structpb.Struct {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *StateChange) GetTrustlineLimit() *structpb.Struct {
+	if x != nil {
+		return x.TrustlineLimit
+	}
+	return nil
+}
+
+func (x *StateChange) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *StateChange) GetKeyValue() *structpb.Struct {
+	if x != nil {
+		return x.KeyValue
+	}
+	return nil
+}
+
+func (x *StateChange) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type Escrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId      string     `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	EscrowType      EscrowType `protobuf:"varint,2,opt,name=escrow_type,json=escrowType,proto3,enum=indexer.v1.EscrowType" json:"escrow_type,omitempty"`
+	Deployer        string     `protobuf:"bytes,3,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	FactoryContract string     `protobuf:"bytes,4,opt,name=factory_contract,json=factoryContract,proto3" json:"factory_contract,omitempty"`
+	DeployerSalt    string     `protobuf:"bytes,5,opt,name=deployer_salt,json=deployerSalt,proto3" json:"deployer_salt,omitempty"`
+	WasmHash        string     `protobuf:"bytes,6,opt,name=wasm_hash,json=wasmHash,proto3" json:"wasm_hash,omitempty"`
+	InitFunction    string     `protobuf:"bytes,7,opt,name=init_function,json=initFunction,proto3" json:"init_function,omitempty"`
+	// Only for single release escrows.
+	Amount       uint64 `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description  string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	EngagementId string `protobuf:"bytes,10,opt,name=engagement_id,json=engagementId,proto3" json:"engagement_id,omitempty"`
+	Title        string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	PlatformFee  uint32 `protobuf:"varint,12,opt,name=platform_fee,json=platformFee,proto3" json:"platform_fee,omitempty"`
+	ReceiverMemo string `protobuf:"bytes,13,opt,name=receiver_memo,json=receiverMemo,proto3" json:"receiver_memo,omitempty"`
+	// Only for single release escrows.
+	Flags            *EscrowFlags `protobuf:"bytes,14,opt,name=flags,proto3" json:"flags,omitempty"`
+	Roles            *EscrowRoles `protobuf:"bytes,15,opt,name=roles,proto3" json:"roles,omitempty"`
+	Milestones       []*Milestone `protobuf:"bytes,16,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	TrustlineAddress string       `protobuf:"bytes,17,opt,name=trustline_address,json=trustlineAddress,proto3" json:"trustline_address,omitempty"`
+	Network          string       `protobuf:"bytes,18,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *Escrow) Reset() {
+	*x = Escrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Escrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Escrow) ProtoMessage() {}
+
+func (x *Escrow) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Escrow.ProtoReflect.Descriptor instead.
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Escrow) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *Escrow) GetEscrowType() EscrowType {
+	if x != nil {
+		return x.EscrowType
+	}
+	return EscrowType_ESCROW_TYPE_UNSPECIFIED
+}
+
+func (x *Escrow) GetDeployer() string {
+	if x != nil {
+		return x.Deployer
+	}
+	return ""
+}
+
+func (x *Escrow) GetFactoryContract() string {
+	if x != nil {
+		return x.FactoryContract
+	}
+	return ""
+}
+
+func (x *Escrow) GetDeployerSalt() string {
+	if x != nil {
+		return x.DeployerSalt
+	}
+	return ""
+}
+
+func (x *Escrow) GetWasmHash() string {
+	if x != nil {
+		return x.WasmHash
+	}
+	return ""
+}
+
+func (x *Escrow) GetInitFunction() string {
+	if x != nil {
+		return x.InitFunction
+	}
+	return ""
+}
+
+func (x *Escrow) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Escrow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Escrow) GetEngagementId() string {
+	if x != nil {
+		return x.EngagementId
+	}
+	return ""
+}
+
+func (x *Escrow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Escrow) GetPlatformFee() uint32 {
+	if x != nil {
+		return x.PlatformFee
+	}
+	return 0
+}
+
+func (x *Escrow) GetReceiverMemo() string {
+	if x != nil {
+		return x.ReceiverMemo
+	}
+	return ""
+}
+
+func (x *Escrow) GetFlags() *EscrowFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Escrow) GetRoles() *EscrowRoles {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Escrow) GetMilestones() []*Milestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *Escrow) GetTrustlineAddress() string {
+	if x != nil {
+		return x.TrustlineAddress
+	}
+	return ""
+}
+
+func (x *Escrow) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type EscrowFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	Disputed bool `protobuf:"varint,2,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+	Resolved bool `protobuf:"varint,4,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *EscrowFlags) Reset() {
+	*x = EscrowFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscrowFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscrowFlags) ProtoMessage() {}
+
+func (x *EscrowFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscrowFlags.ProtoReflect.Descriptor instead.
+func (*EscrowFlags) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EscrowFlags) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *EscrowFlags) GetDisputed() bool {
+	if x != nil {
+		return x.Disputed
+	}
+	return false
+}
+
+func (x *EscrowFlags) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *EscrowFlags) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+type EscrowRoles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceProvider string `protobuf:"bytes,1,opt,name=service_provider,json=serviceProvider,proto3" json:"service_provider,omitempty"`
+	Receiver        string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Approver        string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	ReleaseSigner   string `protobuf:"bytes,4,opt,name=release_signer,json=releaseSigner,proto3" json:"release_signer,omitempty"`
+	DisputeResolver string `protobuf:"bytes,5,opt,name=dispute_resolver,json=disputeResolver,proto3" json:"dispute_resolver,omitempty"`
+	PlatformAddress string `protobuf:"bytes,6,opt,name=platform_address,json=platformAddress,proto3" json:"platform_address,omitempty"`
+}
+
+func (x *EscrowRoles) Reset() {
+	*x = EscrowRoles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscrowRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscrowRoles) ProtoMessage() {}
+
+func (x *EscrowRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscrowRoles.ProtoReflect.Descriptor instead.
+func (*EscrowRoles) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EscrowRoles) GetServiceProvider() string {
+	if x != nil {
+		return x.ServiceProvider
+	}
+	return ""
+}
+
+func (x *EscrowRoles) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *EscrowRoles) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *EscrowRoles) GetReleaseSigner() string {
+	if x != nil {
+		return x.ReleaseSigner
+	}
+	return ""
+}
+
+func (x *EscrowRoles) GetDisputeResolver() string {
+	if x != nil {
+		return x.DisputeResolver
+	}
+	return ""
+}
+
+func (x *EscrowRoles) GetPlatformAddress() string {
+	if x != nil {
+		return x.PlatformAddress
+	}
+	return ""
+}
+
+type Milestone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Status      string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only for single release escrows.
+	Approved bool   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Evidence string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// Only for multi release escrows.
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Only for multi release escrows.
+	Flags *EscrowFlags `protobuf:"bytes,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Only for multi release escrows.
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (x *Milestone) Reset() {
+	*x = Milestone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Milestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Milestone) ProtoMessage() {}
+
+func (x *Milestone) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Milestone.ProtoReflect.Descriptor instead.
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Milestone) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Milestone) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Milestone) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *Milestone) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *Milestone) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Milestone) GetFlags() *EscrowFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Milestone) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+// EscrowEvent is an escrow lifecycle event, mirroring the work.trustless.escrow.* CloudEvents.
+type EscrowEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the CloudEvents id of the event.
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           EscrowEventType        `protobuf:"varint,2,opt,name=type,proto3,enum=indexer.v1.EscrowEventType" json:"type,omitempty"`
+	LedgerSequence uint32                 `protobuf:"varint,3,opt,name=ledger_sequence,json=ledgerSequence,proto3" json:"ledger_sequence,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Escrow         *Escrow                `protobuf:"bytes,5,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (x *EscrowEvent) Reset() {
+	*x = EscrowEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_v1_indexer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscrowEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscrowEvent) ProtoMessage() {}
+
+func (x *EscrowEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_v1_indexer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscrowEvent.ProtoReflect.Descriptor instead.
+func (*EscrowEvent) Descriptor() ([]byte, []int) {
+	return file_indexer_v1_indexer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EscrowEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EscrowEvent) GetType() EscrowEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EscrowEventType_ESCROW_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EscrowEvent) GetLedgerSequence() uint32 {
+	if x != nil {
+		return x.LedgerSequence
+	}
+	return 0
+}
+
+func (x *EscrowEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *EscrowEvent) GetEscrow() *Escrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+var File_indexer_v1_indexer_proto protoreflect.FileDescriptor
+
+var file_indexer_v1_indexer_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x0c, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a,
+	0x07, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x52, 0x07, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x0b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x6f,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x78,
+	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x58, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x78, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x58, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x78, 0x64, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x61, 0x58, 0x64, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x22, 0xab, 0x02, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x78, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x46, 0x0a, 0x11, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0xae,
+	0x09, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x6f, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x10, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35,
+	0x0a, 0x14, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x12,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x10, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e,
+	0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x37,
+	0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x34, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0xa9, 0x05, 0x0a, 0x06, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x53, 0x61, 0x6c, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x73, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x12,
+	0x2d, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x7d, 0x0a, 0x0b, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x09, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0xd3, 0x01,
+	0x0a, 0x0b, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x06, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x2a, 0x68, 0x0a, 0x0a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49,
+	0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x72, 0x0a,
+	0x0f, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x32, 0x5d, 0x0a, 0x14, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01,
+	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x2d, 0x57, 0x6f, 0x72, 0x6b, 0x2f, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_indexer_v1_indexer_proto_rawDescOnce sync.Once
+	file_indexer_v1_indexer_proto_rawDescData = file_indexer_v1_indexer_proto_rawDesc
+)
+
+func file_indexer_v1_indexer_proto_rawDescGZIP() []byte {
+	file_indexer_v1_indexer_proto_rawDescOnce.Do(func() {
+		file_indexer_v1_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_v1_indexer_proto_rawDescData)
+	})
+	return file_indexer_v1_indexer_proto_rawDescData
+}
+
+var file_indexer_v1_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_indexer_v1_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_indexer_v1_indexer_proto_goTypes = []any{
+	(EscrowType)(0),               // 0: indexer.v1.EscrowType
+	(EscrowEventType)(0),          // 1: indexer.v1.EscrowEventType
+	(*SubscribeRequest)(nil),      // 2: indexer.v1.SubscribeRequest
+	(*LedgerUpdate)(nil),          // 3: indexer.v1.LedgerUpdate
+	(*Transaction)(nil),           // 4: indexer.v1.Transaction
+	(*Operation)(nil),             // 5: indexer.v1.Operation
+	(*StateChange)(nil),           // 6: indexer.v1.StateChange
+	(*Escrow)(nil),                // 7: indexer.v1.Escrow
+	(*EscrowFlags)(nil),           // 8: indexer.v1.EscrowFlags
+	(*EscrowRoles)(nil),           // 9: indexer.v1.EscrowRoles
+	(*Milestone)(nil),             // 10: indexer.v1.Milestone
+	(*EscrowEvent)(nil),           // 11: indexer.v1.EscrowEvent
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 13: google.protobuf.Struct
+}
+var file_indexer_v1_indexer_proto_depIdxs = []int32{
+	12, // 0: indexer.v1.LedgerUpdate.closed_at:type_name -> google.protobuf.Timestamp
+	4,  // 1: indexer.v1.LedgerUpdate.transactions:type_name -> indexer.v1.Transaction
+	5,  // 2: indexer.v1.LedgerUpdate.operations:type_name -> indexer.v1.Operation
+	6,  // 3: indexer.v1.LedgerUpdate.state_changes:type_name -> indexer.v1.StateChange
+	7,  // 4: indexer.v1.LedgerUpdate.escrows:type_name -> indexer.v1.Escrow
+	11, // 5: indexer.v1.LedgerUpdate.escrow_events:type_name -> indexer.v1.EscrowEvent
+	12, // 6: indexer.v1.Transaction.ledger_created_at:type_name -> google.protobuf.Timestamp
+	12, // 7: indexer.v1.Operation.ledger_created_at:type_name -> google.protobuf.Timestamp
+	12, // 8: indexer.v1.StateChange.ledger_created_at:type_name -> google.protobuf.Timestamp
+	13, // 9: indexer.v1.StateChange.signer_weights:type_name -> google.protobuf.Struct
+	13, // 10: indexer.v1.StateChange.thresholds:type_name -> google.protobuf.Struct
+	13, // 11: indexer.v1.StateChange.trustline_limit:type_name -> google.protobuf.Struct
+	13, // 12: indexer.v1.StateChange.key_value:type_name -> google.protobuf.Struct
+	0,  // 13: indexer.v1.Escrow.escrow_type:type_name -> indexer.v1.EscrowType
+	8,  // 14: indexer.v1.Escrow.flags:type_name -> indexer.v1.EscrowFlags
+	9,  // 15: indexer.v1.Escrow.roles:type_name -> indexer.v1.EscrowRoles
+	10, // 16: indexer.v1.Escrow.milestones:type_name -> indexer.v1.Milestone
+	8,  // 17: indexer.v1.Milestone.flags:type_name -> indexer.v1.EscrowFlags
+	1,  // 18: indexer.v1.EscrowEvent.type:type_name -> indexer.v1.EscrowEventType
+	12, // 19: indexer.v1.EscrowEvent.time:type_name -> google.protobuf.Timestamp
+	7,  // 20: indexer.v1.EscrowEvent.escrow:type_name -> indexer.v1.Escrow
+	2,  // 21: indexer.v1.IndexerStreamService.Subscribe:input_type -> indexer.v1.SubscribeRequest
+	3,  // 22: indexer.v1.IndexerStreamService.Subscribe:output_type -> indexer.v1.LedgerUpdate
+	22, // [22:23] is the sub-list for method output_type
+	21, // [21:22] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_indexer_v1_indexer_proto_init() }
+func file_indexer_v1_indexer_proto_init() {
+	if File_indexer_v1_indexer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_indexer_v1_indexer_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*StateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Escrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EscrowFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*EscrowRoles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Milestone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_v1_indexer_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*EscrowEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_indexer_v1_indexer_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_indexer_v1_indexer_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_indexer_v1_indexer_proto_goTypes,
+		DependencyIndexes: file_indexer_v1_indexer_proto_depIdxs,
+		EnumInfos:         file_indexer_v1_indexer_proto_enumTypes,
+		MessageInfos:      file_indexer_v1_indexer_proto_msgTypes,
+	}.Build()
+	File_indexer_v1_indexer_proto = out.File
+	file_indexer_v1_indexer_proto_rawDesc = nil
+	file_indexer_v1_indexer_proto_goTypes = nil
+	file_indexer_v1_indexer_proto_depIdxs = nil
+}
@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: indexer/v1/indexer.proto
+
+package indexerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	IndexerStreamService_Subscribe_FullMethodName = "/indexer.v1.IndexerStreamService/Subscribe"
+)
+
+// IndexerStreamServiceClient is the client API for IndexerStreamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IndexerStreamService streams the data indexed from each ledger.
+type IndexerStreamServiceClient interface {
+	// Subscribe streams one LedgerUpdate per ledger, starting at start_ledger, filtered by
+	// account, contract or escrow engagement ID. Ledgers without matching data are still sent
+	// (with no entities) so clients can track their cursor.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (IndexerStreamService_SubscribeClient, error)
+}
+
+type indexerStreamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndexerStreamServiceClient(cc grpc.ClientConnInterface) IndexerStreamServiceClient {
+	return &indexerStreamServiceClient{cc}
+}
+
+func (c *indexerStreamServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (IndexerStreamService_SubscribeClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IndexerStreamService_ServiceDesc.Streams[0], IndexerStreamService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamServiceSubscribeClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IndexerStreamService_SubscribeClient interface {
+	Recv() (*LedgerUpdate, error)
+	grpc.ClientStream
+}
+
+type indexerStreamServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamServiceSubscribeClient) Recv() (*LedgerUpdate, error) {
+	m := new(LedgerUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IndexerStreamServiceServer is the server API for IndexerStreamService service.
+// All implementations must embed UnimplementedIndexerStreamServiceServer
+// for forward compatibility
+//
+// IndexerStreamService streams the data indexed from each ledger.
+type IndexerStreamServiceServer interface {
+	// Subscribe streams one LedgerUpdate per ledger, starting at start_ledger, filtered by
+	// account, contract or escrow engagement ID. Ledgers without matching data are still sent
+	// (with no entities) so clients can track their cursor.
+	Subscribe(*SubscribeRequest, IndexerStreamService_SubscribeServer) error
+	mustEmbedUnimplementedIndexerStreamServiceServer()
+}
+
+// UnimplementedIndexerStreamServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIndexerStreamServiceServer struct {
+}
+
+func (UnimplementedIndexerStreamServiceServer) Subscribe(*SubscribeRequest, IndexerStreamService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedIndexerStreamServiceServer) mustEmbedUnimplementedIndexerStreamServiceServer() {}
+
+// UnsafeIndexerStreamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndexerStreamServiceServer will
+// result in compilation errors.
+type UnsafeIndexerStreamServiceServer interface {
+	mustEmbedUnimplementedIndexerStreamServiceServer()
+}
+
+func RegisterIndexerStreamServiceServer(s grpc.ServiceRegistrar, srv IndexerStreamServiceServer) {
+	s.RegisterService(&IndexerStreamService_ServiceDesc, srv)
+}
+
+func _IndexerStreamService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerStreamServiceServer).Subscribe(m, &indexerStreamServiceSubscribeServer{ServerStream: stream})
+}
+
+type IndexerStreamService_SubscribeServer interface {
+	Send(*LedgerUpdate) error
+	grpc.ServerStream
+}
+
+type indexerStreamServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamServiceSubscribeServer) Send(m *LedgerUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// IndexerStreamService_ServiceDesc is the grpc.ServiceDesc for IndexerStreamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IndexerStreamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.v1.IndexerStreamService",
+	HandlerType: (*IndexerStreamServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _IndexerStreamService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "indexer/v1/indexer.proto",
+}
@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/batch"
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const (
+	// recentActions is how many actions GET /admin/actions returns.
+	recentActions = 100
+)
+
+// auditConfig configures the writer of the audit log entries of the actions.
+var auditConfig = batch.Config{
+	Name:          "admin actions",
+	QueueSize:     256,
+	BatchSize:     100,
+	FlushInterval: time.Second,
+	WriteTimeout:  10 * time.Second,
+}
+
+// Action is an admin action, as logged, listed and audited.
+type Action struct {
+	At time.Time `json:"at"`
+	// Operator is empty when the token was rejected.
+	Operator   string        `json:"operator,omitempty"`
+	Action     string        `json:"action"`
+	Method     string        `json:"method"`
+	Path       string        `json:"path"`
+	Request    Request       `json:"request"`
+	Status     int           `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration"`
+	RemoteAddr string        `json:"remoteAddr"`
+}
+
+// auditEntry returns the action as an entry of the audit log. The fields of the request are
+// encoded in its query.
+func (a Action) auditEntry() store.AuditEntry {
+	params := url.Values{}
+	set := func(key, value string) {
+		if value != "" && value != "0" {
+			params.Set(key, value)
+		}
+	}
+	set("network", a.Request.Network)
+	set("start", strconv.FormatUint(uint64(a.Request.Start), 10))
+	set("end", strconv.FormatUint(uint64(a.Request.End), 10))
+	set("ledger", strconv.FormatUint(uint64(a.Request.Ledger), 10))
+	set("level", a.Request.Level)
+
+	var keyID string
+	if a.Operator != "" {
+		keyID = "admin:" + a.Operator
+	}
+	return store.AuditEntry{
+		KeyID:      keyID,
+		Method:     a.Method,
+		Path:       a.Path,
+		Query:      params.Encode(),
+		Status:     a.Status,
+		Duration:   a.Duration,
+		RemoteAddr: a.RemoteAddr,
+		At:         a.At,
+	}
+}
+
+// actionLog records the admin actions: it logs them, keeps the recent ones in memory and
+// persists them, off the request path, when it has an audit writer.
+type actionLog struct {
+	mu     sync.Mutex
+	recent []Action
+
+	audit *batch.Writer[store.AuditEntry]
+}
+
+func newActionLog(writer auth.AuditWriter) *actionLog {
+	l := &actionLog{}
+	if writer != nil {
+		l.audit = batch.NewWriter(auditConfig, writer.WriteAuditLog)
+	}
+	return l
+}
+
+func (l *actionLog) record(ctx context.Context, action Action) {
+	entry := log.Ctx(ctx).WithFields(log.F{
+		logging.FieldEvent:   "admin_action",
+		"operator":           action.Operator,
+		"action":             action.Action,
+		logging.FieldNetwork: action.Request.Network,
+		"status":             action.Status,
+		"remote_addr":        action.RemoteAddr,
+	})
+	if action.Error != "" {
+		entry.Warnf("Admin action %s failed: %s", action.Action, action.Error)
+	} else {
+		entry.Infof("Admin action %s applied", action.Action)
+	}
+
+	l.mu.Lock()
+	l.recent = append(l.recent, action)
+	if len(l.recent) > recentActions {
+		l.recent = l.recent[len(l.recent)-recentActions:]
+	}
+	l.mu.Unlock()
+
+	if l.audit == nil {
+		return
+	}
+	if err := l.audit.Add(action.auditEntry()); err != nil {
+		log.Ctx(ctx).Warnf("Admin action %s not persisted: audit log %v", action.Action, err)
+	}
+}
+
+// recentFirst returns the recent actions, the latest first.
+func (l *actionLog) recentFirst() []Action {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	actions := make([]Action, len(l.recent))
+	for i, action := range l.recent {
+		actions[len(l.recent)-1-i] = action
+	}
+	return actions
+}
+
+// close writes the pending entries. The actions recorded afterwards are logged but not
+// persisted.
+func (l *actionLog) close() {
+	if l.audit != nil {
+		l.audit.Close()
+	}
+}
+
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,353 @@
+// Package admin serves the admin API of the ingestion, which lets operators pause, resume,
+// drain, rewind and backfill the ingestion of a running process and change its log level.
+//
+// Operators authenticate with a bearer token of their own. Every action, accepted or not, is
+// logged, kept in memory for GET /admin/actions and, when an audit writer is configured,
+// persisted to the audit log of the query server.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const (
+	authorizationScheme = "Bearer "
+	maxRequestBytes     = 1 << 16
+	// waitTimeout bounds how long pause and drain wait for the ledger in flight.
+	waitTimeout     = 30 * time.Second
+	shutdownTimeout = 5 * time.Second
+)
+
+// Config configures the admin API.
+type Config struct {
+	// Address is the address the admin API is served on. The API is disabled when empty.
+	Address string
+	// Tokens maps the operators allowed to use the API to their bearer token. Actions are
+	// recorded under the name of the operator.
+	Tokens map[string]string
+	// Audit, when set, persists the actions to the audit log, under the key "admin:<operator>".
+	Audit auth.AuditWriter
+}
+
+// Request is the body of the admin actions. Each action reads the fields it needs.
+type Request struct {
+	// Network is the network acted on. Pause, resume and drain act on every network when it is
+	// empty. Rewind and backfill require it when several networks are ingested.
+	Network string `json:"network,omitempty"`
+	// Start and End are the inclusive range of a backfill.
+	Start uint32 `json:"start,omitempty"`
+	End   uint32 `json:"end,omitempty"`
+	// Ledger is the ledger a rewind continues from.
+	Ledger uint32 `json:"ledger,omitempty"`
+	// Level is the log level set by log-level.
+	Level string `json:"level,omitempty"`
+}
+
+// Response is the body of a successful admin action.
+type Response struct {
+	// Networks are the networks the action applied to.
+	Networks []string `json:"networks,omitempty"`
+	// Level is the log level after the action.
+	Level string `json:"level,omitempty"`
+}
+
+// Server serves the admin API.
+type Server struct {
+	networks map[string]services.IngestService
+	names    []string
+	tokens   map[string]string
+	actions  *actionLog
+}
+
+// NewServer creates the admin API of the ingestion services, keyed by network.
+func NewServer(cfg Config, networks map[string]services.IngestService) (*Server, error) {
+	if len(cfg.Tokens) == 0 {
+		return nil, errors.New("at least one operator token is required")
+	}
+	if len(networks) == 0 {
+		return nil, errors.New("at least one network is required")
+	}
+	for operator, token := range cfg.Tokens {
+		if operator == "" || token == "" {
+			return nil, fmt.Errorf("operator %q has an empty name or token", operator)
+		}
+	}
+
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return &Server{
+		networks: networks,
+		names:    names,
+		tokens:   cfg.Tokens,
+		actions:  newActionLog(cfg.Audit),
+	}, nil
+}
+
+// Handler returns the handler of the admin API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/pause", s.action("pause", s.pause))
+	mux.HandleFunc("POST /admin/resume", s.action("resume", s.resume))
+	mux.HandleFunc("POST /admin/drain", s.action("drain", s.drain))
+	mux.HandleFunc("POST /admin/rewind", s.action("rewind", s.rewind))
+	mux.HandleFunc("POST /admin/backfill", s.action("backfill", s.backfill))
+	mux.HandleFunc("PUT /admin/log-level", s.action("log-level", s.setLogLevel))
+	mux.HandleFunc("GET /admin/actions", s.listActions)
+	return mux
+}
+
+// Serve serves the admin API on address until ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, address string) error {
+	server := &http.Server{Addr: address, Handler: s.Handler(), ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Ctx(ctx).Infof("Serving the admin API on %s", address)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serving admin API: %w", err)
+	}
+	return nil
+}
+
+// Close writes the actions not yet persisted to the audit log.
+func (s *Server) Close() {
+	s.actions.close()
+}
+
+// httpError is an error answered with its status code.
+type httpError struct {
+	status int
+	err    error
+}
+
+func (e *httpError) Error() string { return e.err.Error() }
+func (e *httpError) Unwrap() error { return e.err }
+
+func badRequest(format string, args ...any) error {
+	return &httpError{status: http.StatusBadRequest, err: fmt.Errorf(format, args...)}
+}
+
+// action authenticates the operator, decodes the request, runs do and records the action.
+func (s *Server) action(name string, do func(context.Context, Request) (Response, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		operator, ok := s.authenticate(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="indexer-admin"`)
+			writeError(w, http.StatusUnauthorized, "invalid admin token")
+			s.actions.record(r.Context(), Action{At: start, Action: name, Method: r.Method, Path: r.URL.Path,
+				Status: http.StatusUnauthorized, Error: "invalid admin token", RemoteAddr: remoteHost(r)})
+			return
+		}
+
+		var (
+			req  Request
+			resp Response
+		)
+		err := decodeRequest(r, &req)
+		if err == nil {
+			resp, err = do(r.Context(), req)
+		}
+
+		status := http.StatusOK
+		action := Action{At: start, Operator: operator, Action: name, Method: r.Method, Path: r.URL.Path,
+			Request: req, RemoteAddr: remoteHost(r)}
+		if err != nil {
+			status = statusOf(err)
+			action.Error = err.Error()
+			writeError(w, status, err.Error())
+		} else {
+			writeJSON(w, status, resp)
+		}
+		action.Status = status
+		action.Duration = time.Since(start)
+		s.actions.record(r.Context(), action)
+	}
+}
+
+// authenticate returns the operator of the bearer token of r.
+func (s *Server) authenticate(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, authorizationScheme) {
+		return "", false
+	}
+	token := []byte(strings.TrimPrefix(header, authorizationScheme))
+
+	// Every token is compared, so the time taken doesn't tell which operator matched.
+	var found string
+	for operator, expected := range s.tokens {
+		if subtle.ConstantTimeCompare(token, []byte(expected)) == 1 {
+			found = operator
+		}
+	}
+	return found, found != ""
+}
+
+// decodeRequest decodes the body of r into req. An empty body is an empty request.
+func decodeRequest(r *http.Request, req *Request) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(req); err != nil && !errors.Is(err, io.EOF) {
+		return badRequest("decoding request: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) pause(ctx context.Context, req Request) (Response, error) {
+	return s.forEach(ctx, req, func(ctx context.Context, service services.IngestService) error {
+		return service.Pause(ctx)
+	})
+}
+
+func (s *Server) resume(ctx context.Context, req Request) (Response, error) {
+	return s.forEach(ctx, req, func(_ context.Context, service services.IngestService) error {
+		service.Resume()
+		return nil
+	})
+}
+
+func (s *Server) drain(ctx context.Context, req Request) (Response, error) {
+	return s.forEach(ctx, req, func(ctx context.Context, service services.IngestService) error {
+		return service.Drain(ctx)
+	})
+}
+
+func (s *Server) rewind(_ context.Context, req Request) (Response, error) {
+	if req.Ledger == 0 {
+		return Response{}, badRequest("ledger is required")
+	}
+	network, service, err := s.one(req)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := service.Rewind(req.Ledger); err != nil {
+		return Response{}, fmt.Errorf("rewinding %s: %w", network, err)
+	}
+	return Response{Networks: []string{network}}, nil
+}
+
+func (s *Server) backfill(_ context.Context, req Request) (Response, error) {
+	if req.Start == 0 || req.End < req.Start {
+		return Response{}, badRequest("invalid range [%d, %d]", req.Start, req.End)
+	}
+	network, service, err := s.one(req)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := service.Backfill(services.LedgerRange{Start: req.Start, End: req.End}); err != nil {
+		return Response{}, fmt.Errorf("queuing backfill of %s: %w", network, err)
+	}
+	return Response{Networks: []string{network}}, nil
+}
+
+func (s *Server) setLogLevel(_ context.Context, req Request) (Response, error) {
+	if err := logging.SetLevel(req.Level); err != nil {
+		return Response{}, badRequest("%v", err)
+	}
+	return Response{Level: logging.Level()}, nil
+}
+
+// forEach applies fn to the network of req, or to every network when req names none. It stops
+// at the first failure.
+func (s *Server) forEach(ctx context.Context, req Request, fn func(context.Context, services.IngestService) error) (Response, error) {
+	names := s.names
+	if req.Network != "" {
+		if _, ok := s.networks[req.Network]; !ok {
+			return Response{}, s.unknownNetwork(req.Network)
+		}
+		names = []string{req.Network}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+	resp := Response{Networks: make([]string, 0, len(names))}
+	for _, name := range names {
+		if err := fn(ctx, s.networks[name]); err != nil {
+			return resp, fmt.Errorf("%s: %w", name, err)
+		}
+		resp.Networks = append(resp.Networks, name)
+	}
+	return resp, nil
+}
+
+// one returns the network of req, which may be omitted when a single network is ingested.
+func (s *Server) one(req Request) (string, services.IngestService, error) {
+	if req.Network == "" {
+		if len(s.names) > 1 {
+			return "", nil, badRequest("network is required, one of %s", strings.Join(s.names, ", "))
+		}
+		return s.names[0], s.networks[s.names[0]], nil
+	}
+	service, ok := s.networks[req.Network]
+	if !ok {
+		return "", nil, s.unknownNetwork(req.Network)
+	}
+	return req.Network, service, nil
+}
+
+func (s *Server) unknownNetwork(network string) error {
+	return &httpError{
+		status: http.StatusNotFound,
+		err:    fmt.Errorf("unknown network %q, expected one of %s", network, strings.Join(s.names, ", ")),
+	}
+}
+
+func (s *Server) listActions(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.authenticate(r); !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="indexer-admin"`)
+		writeError(w, http.StatusUnauthorized, "invalid admin token")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.actions.recentFirst())
+}
+
+func statusOf(err error) int {
+	var httpErr *httpError
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr.status
+	case errors.Is(err, services.ErrNotLive):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrLedgerAhead):
+		return http.StatusBadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{message})
+}
@@ -0,0 +1,314 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+const (
+	aliceToken = "alice-token"
+	bobToken   = "bob-token"
+)
+
+// fakeService records the controls it receives, and fails them with err.
+type fakeService struct {
+	mu    sync.Mutex
+	calls []string
+	err   error
+}
+
+var _ services.IngestService = (*fakeService)(nil)
+
+func (s *fakeService) call(format string, args ...any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, fmt.Sprintf(format, args...))
+	return s.err
+}
+
+func (s *fakeService) Prepare(ctx context.Context, startLedger uint32) error { return nil }
+
+func (s *fakeService) Run(ctx context.Context, startLedger, endLedger uint32) error { return nil }
+
+func (s *fakeService) Status(ctx context.Context) services.IngestStatus {
+	return services.IngestStatus{}
+}
+
+func (s *fakeService) Pause(ctx context.Context) error { return s.call("pause") }
+
+func (s *fakeService) Resume() { _ = s.call("resume") }
+
+func (s *fakeService) Rewind(ledger uint32) error { return s.call("rewind %d", ledger) }
+
+func (s *fakeService) Backfill(ledgers services.LedgerRange) error {
+	return s.call("backfill %d-%d", ledgers.Start, ledgers.End)
+}
+
+func (s *fakeService) Drain(ctx context.Context) error { return s.call("drain") }
+
+func (s *fakeService) recorded() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// auditWriter records the audit log entries. Writes block while blocked is open.
+type auditWriter struct {
+	mu      sync.Mutex
+	entries []store.AuditEntry
+	blocked chan struct{}
+}
+
+func (w *auditWriter) WriteAuditLog(ctx context.Context, entries []store.AuditEntry) error {
+	if w.blocked != nil {
+		<-w.blocked
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.entries = append(w.entries, entries...)
+	return nil
+}
+
+func newTestServer(t *testing.T, audit *auditWriter, networks map[string]*fakeService) *Server {
+	t.Helper()
+	ingestServices := make(map[string]services.IngestService, len(networks))
+	for name, service := range networks {
+		ingestServices[name] = service
+	}
+	cfg := Config{Tokens: map[string]string{"alice": aliceToken, "bob": bobToken}}
+	if audit != nil {
+		cfg.Audit = audit
+	}
+	s, err := NewServer(cfg, ingestServices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// do sends a request to the admin API with token, empty for none.
+func do(handler http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.RemoteAddr = "192.0.2.1:1234"
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestAuthentication(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		header       string
+		wantStatus   int
+		wantOperator string
+	}{
+		{"no token", "", http.StatusUnauthorized, ""},
+		{"other scheme", "Basic " + aliceToken, http.StatusUnauthorized, ""},
+		{"wrong token", "Bearer wrong", http.StatusUnauthorized, ""},
+		{"token prefix", "Bearer " + aliceToken[:len(aliceToken)-1], http.StatusUnauthorized, ""},
+		{"empty token", "Bearer ", http.StatusUnauthorized, ""},
+		{"alice", "Bearer " + aliceToken, http.StatusOK, "alice"},
+		{"bob", "Bearer " + bobToken, http.StatusOK, "bob"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &fakeService{}
+			s := newTestServer(t, nil, map[string]*fakeService{"testnet": service})
+			defer s.Close()
+
+			r := httptest.NewRequest(http.MethodPost, "/admin/pause", nil)
+			if tc.header != "" {
+				r.Header.Set("Authorization", tc.header)
+			}
+			w := httptest.NewRecorder()
+			s.Handler().ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+
+			wantCalls := []string{"pause"}
+			if tc.wantStatus == http.StatusUnauthorized {
+				wantCalls = nil
+				if w.Header().Get("WWW-Authenticate") == "" {
+					t.Error("no WWW-Authenticate header")
+				}
+			}
+			if calls := service.recorded(); !slices.Equal(calls, wantCalls) {
+				t.Errorf("service received %v, want %v", calls, wantCalls)
+			}
+			actions := s.actions.recentFirst()
+			if len(actions) != 1 || actions[0].Operator != tc.wantOperator || actions[0].Status != tc.wantStatus {
+				t.Errorf("recorded %+v, want one action of %q with status %d", actions, tc.wantOperator, tc.wantStatus)
+			}
+		})
+	}
+
+	s := newTestServer(t, nil, map[string]*fakeService{"testnet": {}})
+	defer s.Close()
+	if w := do(s.Handler(), http.MethodGet, "/admin/actions", "wrong", ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("listing actions with a wrong token: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestActions(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		networks   []string
+		method     string
+		path       string
+		body       string
+		err        error
+		wantStatus int
+		// wantCalls are the controls received by each network.
+		wantCalls map[string][]string
+	}{
+		{"pause every network", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/pause", "", nil,
+			http.StatusOK, map[string][]string{"testnet": {"pause"}, "pubnet": {"pause"}}},
+		{"pause one network", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/pause", `{"network":"pubnet"}`, nil,
+			http.StatusOK, map[string][]string{"pubnet": {"pause"}}},
+		{"pause timeout", []string{"testnet"}, http.MethodPost, "/admin/pause", "", context.DeadlineExceeded,
+			http.StatusGatewayTimeout, map[string][]string{"testnet": {"pause"}}},
+		{"resume", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/resume", `{"network":"testnet"}`, nil,
+			http.StatusOK, map[string][]string{"testnet": {"resume"}}},
+		{"drain", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/drain", "", nil,
+			http.StatusOK, map[string][]string{"testnet": {"drain"}, "pubnet": {"drain"}}},
+		{"drain failure stops", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/drain", "", fmt.Errorf("sink down"),
+			http.StatusInternalServerError, map[string][]string{"pubnet": {"drain"}}},
+		{"rewind", []string{"testnet"}, http.MethodPost, "/admin/rewind", `{"ledger":50}`, nil,
+			http.StatusOK, map[string][]string{"testnet": {"rewind 50"}}},
+		{"rewind without ledger", []string{"testnet"}, http.MethodPost, "/admin/rewind", `{}`, nil,
+			http.StatusBadRequest, nil},
+		{"rewind without network", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/rewind", `{"ledger":50}`, nil,
+			http.StatusBadRequest, nil},
+		{"rewind ahead", []string{"testnet"}, http.MethodPost, "/admin/rewind", `{"ledger":500}`, services.ErrLedgerAhead,
+			http.StatusBadRequest, map[string][]string{"testnet": {"rewind 500"}}},
+		{"rewind not live", []string{"testnet"}, http.MethodPost, "/admin/rewind", `{"ledger":50}`, services.ErrNotLive,
+			http.StatusConflict, map[string][]string{"testnet": {"rewind 50"}}},
+		{"backfill", []string{"testnet", "pubnet"}, http.MethodPost, "/admin/backfill", `{"network":"testnet","start":10,"end":20}`, nil,
+			http.StatusOK, map[string][]string{"testnet": {"backfill 10-20"}}},
+		{"backfill reversed range", []string{"testnet"}, http.MethodPost, "/admin/backfill", `{"start":20,"end":10}`, nil,
+			http.StatusBadRequest, nil},
+		{"backfill ahead", []string{"testnet"}, http.MethodPost, "/admin/backfill", `{"start":10,"end":20}`, services.ErrLedgerAhead,
+			http.StatusBadRequest, map[string][]string{"testnet": {"backfill 10-20"}}},
+		{"unknown network", []string{"testnet"}, http.MethodPost, "/admin/pause", `{"network":"futurenet"}`, nil,
+			http.StatusNotFound, nil},
+		{"unknown field", []string{"testnet"}, http.MethodPost, "/admin/pause", `{"networks":"testnet"}`, nil,
+			http.StatusBadRequest, nil},
+		{"log level", []string{"testnet"}, http.MethodPut, "/admin/log-level", `{"level":"debug"}`, nil,
+			http.StatusOK, nil},
+		{"invalid log level", []string{"testnet"}, http.MethodPut, "/admin/log-level", `{"level":"loud"}`, nil,
+			http.StatusBadRequest, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			level := logging.Level()
+			defer func() { _ = logging.SetLevel(level) }()
+
+			networks := make(map[string]*fakeService, len(tc.networks))
+			for _, name := range tc.networks {
+				networks[name] = &fakeService{err: tc.err}
+			}
+			s := newTestServer(t, nil, networks)
+			defer s.Close()
+
+			w := do(s.Handler(), tc.method, tc.path, aliceToken, tc.body)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+			for name, service := range networks {
+				if calls := service.recorded(); !slices.Equal(calls, tc.wantCalls[name]) {
+					t.Errorf("%s received %v, want %v", name, calls, tc.wantCalls[name])
+				}
+			}
+			actions := s.actions.recentFirst()
+			if len(actions) != 1 || actions[0].Status != tc.wantStatus || (tc.wantStatus != http.StatusOK) != (actions[0].Error != "") {
+				t.Errorf("recorded %+v, want one action with status %d", actions, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestActionsRecorded(t *testing.T) {
+	audit := &auditWriter{}
+	s := newTestServer(t, audit, map[string]*fakeService{"testnet": {}})
+	handler := s.Handler()
+
+	do(handler, http.MethodPost, "/admin/pause", aliceToken, "")
+	do(handler, http.MethodPost, "/admin/backfill", bobToken, `{"start":10,"end":20}`)
+	do(handler, http.MethodPost, "/admin/resume", "wrong", "")
+
+	w := do(handler, http.MethodGet, "/admin/actions", aliceToken, "")
+	var actions []Action
+	if err := json.NewDecoder(w.Body).Decode(&actions); err != nil {
+		t.Fatalf("decoding actions: %v", err)
+	}
+	var got []string
+	for _, action := range actions {
+		got = append(got, fmt.Sprintf("%s %s %d", action.Operator, action.Action, action.Status))
+	}
+	want := []string{" resume 401", "bob backfill 200", "alice pause 200"}
+	if !slices.Equal(got, want) {
+		t.Errorf("listed actions %q, want %q", got, want)
+	}
+
+	s.Close()
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+	got = nil
+	for _, entry := range audit.entries {
+		got = append(got, fmt.Sprintf("%s %s %s %d", entry.KeyID, entry.Path, entry.Query, entry.Status))
+	}
+	want = []string{
+		"admin:alice /admin/pause  200",
+		"admin:bob /admin/backfill end=20&start=10 200",
+		" /admin/resume  401",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("audited %q, want %q", got, want)
+	}
+}
+
+func TestActionsRecordedWhenAuditQueueFull(t *testing.T) {
+	cfg := auditConfig
+	defer func() { auditConfig = cfg }()
+	auditConfig.QueueSize = 1
+	auditConfig.BatchSize = 1
+
+	audit := &auditWriter{blocked: make(chan struct{})}
+	service := &fakeService{}
+	s := newTestServer(t, audit, map[string]*fakeService{"testnet": service})
+	handler := s.Handler()
+
+	const requests = 10
+	for i := range requests {
+		if w := do(handler, http.MethodPost, "/admin/rewind", aliceToken, fmt.Sprintf(`{"ledger":%d}`, i+1)); w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want %d", i, w.Code, http.StatusOK)
+		}
+	}
+	if calls := service.recorded(); len(calls) != requests {
+		t.Errorf("service received %d controls, want %d", len(calls), requests)
+	}
+	if actions := s.actions.recentFirst(); len(actions) != requests || actions[0].Request.Ledger != requests {
+		t.Errorf("recorded %d actions, want %d, the latest first", len(actions), requests)
+	}
+
+	close(audit.blocked)
+	s.Close()
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+	if len(audit.entries) == 0 || len(audit.entries) >= requests {
+		t.Errorf("audited %d actions, want some dropped once the queue is full", len(audit.entries))
+	}
+}
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const clientTimeout = waitTimeout + 10*time.Second
+
+// Client calls the admin API of a running ingestion.
+type Client struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the admin API served on url, e.g. "http://localhost:9091".
+func NewClient(url, token string) (*Client, error) {
+	if url == "" {
+		return nil, errors.New("admin URL is required")
+	}
+	if token == "" {
+		return nil, errors.New("admin token is required")
+	}
+	return &Client{
+		url:        strings.TrimSuffix(url, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: clientTimeout},
+	}, nil
+}
+
+func (c *Client) Pause(ctx context.Context, network string) (Response, error) {
+	return c.do(ctx, http.MethodPost, "/admin/pause", Request{Network: network})
+}
+
+func (c *Client) Resume(ctx context.Context, network string) (Response, error) {
+	return c.do(ctx, http.MethodPost, "/admin/resume", Request{Network: network})
+}
+
+func (c *Client) Drain(ctx context.Context, network string) (Response, error) {
+	return c.do(ctx, http.MethodPost, "/admin/drain", Request{Network: network})
+}
+
+func (c *Client) Rewind(ctx context.Context, network string, ledger uint32) (Response, error) {
+	return c.do(ctx, http.MethodPost, "/admin/rewind", Request{Network: network, Ledger: ledger})
+}
+
+func (c *Client) Backfill(ctx context.Context, network string, start, end uint32) (Response, error) {
+	return c.do(ctx, http.MethodPost, "/admin/backfill", Request{Network: network, Start: start, End: end})
+}
+
+func (c *Client) SetLogLevel(ctx context.Context, level string) (Response, error) {
+	return c.do(ctx, http.MethodPut, "/admin/log-level", Request{Level: level})
+}
+
+// Actions returns the recent admin actions, the latest first.
+func (c *Client) Actions(ctx context.Context) ([]Action, error) {
+	var actions []Action
+	if err := c.call(ctx, http.MethodGet, "/admin/actions", nil, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, req Request) (Response, error) {
+	var resp Response
+	if err := c.call(ctx, method, path, &req, &resp); err != nil {
+		return Response{}, err
+	}
+	return resp, nil
+}
+
+func (c *Client) call(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reader)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", authorizationScheme+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s %s: %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&apiErr); err != nil || apiErr.Error == "" {
+			return fmt.Errorf("%s %s: %s", method, path, res.Status)
+		}
+		return fmt.Errorf("%s %s: %s: %s", method, path, res.Status, apiErr.Error)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
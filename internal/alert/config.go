@@ -0,0 +1,169 @@
+package alert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Types of conditions.
+const (
+	// ConditionLag fires when the ledger lag of a network stays above Ledgers for For, outside
+	// backfills.
+	ConditionLag = "lag"
+	// ConditionProcessorErrorRate fires when the processors fail more than PerMinute times a
+	// minute, averaged over Window, for For.
+	ConditionProcessorErrorRate = "processor_error_rate"
+	// ConditionEscrowDisputed fires on the ledgers opening a dispute on an escrow or milestone.
+	ConditionEscrowDisputed = "escrow_disputed"
+	// ConditionEscrowAmount fires on the ledgers deploying an escrow of more than Threshold.
+	ConditionEscrowAmount = "escrow_amount"
+)
+
+// Types of actions.
+const (
+	// ActionWebhook posts the Alert as JSON to URL.
+	ActionWebhook = "webhook"
+	// ActionSlack posts a Slack-compatible {"text": ...} message to URL, e.g. an incoming webhook.
+	ActionSlack = "slack"
+	// ActionEmail sends the alert by email through an SMTP server.
+	ActionEmail = "email"
+)
+
+const defaultErrorRateWindow = 5 * time.Minute
+
+// Config is the declarative configuration of the alerting rules.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule sends its actions when its condition is met.
+type Rule struct {
+	// Name identifies the rule in the alerts, logs and metrics.
+	Name string `json:"name"`
+	// Network restricts the rule to a network. Empty evaluates it on every network.
+	Network   string          `json:"network,omitempty"`
+	Condition ConditionConfig `json:"condition"`
+	Actions   []ActionConfig  `json:"actions"`
+}
+
+// ConditionConfig is the condition of a rule. Each type reads the fields it needs.
+type ConditionConfig struct {
+	Type string `json:"type"`
+	// For is how long a lag or processor_error_rate condition holds before it fires, e.g. "5m".
+	For Duration `json:"for,omitempty"`
+	// Ledgers is the lag above which lag holds.
+	Ledgers uint32 `json:"ledgers,omitempty"`
+	// PerMinute is the processor errors a minute above which processor_error_rate holds.
+	PerMinute float64 `json:"per_minute,omitempty"`
+	// Window is the period the error rate is averaged over. Defaults to 5m.
+	Window Duration `json:"window,omitempty"`
+	// Processor restricts processor_error_rate to the errors of one processor.
+	Processor string `json:"processor,omitempty"`
+	// Platform restricts the escrow conditions to the escrows of a platform address.
+	Platform string `json:"platform,omitempty"`
+	// Threshold is the amount, in the smallest unit of the token, above which escrow_amount
+	// fires. The amount of a multi-release escrow is the sum of its milestones.
+	Threshold uint64 `json:"threshold,omitempty"`
+}
+
+// ActionConfig is an action of a rule. Each type reads the fields it needs.
+type ActionConfig struct {
+	Type string `json:"type"`
+	// URL is where webhook and slack post.
+	URL string `json:"url,omitempty"`
+	// Headers are added to the requests of webhook, e.g. an authorization header.
+	Headers map[string]string `json:"headers,omitempty"`
+	// SMTP configures email.
+	SMTP SMTPConfig `json:"smtp,omitempty"`
+}
+
+// SMTPConfig is the server and recipients of the email action.
+type SMTPConfig struct {
+	// Address is the host:port of the SMTP server.
+	Address  string `json:"address"`
+	Username string `json:"username,omitempty"`
+	// PasswordEnv is the environment variable holding the password, kept out of the config.
+	PasswordEnv string   `json:"password_env,omitempty"`
+	From        string   `json:"from"`
+	To          []string `json:"to"`
+}
+
+// Duration is a time.Duration written as a string in the config, e.g. "5m".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string like \"5m\": %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadConfig reads the rules from a JSON file.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading alerting rules: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("decoding alerting rules %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (r Rule) validate() error {
+	if r.Name == "" {
+		return errors.New("rule has no name")
+	}
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("rule %q has no action", r.Name)
+	}
+
+	c := r.Condition
+	switch c.Type {
+	case ConditionLag:
+		if c.Ledgers == 0 {
+			return fmt.Errorf("rule %q: lag requires ledgers", r.Name)
+		}
+	case ConditionProcessorErrorRate:
+		if c.PerMinute <= 0 {
+			return fmt.Errorf("rule %q: processor_error_rate requires a positive per_minute", r.Name)
+		}
+	case ConditionEscrowDisputed:
+	case ConditionEscrowAmount:
+		if c.Threshold == 0 {
+			return fmt.Errorf("rule %q: escrow_amount requires threshold", r.Name)
+		}
+	default:
+		return fmt.Errorf("rule %q: unknown condition %q", r.Name, c.Type)
+	}
+
+	for _, a := range r.Actions {
+		switch a.Type {
+		case ActionWebhook, ActionSlack:
+			if a.URL == "" {
+				return fmt.Errorf("rule %q: %s requires url", r.Name, a.Type)
+			}
+		case ActionEmail:
+			if a.SMTP.Address == "" || a.SMTP.From == "" || len(a.SMTP.To) == 0 {
+				return fmt.Errorf("rule %q: email requires smtp address, from and to", r.Name)
+			}
+		default:
+			return fmt.Errorf("rule %q: unknown action %q", r.Name, a.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+// Package alert evaluates alerting rules on the ingested ledgers and notifies their actions.
+//
+// Rules are declared in a Config, usually loaded from a JSON file. Their conditions come in two
+// kinds:
+//
+//   - escrow conditions (escrow_disputed, escrow_amount) match the disputes and deployments of
+//     escrows in the buffer of every ledger written to the sink, and fire once per matching
+//     ledger;
+//   - metric conditions (lag, processor_error_rate) read the ingest metrics on every ledger and
+//     every 15 seconds, so a stalled ingestion still alerts. They fire once they held for the
+//     For of the rule, and notify again when they resolve. The lag doesn't hold while a
+//     backfill interrupts the live ingestion.
+//
+// Notifications are delivered off the ingestion path, one at a time. A failed delivery is
+// logged and counted, never retried.
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// States of an alert.
+const (
+	StateFiring   = "firing"
+	StateResolved = "resolved"
+)
+
+const (
+	evaluationInterval = 15 * time.Second
+	notifyQueueSize    = 256
+	notifyTimeout      = 10 * time.Second
+
+	// Metrics read by the metric conditions, see metrics.LedgerLag, metrics.Backfilling and
+	// metrics.ProcessorErrors.
+	ledgerLagMetric       = "indexer_ingest_ledger_lag"
+	backfillingMetric     = "indexer_ingest_backfilling"
+	processorErrorsMetric = "indexer_processor_errors_total"
+)
+
+// Alert is a notification of a rule.
+type Alert struct {
+	Rule    string `json:"rule"`
+	State   string `json:"state"`
+	Network string `json:"network"`
+	// Ledger is the ledger the alert was raised on, 0 when raised between two ledgers.
+	Ledger  uint32 `json:"ledger,omitempty"`
+	Summary string `json:"summary"`
+	// Escrows are the contract IDs of the escrows matching an escrow condition.
+	Escrows []string  `json:"escrows,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// Engine evaluates the rules on the ledgers of every network.
+type Engine struct {
+	rules    []*rule
+	networks []string
+
+	// mu guards the state of the metric conditions, evaluated by every network and the ticker.
+	mu sync.Mutex
+
+	// closeMu guards closed, so no alert is queued once the deliveries are closed.
+	closeMu    sync.RWMutex
+	closed     bool
+	stop       chan struct{}
+	deliveries chan delivery
+	wg         sync.WaitGroup
+}
+
+type rule struct {
+	Rule
+	notifiers []notifier
+	// states are the states of a metric condition, by network.
+	states map[string]*conditionState
+}
+
+type conditionState struct {
+	since   time.Time
+	firing  bool
+	samples []sample
+}
+
+type sample struct {
+	at    time.Time
+	value float64
+}
+
+type delivery struct {
+	alert     Alert
+	notifiers []notifier
+}
+
+// NewEngine validates the rules of the networks ingested. Close stops the delivery of the
+// notifications.
+func NewEngine(cfg Config, networks []string) (*Engine, error) {
+	httpClient := &http.Client{Timeout: notifyTimeout}
+	e := &Engine{networks: networks, stop: make(chan struct{}), deliveries: make(chan delivery, notifyQueueSize)}
+	seen := make(map[string]bool, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		if err := r.validate(); err != nil {
+			return nil, err
+		}
+		if seen[r.Name] {
+			return nil, fmt.Errorf("rule %q is declared twice", r.Name)
+		}
+		seen[r.Name] = true
+
+		notifiers := make([]notifier, 0, len(r.Actions))
+		for _, action := range r.Actions {
+			notifiers = append(notifiers, newNotifier(action, httpClient))
+		}
+		e.rules = append(e.rules, &rule{Rule: r, notifiers: notifiers, states: make(map[string]*conditionState)})
+	}
+
+	e.wg.Add(1)
+	go e.deliver()
+	return e, nil
+}
+
+// Run evaluates the metric conditions periodically until ctx is cancelled or the engine closed.
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(evaluationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stop:
+			return
+		case now := <-ticker.C:
+			for _, network := range e.networks {
+				e.evaluateMetrics(ctx, network, 0, now)
+			}
+		}
+	}
+}
+
+// Close stops Run and delivers the queued notifications. Alerts raised afterwards are only
+// logged.
+func (e *Engine) Close() {
+	e.closeMu.Lock()
+	if e.closed {
+		e.closeMu.Unlock()
+		return
+	}
+	e.closed = true
+	close(e.stop)
+	close(e.deliveries)
+	e.closeMu.Unlock()
+	e.wg.Wait()
+}
+
+// ObserveLedger evaluates the rules on a ledger written to the sink.
+func (e *Engine) ObserveLedger(ctx context.Context, network string, ledgerSeq uint32, buffer *indexer.IndexerBuffer) {
+	now := time.Now()
+	escrows := buffer.GetEscrows()
+	for _, r := range e.rules {
+		if !r.appliesTo(network) {
+			continue
+		}
+		var matched []string
+		switch r.Condition.Type {
+		case ConditionEscrowDisputed:
+			matched = matchEscrows(escrows, r.Condition.Platform, entities.Escrow.IsDispute)
+		case ConditionEscrowAmount:
+			// Only deployments, or every later change of the escrow would fire again.
+			matched = matchEscrows(escrows, r.Condition.Platform, func(escrow entities.Escrow) bool {
+				return !escrow.IsUpdate() && escrowAmount(escrow) > r.Condition.Threshold
+			})
+		default:
+			continue
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		e.notify(ctx, r, Alert{
+			Rule:    r.Name,
+			State:   StateFiring,
+			Network: network,
+			Ledger:  ledgerSeq,
+			Summary: r.describeEscrows(network, ledgerSeq, len(matched)),
+			Escrows: matched,
+			At:      now,
+		})
+	}
+	e.evaluateMetrics(ctx, network, ledgerSeq, now)
+}
+
+// evaluateMetrics evaluates the metric conditions of a network, firing those that held for
+// their For and resolving those that no longer hold.
+func (e *Engine) evaluateMetrics(ctx context.Context, network string, ledgerSeq uint32, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, r := range e.rules {
+		if !r.appliesTo(network) || !r.isMetric() {
+			continue
+		}
+		state, ok := r.states[network]
+		if !ok {
+			state = &conditionState{}
+			r.states[network] = state
+		}
+		value, holds, err := r.measure(network, state, now)
+		if err != nil {
+			log.Ctx(ctx).Errorf("Evaluating alerting rule %q on %s: %v", r.Name, network, err)
+			continue
+		}
+
+		alert := Alert{Rule: r.Name, Network: network, Ledger: ledgerSeq, At: now}
+		switch {
+		case holds:
+			if state.since.IsZero() {
+				state.since = now
+			}
+			if state.firing || now.Sub(state.since) < time.Duration(r.Condition.For) {
+				continue
+			}
+			state.firing = true
+			alert.State = StateFiring
+		case state.firing:
+			state.since, state.firing = time.Time{}, false
+			alert.State = StateResolved
+		default:
+			state.since = time.Time{}
+			continue
+		}
+		alert.Summary = r.describeMetric(network, value, alert.State)
+		e.notify(ctx, r, alert)
+	}
+}
+
+// notify logs the alert and queues its delivery. When the queue is full, the alert is only
+// logged.
+func (e *Engine) notify(ctx context.Context, r *rule, alert Alert) {
+	metrics.AlertsRaised.WithLabelValues(r.Name, alert.State).Inc()
+	log.Ctx(ctx).WithFields(log.F{
+		logging.FieldEvent:   "alert",
+		logging.FieldNetwork: alert.Network,
+		"rule":               alert.Rule,
+		"state":              alert.State,
+	}).Warn(alert.Summary)
+
+	e.closeMu.RLock()
+	defer e.closeMu.RUnlock()
+	if e.closed {
+		return
+	}
+	select {
+	case e.deliveries <- delivery{alert: alert, notifiers: r.notifiers}:
+	default:
+		log.Ctx(ctx).Warnf("Alert queue full, alert of rule %q not delivered", r.Name)
+	}
+}
+
+func (e *Engine) deliver() {
+	defer e.wg.Done()
+
+	for d := range e.deliveries {
+		for _, n := range d.notifiers {
+			ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+			if err := n.notify(ctx, d.alert); err != nil {
+				metrics.AlertDeliveryErrors.WithLabelValues(d.alert.Rule, n.kind()).Inc()
+				log.Ctx(ctx).Errorf("Delivering alert of rule %q with %s: %v", d.alert.Rule, n.kind(), err)
+			}
+			cancel()
+		}
+	}
+}
+
+func (r *rule) appliesTo(network string) bool {
+	return r.Network == "" || r.Network == network
+}
+
+func (r *rule) isMetric() bool {
+	return r.Condition.Type == ConditionLag || r.Condition.Type == ConditionProcessorErrorRate
+}
+
+// measure returns the value of a metric condition and whether the condition holds.
+func (r *rule) measure(network string, state *conditionState, now time.Time) (float64, bool, error) {
+	switch r.Condition.Type {
+	case ConditionLag:
+		labels := map[string]string{"network": network}
+		// The live ingestion waits for the backfills, its lag is expected to grow meanwhile.
+		backfilling, _, err := metrics.Sum(backfillingMetric, labels)
+		if err != nil {
+			return 0, false, err
+		}
+		lag, found, err := metrics.Sum(ledgerLagMetric, labels)
+		return lag, found && backfilling == 0 && lag > float64(r.Condition.Ledgers), err
+	case ConditionProcessorErrorRate:
+		labels := map[string]string{"network": network}
+		if r.Condition.Processor != "" {
+			labels["processor"] = r.Condition.Processor
+		}
+		total, _, err := metrics.Sum(processorErrorsMetric, labels)
+		if err != nil {
+			return 0, false, err
+		}
+		rate := state.errorRate(now, total, r.window())
+		return rate, rate > r.Condition.PerMinute, nil
+	default:
+		return 0, false, errors.New("not a metric condition")
+	}
+}
+
+func (r *rule) window() time.Duration {
+	if r.Condition.Window > 0 {
+		return time.Duration(r.Condition.Window)
+	}
+	return defaultErrorRateWindow
+}
+
+// errorRate records the error count and returns the errors a minute over the window, counting
+// from the oldest sample of the window.
+func (s *conditionState) errorRate(now time.Time, total float64, window time.Duration) float64 {
+	s.samples = append(s.samples, sample{at: now, value: total})
+	for len(s.samples) > 1 && now.Sub(s.samples[1].at) >= window {
+		s.samples = s.samples[1:]
+	}
+	// A counter reset, on restart, starts the window over.
+	if total < s.samples[0].value {
+		s.samples = s.samples[len(s.samples)-1:]
+	}
+	return (total - s.samples[0].value) / window.Minutes()
+}
+
+func (r *rule) describeEscrows(network string, ledgerSeq uint32, count int) string {
+	var what string
+	switch r.Condition.Type {
+	case ConditionEscrowDisputed:
+		what = "disputed escrow(s)"
+	case ConditionEscrowAmount:
+		what = fmt.Sprintf("escrow(s) above %d", r.Condition.Threshold)
+	}
+	if r.Condition.Platform != "" {
+		what += " of platform " + r.Condition.Platform
+	}
+	return fmt.Sprintf("%d %s in ledger %d of %s", count, what, ledgerSeq, network)
+}
+
+func (r *rule) describeMetric(network string, value float64, state string) string {
+	c := r.Condition
+	switch {
+	case c.Type == ConditionLag && state == StateFiring:
+		return fmt.Sprintf("Ledger lag of %s is %.0f ledgers, above %d for %v", network, value, c.Ledgers, time.Duration(c.For))
+	case c.Type == ConditionLag:
+		return fmt.Sprintf("Ledger lag of %s is back to %.0f ledgers", network, value)
+	}
+
+	processors := "Processors"
+	if c.Processor != "" {
+		processors = "Processor " + c.Processor
+	}
+	if state == StateFiring {
+		return fmt.Sprintf("%s of %s failed %.2f times a minute over %v, above %g", processors, network, value, r.window(), c.PerMinute)
+	}
+	return fmt.Sprintf("%s of %s failed %.2f times a minute over %v", processors, network, value, r.window())
+}
+
+// matchEscrows returns the contract IDs of the escrows of platform, or of every platform when
+// empty, that match.
+func matchEscrows(escrows []entities.Escrow, platform string, match func(entities.Escrow) bool) []string {
+	var matched []string
+	for _, escrow := range escrows {
+		if platform != "" && !strings.EqualFold(escrow.Roles.PlatformAddress, platform) {
+			continue
+		}
+		if match(escrow) {
+			matched = append(matched, escrow.ContractID)
+		}
+	}
+	return matched
+}
+
+// escrowAmount is the amount of a single-release escrow, or the sum of the milestones of a
+// multi-release escrow.
+func escrowAmount(escrow entities.Escrow) uint64 {
+	if escrow.EscrowType != entities.EscrowTypeMultiRelease {
+		return escrow.Amount
+	}
+	var amount uint64
+	for _, milestone := range escrow.Milestones {
+		amount += milestone.Amount
+	}
+	return amount
+}
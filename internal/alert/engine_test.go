@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+)
+
+// recordingNotifier records the alerts it is handed.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (n *recordingNotifier) kind() string { return "test" }
+
+func (n *recordingNotifier) notify(ctx context.Context, alert Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+// newTestEngine returns an engine evaluating condition on the network, and the alerts it
+// delivered once closed.
+func newTestEngine(t *testing.T, network string, condition ConditionConfig) (*Engine, func() []Alert) {
+	t.Helper()
+	e, err := NewEngine(Config{Rules: []Rule{{
+		Name:      "test",
+		Condition: condition,
+		Actions:   []ActionConfig{{Type: ActionWebhook, URL: "http://localhost"}},
+	}}}, []string{network})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &recordingNotifier{}
+	e.rules[0].notifiers = []notifier{n}
+	return e, func() []Alert {
+		e.Close()
+		return n.alerts
+	}
+}
+
+func TestEscrowDisputedFiresOnDisputes(t *testing.T) {
+	e, alerts := newTestEngine(t, "testnet", ConditionConfig{Type: ConditionEscrowDisputed})
+
+	disputed := entities.EscrowFlags{Disputed: true}
+	ledgers := [][]entities.Escrow{
+		// A change of an escrow already disputed doesn't open a dispute.
+		{{ContractID: "CFUNDED", Flags: disputed, Function: "fund_escrow"}},
+		{{ContractID: "CDISPUTED", Flags: disputed, Function: entities.FunctionDisputeEscrow}},
+		{{ContractID: "CMILESTONE", Function: entities.FunctionDisputeMilestone,
+			Milestones: []entities.Milestone{{Flags: &disputed}}}},
+	}
+	for i, escrows := range ledgers {
+		buffer := indexer.NewIndexerBuffer()
+		for _, escrow := range escrows {
+			buffer.PushEscrow(escrow)
+		}
+		e.ObserveLedger(context.Background(), "testnet", uint32(100+i), buffer)
+	}
+
+	got := alerts()
+	if len(got) != 2 || got[0].Escrows[0] != "CDISPUTED" || got[1].Escrows[0] != "CMILESTONE" {
+		t.Errorf("alerts = %+v, want the disputes of CDISPUTED and CMILESTONE", got)
+	}
+}
+
+func TestLagDoesNotHoldDuringBackfills(t *testing.T) {
+	network := "lag-test"
+	e, alerts := newTestEngine(t, network, ConditionConfig{Type: ConditionLag, Ledgers: 10})
+	defer metrics.LedgerLag.DeleteLabelValues(network)
+	defer metrics.Backfilling.DeleteLabelValues(network)
+
+	metrics.LedgerLag.WithLabelValues(network).Set(500)
+	metrics.Backfilling.WithLabelValues(network).Set(1)
+	e.evaluateMetrics(context.Background(), network, 0, time.Now())
+	if state := e.rules[0].states[network]; state.firing || !state.since.IsZero() {
+		t.Fatalf("lag held during a backfill")
+	}
+
+	metrics.Backfilling.WithLabelValues(network).Set(0)
+	e.evaluateMetrics(context.Background(), network, 0, time.Now())
+	if got := alerts(); len(got) != 1 || got[0].State != StateFiring {
+		t.Errorf("alerts = %+v, want the lag firing once the backfill is over", got)
+	}
+}
@@ -0,0 +1,163 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+)
+
+// notifier delivers the alerts of an action.
+type notifier interface {
+	kind() string
+	notify(ctx context.Context, alert Alert) error
+}
+
+func newNotifier(action ActionConfig, httpClient *http.Client) notifier {
+	switch action.Type {
+	case ActionSlack:
+		return &slackNotifier{url: action.URL, httpClient: httpClient}
+	case ActionEmail:
+		return &emailNotifier{cfg: action.SMTP}
+	default:
+		return &webhookNotifier{url: action.URL, headers: action.Headers, httpClient: httpClient}
+	}
+}
+
+type webhookNotifier struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+func (n *webhookNotifier) kind() string { return ActionWebhook }
+
+func (n *webhookNotifier) notify(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, n.httpClient, n.url, n.headers, alert)
+}
+
+type slackNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (n *slackNotifier) kind() string { return ActionSlack }
+
+func (n *slackNotifier) notify(ctx context.Context, alert Alert) error {
+	text := fmt.Sprintf("*[%s] %s*\n%s", strings.ToUpper(alert.State), alert.Rule, alert.Summary)
+	if len(alert.Escrows) > 0 {
+		text += "\nEscrows: " + strings.Join(alert.Escrows, ", ")
+	}
+	return postJSON(ctx, n.httpClient, n.url, nil, struct {
+		Text string `json:"text"`
+	}{text})
+}
+
+func postJSON(ctx context.Context, httpClient *http.Client, url string, headers map[string]string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting alert: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("posting alert: unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+type emailNotifier struct {
+	cfg SMTPConfig
+}
+
+func (n *emailNotifier) kind() string { return ActionEmail }
+
+// notify sends the alert through the SMTP server, upgrading the connection with STARTTLS when
+// the server supports it. Authentication requires it, as net/smtp refuses to send credentials
+// in the clear to a remote server.
+func (n *emailNotifier) notify(ctx context.Context, alert Alert) error {
+	host, _, err := net.SplitHostPort(n.cfg.Address)
+	if err != nil {
+		return fmt.Errorf("parsing SMTP address: %w", err)
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.cfg.Address)
+	if err != nil {
+		return fmt.Errorf("connecting to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("greeting SMTP server: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(nil); err != nil {
+			return fmt.Errorf("starting TLS: %w", err)
+		}
+	}
+	if n.cfg.Username != "" {
+		auth := smtp.PlainAuth("", n.cfg.Username, os.Getenv(n.cfg.PasswordEnv), host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+	if err := client.Mail(n.cfg.From); err != nil {
+		return fmt.Errorf("sending MAIL: %w", err)
+	}
+	for _, to := range n.cfg.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("sending RCPT %s: %w", to, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("sending DATA: %w", err)
+	}
+	if _, err := w.Write(n.message(alert)); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("sending message: %w", err)
+	}
+	return client.Quit()
+}
+
+func (n *emailNotifier) message(alert Alert) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(n.cfg.To, ", "))
+	fmt.Fprintf(&b, "Subject: [%s] %s on %s\r\n", strings.ToUpper(alert.State), alert.Rule, alert.Network)
+	fmt.Fprintf(&b, "Date: %s\r\n", alert.At.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(alert.Summary + "\r\n")
+	if alert.Ledger > 0 {
+		fmt.Fprintf(&b, "\r\nLedger: %d\r\n", alert.Ledger)
+	}
+	for _, escrow := range alert.Escrows {
+		fmt.Fprintf(&b, "Escrow: %s\r\n", escrow)
+	}
+	return []byte(b.String())
+}
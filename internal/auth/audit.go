@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/batch"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// auditConfig configures the writer of the audit log.
+var auditConfig = batch.Config{
+	Name:          "audit log entries",
+	QueueSize:     4096,
+	BatchSize:     500,
+	FlushInterval: time.Second,
+	WriteTimeout:  10 * time.Second,
+}
+
+// AuditWriter persists the audit log.
+type AuditWriter interface {
+	WriteAuditLog(ctx context.Context, entries []store.AuditEntry) error
+}
+
+// auditLogger writes the audit entries in batches, off the request path. When the store
+// can't keep up and the queue is full, or once the logger is closed, entries are logged
+// instead of being written.
+type auditLogger struct {
+	entries *batch.Writer[store.AuditEntry]
+}
+
+func newAuditLogger(writer AuditWriter) *auditLogger {
+	return &auditLogger{entries: batch.NewWriter(auditConfig, writer.WriteAuditLog)}
+}
+
+func (l *auditLogger) record(ctx context.Context, entry store.AuditEntry) {
+	if err := l.entries.Add(entry); err != nil {
+		log.Ctx(ctx).WithFields(log.F{
+			"key_id": entry.KeyID,
+			"method": entry.Method,
+			"path":   entry.Path,
+			"status": entry.Status,
+		}).Warnf("Audit log %v, request not persisted", err)
+	}
+}
+
+// close writes the pending entries.
+func (l *auditLogger) close() {
+	l.entries.Close()
+}
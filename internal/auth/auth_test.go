@@ -0,0 +1,353 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/keypair"
+)
+
+// fakeKeyStore holds the keys in memory and records the reads and the audit log.
+type fakeKeyStore struct {
+	mu    sync.Mutex
+	keys  map[string]*store.APIKey
+	reads int
+	audit []store.AuditEntry
+}
+
+var _ KeyStore = (*fakeKeyStore)(nil)
+
+func newFakeKeyStore() *fakeKeyStore {
+	return &fakeKeyStore{keys: make(map[string]*store.APIKey)}
+}
+
+func (s *fakeKeyStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key.ID] = key
+	return nil
+}
+
+func (s *fakeKeyStore) GetAPIKey(ctx context.Context, id string) (*store.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reads++
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return key, nil
+}
+
+func (s *fakeKeyStore) WriteAuditLog(ctx context.Context, entries []store.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.audit = append(s.audit, entries...)
+	return nil
+}
+
+func (s *fakeKeyStore) createKey(t *testing.T, rateLimit float64, burst int, platforms ...string) (string, *store.APIKey) {
+	t.Helper()
+	plaintext, key, err := CreateKey(context.Background(), s, "test", platforms, rateLimit, burst)
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	return plaintext, key
+}
+
+func TestParseKey(t *testing.T) {
+	id := strings.Repeat("a", 2*idBytes)
+	secret := strings.Repeat("b", 2*secretBytes)
+	for _, tc := range []struct {
+		name      string
+		plaintext string
+		wantErr   bool
+	}{
+		{"valid", "twk_" + id + "_" + secret, false},
+		{"empty", "", true},
+		{"prefix only", "twk_", true},
+		{"no prefix", id + "_" + secret, true},
+		{"other prefix", "key_" + id + "_" + secret, true},
+		{"no separator", "twk_" + id + secret, true},
+		{"short id", "twk_" + id[1:] + "_" + secret, true},
+		{"long id", "twk_" + id + "a_" + secret, true},
+		{"short secret", "twk_" + id + "_" + secret[1:], true},
+		{"long secret", "twk_" + id + "_" + secret + "b", true},
+		{"extra part", "twk_" + id + "_" + secret + "_c", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gotID, gotSecret, err := parseKey(tc.plaintext)
+			if tc.wantErr {
+				if err != ErrInvalidKey {
+					t.Fatalf("parseKey(%q) error = %v, want %v", tc.plaintext, err, ErrInvalidKey)
+				}
+				return
+			}
+			if err != nil || gotID != id || gotSecret != secret {
+				t.Fatalf("parseKey(%q) = %q, %q, %v, want %q, %q", tc.plaintext, gotID, gotSecret, err, id, secret)
+			}
+		})
+	}
+}
+
+func TestVerifySecret(t *testing.T) {
+	secret := strings.Repeat("0123456789abcdef", 4)
+	key := &store.APIKey{SecretHash: hashSecret(secret)}
+	for _, tc := range []struct {
+		name   string
+		secret string
+		want   bool
+	}{
+		{"same secret", secret, true},
+		{"last character differs", secret[:len(secret)-1] + "0", false},
+		{"first character differs", "1" + secret[1:], false},
+		{"prefix", secret[:len(secret)-1], false},
+		{"empty", "", false},
+		// The stored hash itself isn't a secret.
+		{"hash", key.SecretHash, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := verifySecret(key, tc.secret); got != tc.want {
+				t.Errorf("verifySecret() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// serve sends a request to the middleware from remoteAddr, with the key in header, and returns
+// the response and the principal the handler saw.
+func serve(a *Authenticator, path, remoteAddr, header, key string) (*httptest.ResponseRecorder, *Principal) {
+	var principal *Principal
+	handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal = FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	r.RemoteAddr = remoteAddr
+	if key != "" {
+		r.Header.Set(header, key)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w, principal
+}
+
+func TestMiddleware(t *testing.T) {
+	keys := newFakeKeyStore()
+	platform := keypair.MustRandom().Address()
+	valid, validKey := keys.createKey(t, 100, 100)
+	scoped, scopedKey := keys.createKey(t, 100, 100, platform)
+	revoked, revokedKey := keys.createKey(t, 100, 100)
+	revokedAt := time.Now()
+	revokedKey.RevokedAt = &revokedAt
+	id, _, _ := parseKey(valid)
+	wrongSecret := keyPrefix + id + "_" + strings.Repeat("0", 2*secretBytes)
+	unknownID := keyPrefix + strings.Repeat("0", 2*idBytes) + valid[len(keyPrefix)+2*idBytes:]
+
+	a, err := NewAuthenticator(keys, Config{
+		PublicEndpoints: []string{"GET /v1/escrows/{contractId}"},
+		PublicRateLimit: 100,
+		PublicBurst:     100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	for _, tc := range []struct {
+		name       string
+		path       string
+		header     string
+		key        string
+		wantStatus int
+		wantKeyID  string
+		wantScope  []string
+	}{
+		{"no key", "/v1/escrows", apiKeyHeader, "", http.StatusUnauthorized, "", nil},
+		{"no key on public endpoint", "/v1/escrows/CESCROW", apiKeyHeader, "", http.StatusOK, "", nil},
+		{"valid key", "/v1/escrows", apiKeyHeader, valid, http.StatusOK, validKey.ID, nil},
+		{"bearer key", "/v1/escrows", "Authorization", "Bearer " + valid, http.StatusOK, validKey.ID, nil},
+		{"scoped key", "/v1/escrows", apiKeyHeader, scoped, http.StatusOK, scopedKey.ID, []string{platform}},
+		{"malformed key", "/v1/escrows", apiKeyHeader, "twk_malformed", http.StatusUnauthorized, "", nil},
+		{"malformed key on public endpoint", "/v1/escrows/CESCROW", apiKeyHeader, "twk_", http.StatusUnauthorized, "", nil},
+		{"other scheme", "/v1/escrows", "Authorization", "Basic " + valid, http.StatusUnauthorized, "", nil},
+		{"wrong secret", "/v1/escrows", apiKeyHeader, wrongSecret, http.StatusUnauthorized, "", nil},
+		{"unknown key", "/v1/escrows", apiKeyHeader, unknownID, http.StatusUnauthorized, "", nil},
+		{"revoked key", "/v1/escrows", apiKeyHeader, revoked, http.StatusUnauthorized, "", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w, principal := serve(a, tc.path, "192.0.2.1:1234", tc.header, tc.key)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+			if tc.wantStatus == http.StatusUnauthorized {
+				if got := w.Header().Get("WWW-Authenticate"); got != wwwAuthenticateValue {
+					t.Errorf("WWW-Authenticate = %q, want %q", got, wwwAuthenticateValue)
+				}
+				return
+			}
+			if principal == nil || principal.KeyID != tc.wantKeyID {
+				t.Fatalf("principal = %+v, want key %q", principal, tc.wantKeyID)
+			}
+			if got := strings.Join(principal.PlatformAddresses, ","); got != strings.Join(tc.wantScope, ",") {
+				t.Errorf("principal platforms = %v, want %v", principal.PlatformAddresses, tc.wantScope)
+			}
+		})
+	}
+}
+
+// rateLimitedRequest is a request sent with one of the keys of TestMiddlewareRateLimits.
+type rateLimitedRequest struct {
+	key, ip    string
+	wantStatus int
+}
+
+func TestMiddlewareRateLimits(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		requests []rateLimitedRequest
+	}{
+		{
+			name: "per key",
+			requests: []rateLimitedRequest{
+				{"limited", "192.0.2.1", http.StatusOK},
+				{"limited", "192.0.2.2", http.StatusOK},
+				{"limited", "192.0.2.3", http.StatusTooManyRequests},
+				{"other", "192.0.2.3", http.StatusOK},
+			},
+		},
+		{
+			name: "per IP without key",
+			requests: []rateLimitedRequest{
+				// Reading a key takes a token of the IP, once cached the key has its own limit.
+				{"other", "192.0.2.1", http.StatusOK},
+				{"", "192.0.2.1", http.StatusOK},
+				{"", "192.0.2.1", http.StatusTooManyRequests},
+				{"", "192.0.2.2", http.StatusOK},
+				{"other", "192.0.2.1", http.StatusOK},
+			},
+		},
+		{
+			name: "per IP with unknown keys",
+			requests: []rateLimitedRequest{
+				{"unknown", "192.0.2.1", http.StatusUnauthorized},
+				{"unknown", "192.0.2.1", http.StatusUnauthorized},
+				{"unknown", "192.0.2.1", http.StatusTooManyRequests},
+				{"unknown", "192.0.2.2", http.StatusUnauthorized},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			keys := newFakeKeyStore()
+			limited, _ := keys.createKey(t, 0.001, 2)
+			other, _ := keys.createKey(t, 0.001, 10)
+			plaintexts := map[string]string{
+				"limited": limited,
+				"other":   other,
+				"unknown": keyPrefix + strings.Repeat("0", 2*idBytes) + "_" + strings.Repeat("0", 2*secretBytes),
+			}
+			a, err := NewAuthenticator(keys, Config{
+				PublicEndpoints: []string{"GET /v1/escrows/{contractId}"},
+				PublicRateLimit: 0.001,
+				PublicBurst:     2,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer a.Close()
+
+			for i, req := range tc.requests {
+				w, _ := serve(a, "/v1/escrows/CESCROW", req.ip+":1234", apiKeyHeader, plaintexts[req.key])
+				if w.Code != req.wantStatus {
+					t.Fatalf("request %d: status = %d, want %d", i, w.Code, req.wantStatus)
+				}
+				if req.wantStatus == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+					t.Errorf("request %d: no Retry-After header", i)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddlewareCachesKeys(t *testing.T) {
+	keys := newFakeKeyStore()
+	plaintext, _ := keys.createKey(t, 100, 100)
+	a, err := NewAuthenticator(keys, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	for range 3 {
+		if w, _ := serve(a, "/v1/escrows", "192.0.2.1:1234", apiKeyHeader, plaintext); w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+		}
+	}
+	keys.mu.Lock()
+	defer keys.mu.Unlock()
+	if keys.reads != 1 {
+		t.Errorf("read the key %d times, want once", keys.reads)
+	}
+}
+
+func TestMiddlewareAudit(t *testing.T) {
+	keys := newFakeKeyStore()
+	plaintext, key := keys.createKey(t, 100, 100)
+	a, err := NewAuthenticator(keys, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve(a, "/v1/escrows?limit=5", "192.0.2.1:1234", apiKeyHeader, plaintext)
+	serve(a, "/v1/escrows", "192.0.2.2:1234", apiKeyHeader, "")
+	a.Close()
+
+	keys.mu.Lock()
+	defer keys.mu.Unlock()
+	want := []store.AuditEntry{
+		{KeyID: key.ID, Method: http.MethodGet, Path: "/v1/escrows", Query: "limit=5", Status: http.StatusOK, RemoteAddr: "192.0.2.1"},
+		{Method: http.MethodGet, Path: "/v1/escrows", Status: http.StatusUnauthorized, RemoteAddr: "192.0.2.2"},
+	}
+	if len(keys.audit) != len(want) {
+		t.Fatalf("audited %d requests, want %d", len(keys.audit), len(want))
+	}
+	for i, entry := range keys.audit {
+		entry.Duration, entry.At = 0, time.Time{}
+		if entry != want[i] {
+			t.Errorf("audit entry %d = %+v, want %+v", i, entry, want[i])
+		}
+	}
+}
+
+func TestRequireUnscoped(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		principal  *Principal
+		wantStatus int
+	}{
+		{"authentication disabled", nil, http.StatusOK},
+		{"unauthenticated", &Principal{}, http.StatusOK},
+		{"unscoped key", &Principal{KeyID: "key"}, http.StatusOK},
+		{"scoped key", &Principal{KeyID: "key", PlatformAddresses: []string{"GPLATFORM"}}, http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := RequireUnscoped(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			if tc.principal != nil {
+				r = r.WithContext(NewContext(r.Context(), tc.principal))
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tc.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Package auth authenticates the clients of the query server with API keys, rate limits them
+// and records every request in the audit log.
+//
+// A key reads "twk_<id>_<secret>". The id locates the key in the store, which only holds the
+// SHA-256 of the secret: keys are random, so a plain hash is enough to make a leaked table
+// useless, and it keeps the verification of every request cheap.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/strkey"
+)
+
+const (
+	keyPrefix   = "twk_"
+	idBytes     = 8
+	secretBytes = 32
+
+	DefaultRateLimit = 10
+	DefaultBurst     = 20
+)
+
+var ErrInvalidKey = errors.New("invalid API key")
+
+// KeyCreator stores new API keys.
+type KeyCreator interface {
+	CreateAPIKey(ctx context.Context, key *store.APIKey) error
+}
+
+// CreateKey generates and stores an API key. It returns the key to hand to the client, which
+// can't be recovered afterwards, and the stored key.
+func CreateKey(ctx context.Context, keys KeyCreator, name string, platformAddresses []string, rateLimit float64, burst int) (string, *store.APIKey, error) {
+	if name == "" {
+		return "", nil, errors.New("name is required")
+	}
+	if rateLimit <= 0 || burst <= 0 {
+		return "", nil, errors.New("rate limit and burst must be positive")
+	}
+	for _, address := range platformAddresses {
+		if !strkey.IsValidEd25519PublicKey(address) && !strkey.IsValidContractAddress(address) {
+			return "", nil, fmt.Errorf("invalid platform address %q", address)
+		}
+	}
+
+	id, err := randomHex(idBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	secret, err := randomHex(secretBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	key := &store.APIKey{
+		ID:                id,
+		Name:              name,
+		SecretHash:        hashSecret(secret),
+		PlatformAddresses: platformAddresses,
+		RateLimit:         rateLimit,
+		Burst:             burst,
+	}
+	if err := keys.CreateAPIKey(ctx, key); err != nil {
+		return "", nil, err
+	}
+	return keyPrefix + id + "_" + secret, key, nil
+}
+
+// parseKey splits a key into its id and secret.
+func parseKey(plaintext string) (id, secret string, err error) {
+	rest, ok := strings.CutPrefix(plaintext, keyPrefix)
+	if !ok {
+		return "", "", ErrInvalidKey
+	}
+	id, secret, ok = strings.Cut(rest, "_")
+	if !ok || len(id) != 2*idBytes || len(secret) != 2*secretBytes {
+		return "", "", ErrInvalidKey
+	}
+	return id, secret, nil
+}
+
+// verifySecret reports whether secret is the secret of key, in constant time.
+func verifySecret(key *store.APIKey, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(key.SecretHash)) == 1
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,329 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultKeyCacheTTL   = time.Minute
+	limiterIdleTimeout   = 10 * time.Minute
+	defaultPublicRate    = 1
+	defaultPublicBurst   = 5
+	authenticateTimeout  = 5 * time.Second
+	apiKeyHeader         = "X-API-Key"
+	authorizationScheme  = "Bearer "
+	wwwAuthenticateValue = `Bearer realm="indexer"`
+)
+
+// KeyStore reads the API keys and persists the audit log.
+type KeyStore interface {
+	GetAPIKey(ctx context.Context, id string) (*store.APIKey, error)
+	AuditWriter
+}
+
+type Config struct {
+	// PublicEndpoints are the ServeMux patterns, e.g. "GET /v1/escrows/{contractId}", served
+	// without an API key.
+	PublicEndpoints []string
+	// PublicRateLimit and PublicBurst limit the requests without a valid API key, per client
+	// IP. Default to 1 request per second with bursts of 5.
+	PublicRateLimit float64
+	PublicBurst     int
+	// KeyCacheTTL is how long a key is served from memory before being read again, which
+	// bounds the time a revocation takes to apply. Defaults to one minute.
+	KeyCacheTTL time.Duration
+}
+
+// Authenticator authenticates, rate limits and audits the requests of the query server.
+type Authenticator struct {
+	keys   KeyStore
+	public *http.ServeMux
+	ttl    time.Duration
+	audit  *auditLogger
+
+	publicRate  rate.Limit
+	publicBurst int
+
+	mu        sync.Mutex
+	cache     map[string]*cachedKey
+	ipLimits  map[string]*ipLimiter
+	lastPrune time.Time
+}
+
+type cachedKey struct {
+	key       *store.APIKey
+	limiter   *rate.Limiter
+	expiresAt time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewAuthenticator creates the authenticator. Close flushes the audit log.
+func NewAuthenticator(keys KeyStore, cfg Config) (*Authenticator, error) {
+	if keys == nil {
+		return nil, errors.New("key store is required")
+	}
+	if cfg.PublicRateLimit <= 0 {
+		cfg.PublicRateLimit = defaultPublicRate
+	}
+	if cfg.PublicBurst <= 0 {
+		cfg.PublicBurst = defaultPublicBurst
+	}
+	if cfg.KeyCacheTTL <= 0 {
+		cfg.KeyCacheTTL = defaultKeyCacheTTL
+	}
+
+	public := http.NewServeMux()
+	for _, pattern := range cfg.PublicEndpoints {
+		if err := registerPublic(public, pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Authenticator{
+		keys:        keys,
+		public:      public,
+		ttl:         cfg.KeyCacheTTL,
+		audit:       newAuditLogger(keys),
+		publicRate:  rate.Limit(cfg.PublicRateLimit),
+		publicBurst: cfg.PublicBurst,
+		cache:       make(map[string]*cachedKey),
+		ipLimits:    make(map[string]*ipLimiter),
+	}, nil
+}
+
+// registerPublic adds a pattern to the allow-list. ServeMux panics on invalid patterns.
+func registerPublic(mux *http.ServeMux, pattern string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid public endpoint %q: %v", pattern, r)
+		}
+	}()
+	mux.Handle(pattern, http.NotFoundHandler())
+	return nil
+}
+
+func (a *Authenticator) Close() {
+	a.audit.close()
+}
+
+// Middleware authenticates the requests with the key in the Authorization (Bearer) or
+// X-API-Key header. Requests without a key are only served on the public endpoints. The
+// principal of the request is available to next through FromContext.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		principal := &Principal{}
+		defer func() {
+			a.audit.record(r.Context(), store.AuditEntry{
+				KeyID:      principal.KeyID,
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				Query:      r.URL.RawQuery,
+				Status:     recorder.status,
+				Duration:   time.Since(start),
+				RemoteAddr: clientIP(r),
+				At:         start,
+			})
+		}()
+
+		plaintext := requestKey(r)
+		if plaintext == "" {
+			if _, pattern := a.public.Handler(r); pattern == "" {
+				recorder.Header().Set("WWW-Authenticate", wwwAuthenticateValue)
+				writeError(recorder, http.StatusUnauthorized, "API key required")
+				return
+			}
+			if !a.allowIP(r) {
+				writeRateLimited(recorder)
+				return
+			}
+			next.ServeHTTP(recorder, r.WithContext(NewContext(r.Context(), principal)))
+			return
+		}
+
+		key, limiter, err := a.authenticate(r, plaintext)
+		switch {
+		case errors.Is(err, errRateLimited):
+			writeRateLimited(recorder)
+			return
+		case errors.Is(err, ErrInvalidKey):
+			recorder.Header().Set("WWW-Authenticate", wwwAuthenticateValue)
+			writeError(recorder, http.StatusUnauthorized, ErrInvalidKey.Error())
+			return
+		case err != nil:
+			log.Ctx(r.Context()).Errorf("Authenticating %s %s: %v", r.Method, r.URL.Path, err)
+			writeError(recorder, http.StatusInternalServerError, "internal error")
+			return
+		}
+
+		principal.KeyID = key.ID
+		principal.PlatformAddresses = key.PlatformAddresses
+		if !limiter.Allow() {
+			writeRateLimited(recorder)
+			return
+		}
+		next.ServeHTTP(recorder, r.WithContext(NewContext(r.Context(), principal)))
+	})
+}
+
+var errRateLimited = errors.New("rate limited")
+
+// authenticate returns the active key matching plaintext and its rate limiter. Keys missing
+// from the cache are read from the store, which costs the client a token of its IP limit so
+// that invalid keys can't be used to hammer the store.
+func (a *Authenticator) authenticate(r *http.Request, plaintext string) (*store.APIKey, *rate.Limiter, error) {
+	id, secret, err := parseKey(plaintext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	a.mu.Lock()
+	cached, ok := a.cache[id]
+	a.mu.Unlock()
+
+	if !ok || now.After(cached.expiresAt) {
+		if !a.allowIP(r) {
+			return nil, nil, errRateLimited
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), authenticateTimeout)
+		defer cancel()
+		key, err := a.keys.GetAPIKey(ctx, id)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, ErrInvalidKey
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		cached = a.cacheKey(key, now)
+	}
+
+	if cached.key.RevokedAt != nil || !verifySecret(cached.key, secret) {
+		return nil, nil, ErrInvalidKey
+	}
+	return cached.key, cached.limiter, nil
+}
+
+// cacheKey caches a key read from the store, keeping its limiter when its limits didn't change.
+func (a *Authenticator) cacheKey(key *store.APIKey, now time.Time) *cachedKey {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry := &cachedKey{key: key, expiresAt: now.Add(a.ttl)}
+	if previous, ok := a.cache[key.ID]; ok &&
+		previous.key.RateLimit == key.RateLimit && previous.key.Burst == key.Burst {
+		entry.limiter = previous.limiter
+	} else {
+		entry.limiter = rate.NewLimiter(rate.Limit(key.RateLimit), key.Burst)
+	}
+	a.cache[key.ID] = entry
+	return entry
+}
+
+// allowIP takes a token from the limit of the client IP. Limiters idle for a while are
+// dropped, as are the expired keys.
+func (a *Authenticator) allowIP(r *http.Request) bool {
+	ip := clientIP(r)
+	now := time.Now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.Sub(a.lastPrune) > limiterIdleTimeout {
+		for key, l := range a.ipLimits {
+			if now.Sub(l.lastSeen) > limiterIdleTimeout {
+				delete(a.ipLimits, key)
+			}
+		}
+		for id, cached := range a.cache {
+			if now.After(cached.expiresAt.Add(limiterIdleTimeout)) {
+				delete(a.cache, id)
+			}
+		}
+		a.lastPrune = now
+	}
+
+	l, ok := a.ipLimits[ip]
+	if !ok {
+		l = &ipLimiter{limiter: rate.NewLimiter(a.publicRate, a.publicBurst)}
+		a.ipLimits[ip] = l
+	}
+	l.lastSeen = now
+	return l.limiter.AllowN(now, 1)
+}
+
+func requestKey(r *http.Request) string {
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		return key
+	}
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, authorizationScheme) {
+		return strings.TrimSpace(strings.TrimPrefix(auth, authorizationScheme))
+	}
+	return ""
+}
+
+// clientIP returns the IP of the peer. Deployments behind a proxy should have it rewrite
+// RemoteAddr, forwarded headers are not trusted.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// statusRecorder records the status code of a response for the audit log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func writeRateLimited(w http.ResponseWriter) {
+	w.Header().Set("Retry-After", "1")
+	writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+}
+
+// writeError writes the error body of the REST API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{message})
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+)
+
+// Principal is the client of a request.
+type Principal struct {
+	// KeyID is the id of the API key of the request, empty for unauthenticated requests.
+	KeyID string
+	// PlatformAddresses are the platforms the key is scoped to. Empty means unrestricted.
+	PlatformAddresses []string
+}
+
+// Scoped reports whether the principal is restricted to some platforms.
+func (p *Principal) Scoped() bool {
+	return p != nil && len(p.PlatformAddresses) > 0
+}
+
+// AllowsPlatform reports whether the principal can read the escrows of a platform.
+func (p *Principal) AllowsPlatform(address string) bool {
+	return !p.Scoped() || slices.Contains(p.PlatformAddresses, address)
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of a request, nil when authentication is disabled.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// RequireUnscoped rejects the requests of scoped keys. It guards the endpoints that can't
+// restrict their results to the platforms of a key, such as GraphQL.
+func RequireUnscoped(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if FromContext(r.Context()).Scoped() {
+			writeError(w, http.StatusForbidden, "this endpoint requires an API key without platform scope")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
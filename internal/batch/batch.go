@@ -0,0 +1,116 @@
+// Package batch writes records in batches, off the path that produces them. It backs the
+// audit log, the admin actions and the ledger stats, which must never slow down the requests
+// or the ingestion they describe.
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+var (
+	// ErrQueueFull is returned by Add when the store can't keep up and the queue is full.
+	ErrQueueFull = errors.New("queue full")
+	// ErrClosed is returned by Add once the writer is closed.
+	ErrClosed = errors.New("writer closed")
+)
+
+// Config configures a Writer.
+type Config struct {
+	// Name describes the records in the logs, e.g. "audit log entries".
+	Name          string
+	QueueSize     int
+	BatchSize     int
+	FlushInterval time.Duration
+	WriteTimeout  time.Duration
+}
+
+// Writer queues records and writes them in batches of up to BatchSize, at least every
+// FlushInterval. Records that don't fit in the queue, or that are added after Close, are
+// dropped. It is safe for concurrent use.
+type Writer[T any] struct {
+	cfg   Config
+	write func(ctx context.Context, records []T) error
+
+	mu      sync.RWMutex
+	closed  bool
+	records chan T
+	wg      sync.WaitGroup
+}
+
+// NewWriter starts a writer that persists the records with write.
+func NewWriter[T any](cfg Config, write func(ctx context.Context, records []T) error) *Writer[T] {
+	w := &Writer[T]{cfg: cfg, write: write, records: make(chan T, cfg.QueueSize)}
+	w.wg.Add(1)
+	go w.run()
+	return w
+}
+
+// Add queues a record without blocking. It returns ErrQueueFull or ErrClosed when the record
+// is dropped.
+func (w *Writer[T]) Add(record T) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		return ErrClosed
+	}
+	select {
+	case w.records <- record:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (w *Writer[T]) run() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]T, 0, w.cfg.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), w.cfg.WriteTimeout)
+		defer cancel()
+		if err := w.write(ctx, batch); err != nil {
+			log.Ctx(ctx).Errorf("Writing %d %s: %v", len(batch), w.cfg.Name, err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case record, ok := <-w.records:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, record)
+			if len(batch) == w.cfg.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// Close writes the pending records. Records added afterwards are dropped. It can be called
+// several times.
+func (w *Writer[T]) Close() {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.records)
+	}
+	w.mu.Unlock()
+	w.wg.Wait()
+}
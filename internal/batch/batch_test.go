@@ -0,0 +1,72 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recorder) write(ctx context.Context, records []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, append([]int(nil), records...))
+	return nil
+}
+
+func TestWriterBatches(t *testing.T) {
+	r := &recorder{}
+	w := NewWriter(Config{Name: "numbers", QueueSize: 10, BatchSize: 2, FlushInterval: time.Hour, WriteTimeout: time.Second}, r.write)
+	for i := range 5 {
+		if err := w.Add(i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+	w.Close()
+
+	var written []int
+	for _, batch := range r.batches {
+		if len(batch) > 2 {
+			t.Errorf("batch %v is larger than 2", batch)
+		}
+		written = append(written, batch...)
+	}
+	if len(written) != 5 {
+		t.Errorf("wrote %v, want the 5 records", written)
+	}
+}
+
+func TestWriterAddAfterClose(t *testing.T) {
+	r := &recorder{}
+	w := NewWriter(Config{QueueSize: 1, BatchSize: 1, FlushInterval: time.Hour, WriteTimeout: time.Second}, r.write)
+	w.Close()
+	w.Close()
+	if err := w.Add(1); !errors.Is(err, ErrClosed) {
+		t.Errorf("Add after Close = %v, want ErrClosed", err)
+	}
+}
+
+func TestWriterQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	w := NewWriter(Config{QueueSize: 1, BatchSize: 1, FlushInterval: time.Hour, WriteTimeout: time.Second},
+		func(ctx context.Context, records []int) error {
+			<-release
+			return nil
+		})
+	defer w.Close()
+	defer close(release)
+
+	var err error
+	for i := 0; i < 3 && err == nil; i++ {
+		err = w.Add(i)
+	}
+	if !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Add with a blocked writer = %v, want ErrQueueFull", err)
+	}
+}
@@ -0,0 +1,281 @@
+// Package bench measures the throughput of the indexer pipeline by replaying recorded ledgers
+// through Indexer.ProcessLedgerTransactions.
+//
+// Each ledger goes through the phases of the ingestion, measured separately:
+//
+//   - read: the transactions are read from the LedgerCloseMeta;
+//   - process: Indexer.ProcessLedgerTransactions fills the buffer of the ledger, including the
+//     merge of the buffer of each transaction into it (IndexerBuffer.MergeBuffer);
+//   - batch merge: the buffer of the ledger is merged into a batch of BatchSize ledgers, as
+//     the backfill does before a write.
+//
+// Nothing is written to a sink, so the figures are the cost of the processing alone.
+// Allocations are read from runtime/metrics and include the workers of the pool.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/metrics"
+	"runtime/pprof"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/alitto/pond/v2"
+	"github.com/stellar/go-stellar-sdk/ingest"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+const defaultBatchSize = 50
+
+// Config configures a benchmark.
+type Config struct {
+	Network           string
+	NetworkPassphrase string
+	// Iterations is how many times the ledgers are replayed. Defaults to 1.
+	Iterations int
+	// Workers bounds the pool processing the transactions of a ledger. 0 is unbounded, like
+	// the ingestion.
+	Workers int
+	// BatchSize is the ledgers merged into a batch. Defaults to 50, the default
+	// BackfillDBInsertBatchSize.
+	BatchSize      int
+	SkipTxMeta     bool
+	SkipTxEnvelope bool
+	// CPUProfile and HeapProfile, when set, are the files the CPU profile of the replay and the
+	// heap profile at its end are written to, for go tool pprof.
+	CPUProfile  string
+	HeapProfile string
+}
+
+// Report is the outcome of a benchmark.
+type Report struct {
+	Ledgers      int
+	Transactions int
+	Operations   int
+	Duration     time.Duration
+
+	Read    Phase
+	Process Phase
+	// TxMerges is the merge of the transaction buffers into the ledger buffers, part of Process.
+	TxMerges Phase
+	// BatchMerges is the merge of the ledger buffers into batches.
+	BatchMerges Phase
+
+	// ProcessLatency are percentiles of the process phase of a ledger.
+	ProcessP50, ProcessP99, ProcessMax time.Duration
+}
+
+// Phase is the cost of a phase over the whole replay.
+type Phase struct {
+	Count    int
+	Duration time.Duration
+	Allocs   uint64
+	Bytes    uint64
+}
+
+// LedgersPerSecond is the ledgers processed a second, read and process phases included.
+func (r Report) LedgersPerSecond() float64 {
+	return float64(r.Ledgers) / (r.Read.Duration + r.Process.Duration).Seconds()
+}
+
+// TransactionsPerSecond is the transactions processed a second, read and process phases
+// included.
+func (r Report) TransactionsPerSecond() float64 {
+	return float64(r.Transactions) / (r.Read.Duration + r.Process.Duration).Seconds()
+}
+
+// Run replays the ledgers through the indexer and reports the cost of each phase.
+func Run(ctx context.Context, cfg Config, ledgers []xdr.LedgerCloseMeta) (Report, error) {
+	if len(ledgers) == 0 {
+		return Report{}, errors.New("no ledger to replay")
+	}
+	iterations := max(cfg.Iterations, 1)
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	pool := pond.NewPool(cfg.Workers)
+	defer pool.StopAndWait()
+	idx := indexer.NewIndexer(cfg.Network, cfg.NetworkPassphrase, pool, cfg.SkipTxMeta, cfg.SkipTxEnvelope)
+
+	if cfg.CPUProfile != "" {
+		file, err := os.Create(cfg.CPUProfile)
+		if err != nil {
+			return Report{}, fmt.Errorf("creating CPU profile: %w", err)
+		}
+		defer file.Close()
+		if err := pprof.StartCPUProfile(file); err != nil {
+			return Report{}, fmt.Errorf("starting CPU profile: %w", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	var (
+		report    Report
+		latencies = make([]time.Duration, 0, len(ledgers)*iterations)
+		batch     = indexer.NewIndexerBuffer()
+		batched   int
+	)
+	runtime.GC()
+	start := time.Now()
+	for range iterations {
+		for _, ledger := range ledgers {
+			if err := ctx.Err(); err != nil {
+				return Report{}, err
+			}
+
+			var transactions []ingest.LedgerTransaction
+			err := report.Read.measure(func() (err error) {
+				transactions, err = readTransactions(cfg.NetworkPassphrase, ledger)
+				return err
+			})
+			if err != nil {
+				return Report{}, fmt.Errorf("reading ledger %d: %w", ledger.LedgerSequence(), err)
+			}
+
+			buffer := &mergeTimer{IndexerBuffer: indexer.NewIndexerBuffer(), phase: &report.TxMerges}
+			processStart := time.Now()
+			err = report.Process.measure(func() error {
+				_, err := idx.ProcessLedgerTransactions(ctx, transactions, buffer)
+				return err
+			})
+			if err != nil {
+				return Report{}, fmt.Errorf("processing ledger %d: %w", ledger.LedgerSequence(), err)
+			}
+			latencies = append(latencies, time.Since(processStart))
+
+			_ = report.BatchMerges.measure(func() error {
+				batch.MergeBuffer(buffer.IndexerBuffer)
+				return nil
+			})
+			if batched++; batched == batchSize {
+				batch, batched = indexer.NewIndexerBuffer(), 0
+			}
+
+			report.Ledgers++
+			report.Transactions += len(transactions)
+			report.Operations += buffer.GetNumberOfOperations()
+		}
+	}
+	report.Duration = time.Since(start)
+
+	slices.Sort(latencies)
+	report.ProcessP50 = percentile(latencies, 0.50)
+	report.ProcessP99 = percentile(latencies, 0.99)
+	report.ProcessMax = latencies[len(latencies)-1]
+
+	if cfg.HeapProfile != "" {
+		if err := writeHeapProfile(cfg.HeapProfile); err != nil {
+			return Report{}, err
+		}
+	}
+	return report, nil
+}
+
+// measure runs fn and adds its duration and allocations to the phase.
+func (p *Phase) measure(fn func() error) error {
+	allocsBefore, bytesBefore := readAllocs()
+	start := time.Now()
+	err := fn()
+	p.Duration += time.Since(start)
+	allocsAfter, bytesAfter := readAllocs()
+	p.Count++
+	p.Allocs += allocsAfter - allocsBefore
+	p.Bytes += bytesAfter - bytesBefore
+	return err
+}
+
+// mergeTimer times the merges of the transaction buffers into the buffer of a ledger. Reading
+// the allocations of every merge would slow down the process phase it is part of, so only
+// their count and duration are measured.
+type mergeTimer struct {
+	*indexer.IndexerBuffer
+	phase *Phase
+}
+
+func (t *mergeTimer) MergeBuffer(other indexer.IndexerBufferInterface) {
+	start := time.Now()
+	t.IndexerBuffer.MergeBuffer(other)
+	t.phase.Duration += time.Since(start)
+	t.phase.Count++
+}
+
+var allocSamples = []metrics.Sample{
+	{Name: "/gc/heap/allocs:objects"},
+	{Name: "/gc/heap/allocs:bytes"},
+}
+
+// readAllocs returns the objects and bytes allocated on the heap since the program started.
+func readAllocs() (objects, bytes uint64) {
+	samples := slices.Clone(allocSamples)
+	metrics.Read(samples)
+	return samples[0].Value.Uint64(), samples[1].Value.Uint64()
+}
+
+func readTransactions(networkPassphrase string, ledger xdr.LedgerCloseMeta) ([]ingest.LedgerTransaction, error) {
+	reader, err := ingest.NewLedgerTransactionReaderFromLedgerCloseMeta(networkPassphrase, ledger)
+	if err != nil {
+		return nil, fmt.Errorf("creating ledger transaction reader: %w", err)
+	}
+	defer reader.Close()
+
+	var transactions []ingest.LedgerTransaction
+	for {
+		tx, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return transactions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading transaction: %w", err)
+		}
+		transactions = append(transactions, tx)
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	return sorted[int(float64(len(sorted)-1)*p)]
+}
+
+func writeHeapProfile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating heap profile: %w", err)
+	}
+	defer file.Close()
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("writing heap profile: %w", err)
+	}
+	return nil
+}
+
+// Write prints the report as a table.
+func (r Report) Write(w io.Writer) error {
+	perTx := func(value uint64) float64 { return float64(value) / float64(max(r.Transactions, 1)) }
+	perCount := func(p Phase) time.Duration { return p.Duration / time.Duration(max(p.Count, 1)) }
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Ledgers\t%d (%d transactions, %d operations) in %v\n", r.Ledgers, r.Transactions, r.Operations, r.Duration.Round(time.Millisecond))
+	fmt.Fprintf(tw, "Throughput\t%.1f ledgers/s, %.0f tx/s\n", r.LedgersPerSecond(), r.TransactionsPerSecond())
+	fmt.Fprintf(tw, "Process latency\tp50 %v, p99 %v, max %v\n", r.ProcessP50.Round(time.Microsecond), r.ProcessP99.Round(time.Microsecond), r.ProcessMax.Round(time.Microsecond))
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "PHASE\tTOTAL\tPER LEDGER\tALLOCS/TX\tBYTES/TX")
+	fmt.Fprintf(tw, "read\t%v\t%v\t%.0f\t%.0f\n", r.Read.Duration.Round(time.Millisecond), perCount(r.Read).Round(time.Microsecond), perTx(r.Read.Allocs), perTx(r.Read.Bytes))
+	fmt.Fprintf(tw, "process\t%v\t%v\t%.0f\t%.0f\n", r.Process.Duration.Round(time.Millisecond), perCount(r.Process).Round(time.Microsecond), perTx(r.Process.Allocs), perTx(r.Process.Bytes))
+	fmt.Fprintf(tw, "batch merge\t%v\t%v\t%.0f\t%.0f\n", r.BatchMerges.Duration.Round(time.Millisecond), perCount(r.BatchMerges).Round(time.Microsecond), perTx(r.BatchMerges.Allocs), perTx(r.BatchMerges.Bytes))
+	fmt.Fprintln(tw)
+	share := 0.0
+	if r.Process.Duration > 0 {
+		share = 100 * float64(r.TxMerges.Duration) / float64(r.Process.Duration)
+	}
+	fmt.Fprintf(tw, "Transaction merges\t%d merges, %v per merge, %.1f%% of process\n", r.TxMerges.Count, perCount(r.TxMerges), share)
+	return tw.Flush()
+}
@@ -0,0 +1,292 @@
+package bench
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/alitto/pond/v2"
+	"github.com/stellar/go-stellar-sdk/ingest"
+	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/network"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// The fixture is a small batch of ledgers of native payments between a few accounts, recorded
+// by TestRecordFixtures. Run `go test ./internal/bench -run TestRecordFixtures -record` to
+// record it again, e.g. after a change of the fixture format.
+const (
+	fixtureDir        = "testdata/ledgers"
+	fixtureStart      = 1000
+	fixtureLedgers    = 8
+	fixtureTxs        = 20
+	fixtureAccounts   = 10
+	fixturePassphrase = network.TestNetworkPassphrase
+)
+
+var record = flag.Bool("record", false, "record the ledgers of "+fixtureDir)
+
+func TestRecordFixtures(t *testing.T) {
+	if !*record {
+		t.Skip("run with -record to record the fixtures")
+	}
+	ledgers, err := paymentLedgers()
+	if err != nil {
+		t.Fatalf("building ledgers: %v", err)
+	}
+	if err := os.RemoveAll(fixtureDir); err != nil {
+		t.Fatalf("removing fixtures: %v", err)
+	}
+	backend := &fixtureBackend{ledgers: ledgers}
+	if err := Record(context.Background(), backend, fixtureStart, fixtureLedgers, fixtureDir); err != nil {
+		t.Fatalf("recording fixtures: %v", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	ledgers := loadFixtures(t)
+	report, err := Run(context.Background(), Config{
+		Network:           "testnet",
+		NetworkPassphrase: fixturePassphrase,
+		Iterations:        2,
+		BatchSize:         3,
+	}, ledgers)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Ledgers != 2*fixtureLedgers {
+		t.Errorf("Ledgers = %d, want %d", report.Ledgers, 2*fixtureLedgers)
+	}
+	if report.Transactions != 2*fixtureLedgers*fixtureTxs {
+		t.Errorf("Transactions = %d, want %d", report.Transactions, 2*fixtureLedgers*fixtureTxs)
+	}
+	if report.Operations != report.Transactions {
+		t.Errorf("Operations = %d, want %d", report.Operations, report.Transactions)
+	}
+	if report.TxMerges.Count != report.Transactions {
+		t.Errorf("TxMerges.Count = %d, want %d", report.TxMerges.Count, report.Transactions)
+	}
+}
+
+func BenchmarkProcessLedgerTransactions(b *testing.B) {
+	ctx := context.Background()
+	transactions := fixtureTransactions(b)
+	pool := pond.NewPool(0)
+	defer pool.StopAndWait()
+	idx := indexer.NewIndexer("testnet", fixturePassphrase, pool, false, false)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, txs := range transactions {
+			if _, err := idx.ProcessLedgerTransactions(ctx, txs, indexer.NewIndexerBuffer()); err != nil {
+				b.Fatalf("processing ledger: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkMergeBuffer(b *testing.B) {
+	ctx := context.Background()
+	pool := pond.NewPool(0)
+	defer pool.StopAndWait()
+	idx := indexer.NewIndexer("testnet", fixturePassphrase, pool, false, false)
+
+	var buffers []*indexer.IndexerBuffer
+	for _, txs := range fixtureTransactions(b) {
+		buffer := indexer.NewIndexerBuffer()
+		if _, err := idx.ProcessLedgerTransactions(ctx, txs, buffer); err != nil {
+			b.Fatalf("processing ledger: %v", err)
+		}
+		buffers = append(buffers, buffer)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		batch := indexer.NewIndexerBuffer()
+		for _, buffer := range buffers {
+			batch.MergeBuffer(buffer)
+		}
+	}
+}
+
+func loadFixtures(tb testing.TB) []xdr.LedgerCloseMeta {
+	tb.Helper()
+	ledgers, err := LoadLedgers(fixtureDir)
+	if err != nil {
+		tb.Fatalf("loading fixtures: %v", err)
+	}
+	if len(ledgers) != fixtureLedgers {
+		tb.Fatalf("loaded %d ledgers, want %d", len(ledgers), fixtureLedgers)
+	}
+	return ledgers
+}
+
+// fixtureTransactions reads the transactions of each ledger of the fixture.
+func fixtureTransactions(tb testing.TB) [][]ingest.LedgerTransaction {
+	tb.Helper()
+	var transactions [][]ingest.LedgerTransaction
+	for _, ledger := range loadFixtures(tb) {
+		txs, err := readTransactions(fixturePassphrase, ledger)
+		if err != nil {
+			tb.Fatalf("reading ledger %d: %v", ledger.LedgerSequence(), err)
+		}
+		transactions = append(transactions, txs)
+	}
+	return transactions
+}
+
+// fixtureBackend serves ledgers held in memory.
+type fixtureBackend struct {
+	ledgers map[uint32]xdr.LedgerCloseMeta
+}
+
+var _ ledgerbackend.LedgerBackend = (*fixtureBackend)(nil)
+
+func (b *fixtureBackend) GetLatestLedgerSequence(context.Context) (uint32, error) {
+	return fixtureStart + fixtureLedgers - 1, nil
+}
+
+func (b *fixtureBackend) GetLedger(_ context.Context, sequence uint32) (xdr.LedgerCloseMeta, error) {
+	ledger, ok := b.ledgers[sequence]
+	if !ok {
+		return xdr.LedgerCloseMeta{}, fmt.Errorf("ledger %d not found", sequence)
+	}
+	return ledger, nil
+}
+
+func (b *fixtureBackend) PrepareRange(context.Context, ledgerbackend.Range) error { return nil }
+
+func (b *fixtureBackend) IsPrepared(context.Context, ledgerbackend.Range) (bool, error) {
+	return true, nil
+}
+
+func (b *fixtureBackend) Close() error { return nil }
+
+// paymentLedgers builds the ledgers of the fixture: each transaction is a native payment from
+// one account to the next, with the balance changes of both accounts in its meta.
+func paymentLedgers() (map[uint32]xdr.LedgerCloseMeta, error) {
+	accounts := make([]xdr.AccountId, fixtureAccounts)
+	balances := make([]xdr.Int64, fixtureAccounts)
+	for i := range accounts {
+		kp, err := keypair.FromRawSeed(network.ID(fmt.Sprintf("bench account %d", i)))
+		if err != nil {
+			return nil, fmt.Errorf("deriving account %d: %w", i, err)
+		}
+		accounts[i] = xdr.MustAddress(kp.Address())
+		balances[i] = 1_000_000_000_000
+	}
+	sequences := make([]xdr.SequenceNumber, fixtureAccounts)
+
+	const fee, amount = 100, 10_000_000
+	closedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ledgers := make(map[uint32]xdr.LedgerCloseMeta, fixtureLedgers)
+	for l := range uint32(fixtureLedgers) {
+		sequence := fixtureStart + l
+		var (
+			envelopes []xdr.TransactionEnvelope
+			results   []xdr.TransactionResultMeta
+		)
+		for t := range fixtureTxs {
+			from := (int(l)*fixtureTxs + t) % fixtureAccounts
+			to := (from + 1) % fixtureAccounts
+			sequences[from]++
+
+			envelope := xdr.TransactionEnvelope{Type: xdr.EnvelopeTypeEnvelopeTypeTx, V1: &xdr.TransactionV1Envelope{
+				Tx: xdr.Transaction{
+					SourceAccount: accounts[from].ToMuxedAccount(),
+					Fee:           fee,
+					SeqNum:        sequences[from],
+					Cond:          xdr.Preconditions{Type: xdr.PreconditionTypePrecondNone},
+					Operations: []xdr.Operation{{Body: xdr.OperationBody{
+						Type: xdr.OperationTypePayment,
+						PaymentOp: &xdr.PaymentOp{
+							Destination: accounts[to].ToMuxedAccount(),
+							Asset:       xdr.MustNewNativeAsset(),
+							Amount:      amount,
+						},
+					}}},
+				},
+			}}
+			hash, err := network.HashTransactionInEnvelope(envelope, fixturePassphrase)
+			if err != nil {
+				return nil, fmt.Errorf("hashing transaction: %w", err)
+			}
+
+			fromBefore, toBefore := balances[from], balances[to]
+			balances[from] -= amount + fee
+			balances[to] += amount
+			changes := xdr.LedgerEntryChanges{
+				accountState(accounts[from], fromBefore-fee, sequences[from], sequence),
+				accountUpdated(accounts[from], balances[from], sequences[from], sequence),
+				accountState(accounts[to], toBefore, sequences[to], sequence),
+				accountUpdated(accounts[to], balances[to], sequences[to], sequence),
+			}
+
+			envelopes = append(envelopes, envelope)
+			results = append(results, xdr.TransactionResultMeta{
+				Result: xdr.TransactionResultPair{
+					TransactionHash: hash,
+					Result: xdr.TransactionResult{
+						FeeCharged: fee,
+						Result: xdr.TransactionResultResult{
+							Code: xdr.TransactionResultCodeTxSuccess,
+							Results: &[]xdr.OperationResult{{
+								Code: xdr.OperationResultCodeOpInner,
+								Tr: &xdr.OperationResultTr{
+									Type:          xdr.OperationTypePayment,
+									PaymentResult: &xdr.PaymentResult{Code: xdr.PaymentResultCodePaymentSuccess},
+								},
+							}},
+						},
+					},
+				},
+				TxApplyProcessing: xdr.TransactionMeta{V: 3, V3: &xdr.TransactionMetaV3{
+					Operations: []xdr.OperationMeta{{Changes: changes}},
+				}},
+			})
+		}
+
+		ledgers[sequence] = xdr.LedgerCloseMeta{V: 1, V1: &xdr.LedgerCloseMetaV1{
+			LedgerHeader: xdr.LedgerHeaderHistoryEntry{Header: xdr.LedgerHeader{
+				LedgerSeq:     xdr.Uint32(sequence),
+				LedgerVersion: 22,
+				ScpValue:      xdr.StellarValue{CloseTime: xdr.TimePoint(closedAt.Add(time.Duration(l) * 5 * time.Second).Unix())},
+			}},
+			TxSet: xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{
+				Phases: []xdr.TransactionPhase{{V0Components: &[]xdr.TxSetComponent{{
+					Type:                  xdr.TxSetComponentTypeTxsetCompTxsMaybeDiscountedFee,
+					TxsMaybeDiscountedFee: &xdr.TxSetComponentTxsMaybeDiscountedFee{Txs: envelopes},
+				}}}},
+			}},
+			TxProcessing: results,
+		}}
+	}
+	return ledgers, nil
+}
+
+func accountEntry(account xdr.AccountId, balance xdr.Int64, seqNum xdr.SequenceNumber, ledger uint32) xdr.LedgerEntry {
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: xdr.Uint32(ledger),
+		Data: xdr.LedgerEntryData{Type: xdr.LedgerEntryTypeAccount, Account: &xdr.AccountEntry{
+			AccountId:  account,
+			Balance:    balance,
+			SeqNum:     seqNum,
+			Thresholds: xdr.Thresholds{1, 0, 0, 0},
+		}},
+	}
+}
+
+func accountState(account xdr.AccountId, balance xdr.Int64, seqNum xdr.SequenceNumber, ledger uint32) xdr.LedgerEntryChange {
+	entry := accountEntry(account, balance, seqNum, ledger-1)
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryState, State: &entry}
+}
+
+func accountUpdated(account xdr.AccountId, balance xdr.Int64, seqNum xdr.SequenceNumber, ledger uint32) xdr.LedgerEntryChange {
+	entry := accountEntry(account, balance, seqNum, ledger)
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated, Updated: &entry}
+}
@@ -0,0 +1,128 @@
+package bench
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
+	"github.com/stellar/go-stellar-sdk/support/compressxdr"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// Extensions of the fixture files.
+const (
+	// extXDR is a LedgerCloseMeta in XDR.
+	extXDR = ".xdr"
+	// extZstd is a LedgerCloseMetaBatch in XDR compressed with zstd, the format of the ledger
+	// exporter (galexie) and of Record.
+	extZstd = ".xdr.zst"
+)
+
+// LoadLedgers reads the ledgers of every fixture under dir, sorted by sequence. Fixtures are
+// *.xdr files holding a LedgerCloseMeta, and *.xdr.zst or *.xdr.zstd files holding a
+// zstd-compressed LedgerCloseMetaBatch, e.g. a copy of a ledger exporter bucket.
+func LoadLedgers(dir string) ([]xdr.LedgerCloseMeta, error) {
+	var ledgers []xdr.LedgerCloseMeta
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		switch {
+		case strings.HasSuffix(path, extZstd), strings.HasSuffix(path, extZstd+"d"):
+			batch, err := readBatch(path)
+			if err != nil {
+				return err
+			}
+			ledgers = append(ledgers, batch.LedgerCloseMetas...)
+		case strings.HasSuffix(path, extXDR):
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading fixture: %w", err)
+			}
+			var ledger xdr.LedgerCloseMeta
+			if err := ledger.UnmarshalBinary(data); err != nil {
+				return fmt.Errorf("decoding fixture %s: %w", path, err)
+			}
+			ledgers = append(ledgers, ledger)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading ledgers from %s: %w", dir, err)
+	}
+	if len(ledgers) == 0 {
+		return nil, fmt.Errorf("no ledger fixture (*%s, *%s) in %s", extXDR, extZstd, dir)
+	}
+
+	slices.SortFunc(ledgers, func(a, b xdr.LedgerCloseMeta) int {
+		return cmp.Compare(a.LedgerSequence(), b.LedgerSequence())
+	})
+	return ledgers, nil
+}
+
+func readBatch(path string) (xdr.LedgerCloseMetaBatch, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return xdr.LedgerCloseMetaBatch{}, fmt.Errorf("opening fixture: %w", err)
+	}
+	defer file.Close()
+
+	var batch xdr.LedgerCloseMetaBatch
+	if _, err := compressxdr.NewXDRDecoder(compressxdr.DefaultCompressor, &batch).ReadFrom(file); err != nil {
+		return xdr.LedgerCloseMetaBatch{}, fmt.Errorf("decoding fixture %s: %w", path, err)
+	}
+	return batch, nil
+}
+
+// Record fetches count ledgers from start with the backend and writes each one to dir as a
+// fixture named <sequence>.xdr.zst.
+func Record(ctx context.Context, backend ledgerbackend.LedgerBackend, start, count uint32, dir string) error {
+	if count == 0 {
+		return errors.New("count must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating fixture directory: %w", err)
+	}
+	end := start + count - 1
+	if err := backend.PrepareRange(ctx, ledgerbackend.BoundedRange(start, end)); err != nil {
+		return fmt.Errorf("preparing ledgers [%d, %d]: %w", start, end, err)
+	}
+
+	for seq := start; seq <= end; seq++ {
+		ledger, err := backend.GetLedger(ctx, seq)
+		if err != nil {
+			return fmt.Errorf("fetching ledger %d: %w", seq, err)
+		}
+		batch := xdr.LedgerCloseMetaBatch{
+			StartSequence:    xdr.Uint32(seq),
+			EndSequence:      xdr.Uint32(seq),
+			LedgerCloseMetas: []xdr.LedgerCloseMeta{ledger},
+		}
+		if err := writeBatch(filepath.Join(dir, fmt.Sprintf("%010d%s", seq, extZstd)), batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeBatch(path string, batch xdr.LedgerCloseMetaBatch) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating fixture: %w", err)
+	}
+	if _, err := compressxdr.NewXDREncoder(compressxdr.DefaultCompressor, batch).WriteTo(file); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("writing fixture %s: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("writing fixture %s: %w", path, err)
+	}
+	return nil
+}
@@ -15,7 +15,7 @@ type Escrow struct {
 	DeployerSalt     string
 	WasmHash         string
 	InitFunction     string
-	Amount           uint64      // Only for single release (at escrow level)
+	Amount           uint64 // Only for single release (at escrow level)
 	Description      string
 	EngagementID     string
 	Title            string
@@ -25,13 +25,47 @@ type Escrow struct {
 	Roles            EscrowRoles
 	Milestones       []Milestone
 	TrustlineAddress string
+	Network          string // Name of the network the escrow was deployed on
+	Function         string // Escrow function whose invocation changed the escrow, empty for deployments
+	OperationID      int64  // Operation that deployed or changed the escrow
+}
+
+// Escrow functions that open a dispute.
+const (
+	FunctionDisputeEscrow    = "dispute_escrow"
+	FunctionDisputeMilestone = "dispute_milestone"
+)
+
+// IsUpdate reports whether the escrow is the state left by a lifecycle invocation (approve,
+// dispute, resolve, release, fund...) rather than a deployment.
+func (e Escrow) IsUpdate() bool {
+	return e.Function != ""
+}
+
+// IsDispute reports whether the escrow is the state left by the opening of a dispute on the
+// escrow or one of its milestones.
+func (e Escrow) IsDispute() bool {
+	return e.Function == FunctionDisputeEscrow || e.Function == FunctionDisputeMilestone
+}
+
+// Apply returns the escrow with the state of update. The deployment details of e are kept
+// when update has none, as the storage of an escrow contract doesn't hold them.
+func (e Escrow) Apply(update Escrow) Escrow {
+	if update.Deployer == "" {
+		update.Deployer = e.Deployer
+		update.FactoryContract = e.FactoryContract
+		update.DeployerSalt = e.DeployerSalt
+		update.WasmHash = e.WasmHash
+		update.InitFunction = e.InitFunction
+	}
+	return update
 }
 
 type EscrowFlags struct {
-	Approved bool // Only used in multi-release milestone flags
-	Disputed bool
-	Released bool
-	Resolved bool
+	Approved bool `json:"approved"` // Only used in multi-release milestone flags
+	Disputed bool `json:"disputed"`
+	Released bool `json:"released"`
+	Resolved bool `json:"resolved"`
 }
 
 type EscrowRoles struct {
@@ -43,12 +77,13 @@ type EscrowRoles struct {
 	PlatformAddress string
 }
 
+// Milestone is stored as JSON by the postgres store, which queries it by the json names.
 type Milestone struct {
-	Description string
-	Status      string
-	Approved    bool      // Only for single release
-	Evidence    string
-	Amount      uint64    // Only for multi release
-	Flags       *EscrowFlags // Only for multi release (per milestone)
-	Receiver    string    // Only for multi release (per milestone)
+	Description string       `json:"description"`
+	Status      string       `json:"status"`
+	Approved    bool         `json:"approved"` // Only for single release
+	Evidence    string       `json:"evidence"`
+	Amount      uint64       `json:"amount"`          // Only for multi release
+	Flags       *EscrowFlags `json:"flags,omitempty"` // Only for multi release (per milestone)
+	Receiver    string       `json:"receiver"`        // Only for multi release (per milestone)
 }
@@ -0,0 +1,29 @@
+package entities
+
+import "testing"
+
+func TestEscrowApply(t *testing.T) {
+	deployed := Escrow{
+		ContractID:      "CESCROW",
+		Deployer:        "GDEPLOYER",
+		FactoryContract: "CFACTORY",
+		DeployerSalt:    "salt",
+		WasmHash:        "hash",
+		InitFunction:    "tw_new_single_release_escrow",
+		Title:           "Before",
+	}
+
+	got := deployed.Apply(Escrow{ContractID: "CESCROW", Title: "After", Flags: EscrowFlags{Disputed: true}})
+	if got.Title != "After" || !got.Flags.Disputed {
+		t.Fatalf("Apply = %+v, want the state of the update", got)
+	}
+	if got.Deployer != "GDEPLOYER" || got.FactoryContract != "CFACTORY" || got.DeployerSalt != "salt" ||
+		got.WasmHash != "hash" || got.InitFunction != "tw_new_single_release_escrow" {
+		t.Fatalf("Apply = %+v, want the deployment details kept", got)
+	}
+
+	redeployed := deployed.Apply(Escrow{ContractID: "CESCROW", Deployer: "GOTHER"})
+	if redeployed.Deployer != "GOTHER" || redeployed.WasmHash != "" {
+		t.Fatalf("Apply = %+v, want the deployment details of the update", redeployed)
+	}
+}
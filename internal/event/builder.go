@@ -0,0 +1,212 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/stellar/go-stellar-sdk/network"
+)
+
+// NetworkName returns the short name of a network used in event sources ("pubnet",
+// "testnet", "futurenet"), or "custom" for any other passphrase.
+func NetworkName(passphrase string) string {
+	switch passphrase {
+	case network.PublicNetworkPassphrase:
+		return "pubnet"
+	case network.TestNetworkPassphrase:
+		return "testnet"
+	case network.FutureNetworkPassphrase:
+		return "futurenet"
+	default:
+		return "custom"
+	}
+}
+
+// Builder wraps the entities of a ledger buffer into events.
+//
+// Events are attributed to the network their entity was tagged with by the indexer, or to the
+// network of the Builder for untagged entities.
+//
+// IDs are deterministic (derived from the entity keys), so an event delivered twice can be
+// deduplicated by consumers using the (source, id) pair as mandated by CloudEvents.
+type Builder struct {
+	network string
+}
+
+// NewBuilder creates a Builder for the given network name (see NetworkName).
+func NewBuilder(networkName string) *Builder {
+	return &Builder{network: networkName}
+}
+
+// Source returns the source of the events of an account or contract, "/stellar/<network>/<address>".
+// An empty address returns the network source.
+func (b *Builder) Source(address string) string {
+	return source(b.network, address)
+}
+
+func source(network, address string) string {
+	if address == "" {
+		return "/stellar/" + network
+	}
+	return "/stellar/" + network + "/" + address
+}
+
+// networkOf returns the network of an entity tagged with network.
+func (b *Builder) networkOf(network string) string {
+	if network == "" {
+		return b.network
+	}
+	return network
+}
+
+// Entity identifies the buffer entity an event was built from.
+type Entity string
+
+const (
+	EntityTransaction     Entity = "transaction"
+	EntityOperation       Entity = "operation"
+	EntityStateChange     Entity = "state_change"
+	EntityTrustlineChange Entity = "trustline_change"
+	EntityContractChange  Entity = "contract_change"
+	EntityEscrow          Entity = "escrow"
+)
+
+// Keyed is an event along with the entity it comes from and its partition key (the account
+// or contract the event belongs to), which sinks use to preserve per-key ordering.
+type Keyed struct {
+	Event
+	Entity Entity
+	Key    string
+}
+
+// FromBuffer builds the events of every entity in the buffer, in entity order: transactions,
+// operations, state changes, trustline changes, contract changes and escrows.
+func (b *Builder) FromBuffer(buffer indexer.IndexerBufferInterface, ledgerSeq uint32) ([]Keyed, error) {
+	closeTime := time.Now().UTC()
+	if txs := buffer.GetTransactions(); len(txs) > 0 {
+		closeTime = txs[0].LedgerCreatedAt
+	}
+
+	events := make([]Keyed, 0)
+	add := func(entity Entity, key string, e Event, data any) error {
+		built, err := b.build(e, data, ledgerSeq, closeTime)
+		if err != nil {
+			return fmt.Errorf("building %s event %s: %w", entity, e.ID, err)
+		}
+		events = append(events, Keyed{Event: built, Entity: entity, Key: key})
+		return nil
+	}
+
+	for _, tx := range buffer.GetTransactions() {
+		e := Event{ID: "tx:" + tx.Hash, Source: source(b.networkOf(tx.Network), ""), Network: tx.Network, Type: TypeTransactionCreated, Subject: tx.Hash, Time: tx.LedgerCreatedAt}
+		if err := add(EntityTransaction, tx.Hash, e, tx); err != nil {
+			return nil, err
+		}
+	}
+	for _, op := range buffer.GetOperations() {
+		e := Event{ID: "op:" + strconv.FormatInt(op.ID, 10), Source: source(b.networkOf(op.Network), ""), Network: op.Network, Type: TypeOperationCreated, Subject: op.TxHash, Time: op.LedgerCreatedAt}
+		if err := add(EntityOperation, op.TxHash, e, op); err != nil {
+			return nil, err
+		}
+	}
+	for _, sc := range buffer.GetStateChanges() {
+		e, err := b.StateChange(sc, ledgerSeq)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, Keyed{Event: e, Entity: EntityStateChange, Key: sc.AccountID})
+	}
+	for _, tc := range buffer.GetTrustlineChanges() {
+		e := Event{ID: fmt.Sprintf("tl:%d-%s-%s", tc.OperationID, tc.AccountID, tc.Asset), Source: source(b.networkOf(tc.Network), tc.AccountID), Network: tc.Network, Type: TypeTrustlineChangeCreated, Subject: tc.Asset}
+		data := TrustlineChangeData{AccountID: tc.AccountID, Asset: tc.Asset, OperationID: tc.OperationID, LedgerNumber: tc.LedgerNumber, Operation: string(tc.Operation)}
+		if err := add(EntityTrustlineChange, tc.AccountID, e, data); err != nil {
+			return nil, err
+		}
+	}
+	for _, cc := range buffer.GetContractChanges() {
+		e := Event{ID: fmt.Sprintf("cc:%d-%s-%s", cc.OperationID, cc.AccountID, cc.ContractID), Source: source(b.networkOf(cc.Network), cc.ContractID), Network: cc.Network, Type: TypeContractChangeCreated, Subject: cc.AccountID}
+		data := ContractChangeData{AccountID: cc.AccountID, ContractID: cc.ContractID, OperationID: cc.OperationID, LedgerNumber: cc.LedgerNumber, ContractType: string(cc.ContractType)}
+		if err := add(EntityContractChange, cc.ContractID, e, data); err != nil {
+			return nil, err
+		}
+	}
+	for _, escrow := range buffer.GetEscrows() {
+		e, err := b.Escrow(escrow, ledgerSeq, closeTime)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, Keyed{Event: e, Entity: EntityEscrow, Key: escrow.ContractID})
+	}
+
+	return events, nil
+}
+
+// StateChange builds the event of a state change.
+func (b *Builder) StateChange(sc types.StateChange, ledgerSeq uint32) (Event, error) {
+	e := Event{
+		ID:         fmt.Sprintf("sc:%d-%d", sc.ToID, sc.StateChangeOrder),
+		Source:     source(b.networkOf(sc.Network), sc.AccountID),
+		Network:    sc.Network,
+		Type:       TypeStateChangeCreated,
+		Subject:    sc.TxHash,
+		Time:       sc.LedgerCreatedAt,
+		DataSchema: SchemaStateChangeV1,
+	}
+	built, err := b.build(e, NewStateChangeV1(sc), ledgerSeq, sc.LedgerCreatedAt)
+	if err != nil {
+		return Event{}, fmt.Errorf("building state change event %s: %w", e.ID, err)
+	}
+	return built, nil
+}
+
+// Escrow builds the event of a deployed escrow, or of its state after a lifecycle invocation.
+// closeTime is the close time of the ledger the escrow was deployed or changed in.
+func (b *Builder) Escrow(escrow entities.Escrow, ledgerSeq uint32, closeTime time.Time) (Event, error) {
+	e := Event{
+		ID:         EscrowEventID(escrow),
+		Source:     source(b.networkOf(escrow.Network), escrow.ContractID),
+		Network:    escrow.Network,
+		Type:       TypeEscrowCreated,
+		Subject:    escrow.EngagementID,
+		DataSchema: SchemaEscrowV1,
+	}
+	if escrow.IsUpdate() {
+		e.Type = TypeEscrowUpdated
+	}
+	built, err := b.build(e, NewEscrowV1(escrow), ledgerSeq, closeTime)
+	if err != nil {
+		return Event{}, fmt.Errorf("building escrow event %s: %w", e.ID, err)
+	}
+	return built, nil
+}
+
+// EscrowEventID is the ID of the event of an escrow: "escrow:<contract>" for its deployment,
+// "escrow:<contract>:<operation>" for its updates.
+func EscrowEventID(escrow entities.Escrow) string {
+	if escrow.IsUpdate() {
+		return fmt.Sprintf("escrow:%s:%d", escrow.ContractID, escrow.OperationID)
+	}
+	return "escrow:" + escrow.ContractID
+}
+
+// build fills the envelope attributes shared by every event and marshals the data.
+func (b *Builder) build(e Event, data any, ledgerSeq uint32, closeTime time.Time) (Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("marshalling data: %w", err)
+	}
+	e.SpecVersion = SpecVersion
+	e.DataContentType = DataContentType
+	e.LedgerSeq = ledgerSeq
+	e.Network = b.networkOf(e.Network)
+	e.Data = payload
+	if e.Time.IsZero() {
+		e.Time = closeTime
+	}
+	return e, nil
+}
@@ -0,0 +1,37 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+)
+
+func TestEscrowEvent(t *testing.T) {
+	closedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deployed := entities.Escrow{ContractID: "CESCROW", EngagementID: "ENG-1", OperationID: 42}
+	disputed := deployed
+	disputed.Function = "dispute_escrow"
+	disputed.OperationID = 84
+
+	tests := []struct {
+		name     string
+		escrow   entities.Escrow
+		wantID   string
+		wantType Type
+	}{
+		{name: "deployment", escrow: deployed, wantID: "escrow:CESCROW", wantType: TypeEscrowCreated},
+		{name: "update", escrow: disputed, wantID: "escrow:CESCROW:84", wantType: TypeEscrowUpdated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewBuilder("testnet").Escrow(tt.escrow, 100, closedAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.ID != tt.wantID || e.Type != tt.wantType || e.Subject != "ENG-1" {
+				t.Fatalf("event = %s %s %s, want %s %s ENG-1", e.ID, e.Type, e.Subject, tt.wantID, tt.wantType)
+			}
+		})
+	}
+}
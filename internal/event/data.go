@@ -0,0 +1,207 @@
+package event
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+// EscrowV1 is the data of TypeEscrowCreated and TypeEscrowUpdated events, described by the
+// SchemaEscrowV1 schema.
+// Amounts are encoded as strings so they survive JSON parsers limited to 53 bits integers.
+type EscrowV1 struct {
+	ContractID       string        `json:"contractId"`
+	EscrowType       string        `json:"escrowType"`
+	Deployer         string        `json:"deployer"`
+	FactoryContract  string        `json:"factoryContract,omitempty"`
+	DeployerSalt     string        `json:"deployerSalt,omitempty"`
+	WasmHash         string        `json:"wasmHash,omitempty"`
+	InitFunction     string        `json:"initFunction,omitempty"`
+	EngagementID     string        `json:"engagementId"`
+	Title            string        `json:"title"`
+	Description      string        `json:"description"`
+	Amount           string        `json:"amount,omitempty"`
+	PlatformFee      uint32        `json:"platformFee"`
+	ReceiverMemo     string        `json:"receiverMemo,omitempty"`
+	TrustlineAddress string        `json:"trustlineAddress"`
+	Flags            *FlagsV1      `json:"flags,omitempty"`
+	Roles            RolesV1       `json:"roles"`
+	Milestones       []MilestoneV1 `json:"milestones"`
+}
+
+type FlagsV1 struct {
+	Approved bool `json:"approved"`
+	Disputed bool `json:"disputed"`
+	Released bool `json:"released"`
+	Resolved bool `json:"resolved"`
+}
+
+type RolesV1 struct {
+	ServiceProvider string `json:"serviceProvider"`
+	Receiver        string `json:"receiver,omitempty"`
+	Approver        string `json:"approver"`
+	ReleaseSigner   string `json:"releaseSigner"`
+	DisputeResolver string `json:"disputeResolver"`
+	PlatformAddress string `json:"platformAddress"`
+}
+
+type MilestoneV1 struct {
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	Evidence    string   `json:"evidence"`
+	Approved    bool     `json:"approved"`
+	Amount      string   `json:"amount,omitempty"`
+	Receiver    string   `json:"receiver,omitempty"`
+	Flags       *FlagsV1 `json:"flags,omitempty"`
+}
+
+// NewEscrowV1 converts an escrow to its v1 payload. Single release escrows carry the amount
+// and flags at the escrow level, multi release escrows carry them per milestone.
+func NewEscrowV1(escrow entities.Escrow) EscrowV1 {
+	data := EscrowV1{
+		ContractID:       escrow.ContractID,
+		EscrowType:       string(escrow.EscrowType),
+		Deployer:         escrow.Deployer,
+		FactoryContract:  escrow.FactoryContract,
+		DeployerSalt:     escrow.DeployerSalt,
+		WasmHash:         escrow.WasmHash,
+		InitFunction:     escrow.InitFunction,
+		EngagementID:     escrow.EngagementID,
+		Title:            escrow.Title,
+		Description:      escrow.Description,
+		PlatformFee:      escrow.PlatformFee,
+		ReceiverMemo:     escrow.ReceiverMemo,
+		TrustlineAddress: escrow.TrustlineAddress,
+		Roles: RolesV1{
+			ServiceProvider: escrow.Roles.ServiceProvider,
+			Receiver:        escrow.Roles.Receiver,
+			Approver:        escrow.Roles.Approver,
+			ReleaseSigner:   escrow.Roles.ReleaseSigner,
+			DisputeResolver: escrow.Roles.DisputeResolver,
+			PlatformAddress: escrow.Roles.PlatformAddress,
+		},
+		Milestones: make([]MilestoneV1, 0, len(escrow.Milestones)),
+	}
+
+	if escrow.EscrowType == entities.EscrowTypeSingleRelease {
+		data.Amount = strconv.FormatUint(escrow.Amount, 10)
+		data.Flags = newFlagsV1(&escrow.Flags)
+	}
+
+	for _, milestone := range escrow.Milestones {
+		m := MilestoneV1{
+			Description: milestone.Description,
+			Status:      milestone.Status,
+			Evidence:    milestone.Evidence,
+			Approved:    milestone.Approved,
+			Receiver:    milestone.Receiver,
+			Flags:       newFlagsV1(milestone.Flags),
+		}
+		if escrow.EscrowType == entities.EscrowTypeMultiRelease {
+			m.Amount = strconv.FormatUint(milestone.Amount, 10)
+		}
+		data.Milestones = append(data.Milestones, m)
+	}
+
+	return data
+}
+
+func newFlagsV1(flags *entities.EscrowFlags) *FlagsV1 {
+	if flags == nil {
+		return nil
+	}
+	return &FlagsV1{
+		Approved: flags.Approved,
+		Disputed: flags.Disputed,
+		Released: flags.Released,
+		Resolved: flags.Resolved,
+	}
+}
+
+// StateChangeV1 is the data of TypeStateChangeCreated events, described by the
+// SchemaStateChangeV1 schema. Nullable columns of types.StateChange are omitted when null.
+type StateChangeV1 struct {
+	ToID               int64          `json:"toId"`
+	StateChangeOrder   int64          `json:"stateChangeOrder"`
+	Category           string         `json:"category"`
+	Reason             string         `json:"reason,omitempty"`
+	LedgerNumber       uint32         `json:"ledgerNumber"`
+	LedgerCreatedAt    time.Time      `json:"ledgerCreatedAt"`
+	AccountID          string         `json:"accountId"`
+	OperationID        int64          `json:"operationId"`
+	TxHash             string         `json:"txHash"`
+	TokenID            string         `json:"tokenId,omitempty"`
+	Amount             string         `json:"amount,omitempty"`
+	OfferID            string         `json:"offerId,omitempty"`
+	SignerAccountID    string         `json:"signerAccountId,omitempty"`
+	SpenderAccountID   string         `json:"spenderAccountId,omitempty"`
+	SponsoredAccountID string         `json:"sponsoredAccountId,omitempty"`
+	SponsorAccountID   string         `json:"sponsorAccountId,omitempty"`
+	DeployerAccountID  string         `json:"deployerAccountId,omitempty"`
+	FunderAccountID    string         `json:"funderAccountId,omitempty"`
+	SignerWeights      map[string]any `json:"signerWeights,omitempty"`
+	Thresholds         map[string]any `json:"thresholds,omitempty"`
+	TrustlineLimit     map[string]any `json:"trustlineLimit,omitempty"`
+	Flags              []string       `json:"flags,omitempty"`
+	KeyValue           map[string]any `json:"keyValue,omitempty"`
+}
+
+// NewStateChangeV1 converts a state change to its v1 payload.
+func NewStateChangeV1(sc types.StateChange) StateChangeV1 {
+	data := StateChangeV1{
+		ToID:               sc.ToID,
+		StateChangeOrder:   sc.StateChangeOrder,
+		Category:           string(sc.StateChangeCategory),
+		LedgerNumber:       sc.LedgerNumber,
+		LedgerCreatedAt:    sc.LedgerCreatedAt,
+		AccountID:          sc.AccountID,
+		OperationID:        sc.OperationID,
+		TxHash:             sc.TxHash,
+		TokenID:            nullString(sc.TokenID),
+		Amount:             nullString(sc.Amount),
+		OfferID:            nullString(sc.OfferID),
+		SignerAccountID:    nullString(sc.SignerAccountID),
+		SpenderAccountID:   nullString(sc.SpenderAccountID),
+		SponsoredAccountID: nullString(sc.SponsoredAccountID),
+		SponsorAccountID:   nullString(sc.SponsorAccountID),
+		DeployerAccountID:  nullString(sc.DeployerAccountID),
+		FunderAccountID:    nullString(sc.FunderAccountID),
+		SignerWeights:      sc.SignerWeights,
+		Thresholds:         sc.Thresholds,
+		TrustlineLimit:     sc.TrustlineLimit,
+		Flags:              sc.Flags,
+		KeyValue:           sc.KeyValue,
+	}
+	if sc.StateChangeReason != nil {
+		data.Reason = string(*sc.StateChangeReason)
+	}
+	return data
+}
+
+func nullString(s sql.NullString) string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
+// TrustlineChangeData is the data of TypeTrustlineChangeCreated events.
+type TrustlineChangeData struct {
+	AccountID    string `json:"accountId"`
+	Asset        string `json:"asset"`
+	OperationID  int64  `json:"operationId"`
+	LedgerNumber uint32 `json:"ledgerNumber"`
+	Operation    string `json:"operation"`
+}
+
+// ContractChangeData is the data of TypeContractChangeCreated events.
+type ContractChangeData struct {
+	AccountID    string `json:"accountId"`
+	ContractID   string `json:"contractId"`
+	OperationID  int64  `json:"operationId"`
+	LedgerNumber uint32 `json:"ledgerNumber"`
+	ContractType string `json:"contractType"`
+}
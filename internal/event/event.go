@@ -0,0 +1,68 @@
+// Package event defines the canonical envelope of every event emitted by the indexer.
+//
+// Events follow the CloudEvents 1.0 JSON format, so HTTP, AMQP and Kafka consumers all
+// receive the same shape:
+//
+//	{
+//	  "specversion": "1.0",
+//	  "id": "escrow:CABC...",
+//	  "source": "/stellar/testnet/CABC...",
+//	  "type": "work.trustless.escrow.created",
+//	  "subject": "engagement-42",
+//	  "time": "2025-01-01T00:00:00Z",
+//	  "datacontenttype": "application/json",
+//	  "dataschema": "urn:trustless-work:indexer:schema:escrow:v1",
+//	  "ledgerseq": 123456,
+//	  "network": "testnet",
+//	  "data": { ... }
+//	}
+//
+// The payload of escrow and state change events is a versioned data type (EscrowV1,
+// StateChangeV1) described by a JSON schema embedded in this package (see Schemas).
+// Breaking changes to a payload must introduce a new version instead of modifying the
+// existing one.
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version implemented by Event.
+	SpecVersion = "1.0"
+	// ContentType is the media type of an event in structured mode.
+	ContentType = "application/cloudevents+json"
+	// DataContentType is the media type of the data of every event.
+	DataContentType = "application/json"
+)
+
+// Type is the CloudEvents type attribute, a reverse-DNS name of the event.
+type Type string
+
+const (
+	TypeTransactionCreated     Type = "work.trustless.transaction.created"
+	TypeOperationCreated       Type = "work.trustless.operation.created"
+	TypeStateChangeCreated     Type = "work.trustless.state_change.created"
+	TypeTrustlineChangeCreated Type = "work.trustless.trustline_change.created"
+	TypeContractChangeCreated  Type = "work.trustless.contract_change.created"
+	TypeEscrowCreated          Type = "work.trustless.escrow.created"
+	TypeEscrowUpdated          Type = "work.trustless.escrow.updated"
+)
+
+// Event is a CloudEvents 1.0 event in JSON format.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            Type      `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	DataSchema      string    `json:"dataschema,omitempty"`
+	// LedgerSeq is an extension attribute with the ledger that produced the event.
+	LedgerSeq uint32 `json:"ledgerseq"`
+	// Network is an extension attribute with the name of the network the event comes from.
+	Network string          `json:"network,omitempty"`
+	Data    json.RawMessage `json:"data"`
+}
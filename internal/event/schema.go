@@ -0,0 +1,38 @@
+package event
+
+import (
+	"embed"
+	"fmt"
+)
+
+// Data schema identifiers, used as the dataschema attribute and as the $id of the schemas.
+const (
+	SchemaEscrowV1      = "urn:trustless-work:indexer:schema:escrow:v1"
+	SchemaStateChangeV1 = "urn:trustless-work:indexer:schema:state_change:v1"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+var schemaPaths = map[string]string{
+	SchemaEscrowV1:      "schemas/escrow.v1.json",
+	SchemaStateChangeV1: "schemas/state_change.v1.json",
+}
+
+// Schemas lists the identifiers of every published data schema.
+func Schemas() []string {
+	return []string{SchemaEscrowV1, SchemaStateChangeV1}
+}
+
+// Schema returns the JSON schema document of a dataschema identifier.
+func Schema(id string) ([]byte, error) {
+	path, ok := schemaPaths[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown data schema %q", id)
+	}
+	data, err := schemaFiles.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading data schema %q: %w", id, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,132 @@
+package event
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// validate checks the data of an event against the embedded schema of its dataschema attribute.
+func validate(t *testing.T, e Event) {
+	t.Helper()
+	schema, err := Schema(e.DataSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewBytesLoader(e.Data))
+	if err != nil {
+		t.Fatalf("validating %s: %v", e.DataSchema, err)
+	}
+	for _, desc := range result.Errors() {
+		t.Errorf("%s: %s", e.DataSchema, desc)
+	}
+}
+
+// checkProperties checks that every JSON field of the data type is declared by the schema, so
+// fields omitted when empty do not escape validate.
+func checkProperties(t *testing.T, id string, data any) {
+	t.Helper()
+	raw, err := Schema(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatal(err)
+	}
+	typ := reflect.TypeOf(data)
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("%s: field %s of %s is not declared", id, name, typ.Name())
+		}
+	}
+}
+
+func testEscrow(escrowType entities.EscrowType) entities.Escrow {
+	escrow := entities.Escrow{
+		ContractID:       "CESCROW",
+		EscrowType:       escrowType,
+		Deployer:         "GDEPLOYER",
+		FactoryContract:  "CFACTORY",
+		DeployerSalt:     "salt",
+		WasmHash:         "hash",
+		InitFunction:     "tw_new_single_release_escrow",
+		Amount:           1000,
+		Description:      "description",
+		EngagementID:     "engagement",
+		Title:            "title",
+		PlatformFee:      5,
+		ReceiverMemo:     "memo",
+		Flags:            entities.EscrowFlags{Disputed: true},
+		TrustlineAddress: "CUSDC",
+		Network:          "testnet",
+		Roles: entities.EscrowRoles{
+			ServiceProvider: "GPROVIDER",
+			Receiver:        "GRECEIVER",
+			Approver:        "GAPPROVER",
+			ReleaseSigner:   "GSIGNER",
+			DisputeResolver: "GRESOLVER",
+			PlatformAddress: "GPLATFORM",
+		},
+		Milestones: []entities.Milestone{{Description: "first", Status: "pending", Evidence: "link", Approved: true}},
+	}
+	if escrowType == entities.EscrowTypeMultiRelease {
+		escrow.Amount = 0
+		escrow.Flags = entities.EscrowFlags{}
+		escrow.Milestones[0].Amount = 500
+		escrow.Milestones[0].Receiver = "GRECEIVER"
+		escrow.Milestones[0].Flags = &entities.EscrowFlags{Approved: true}
+	}
+	return escrow
+}
+
+func TestEscrowV1Schema(t *testing.T) {
+	b := NewBuilder("testnet")
+	for _, escrowType := range []entities.EscrowType{entities.EscrowTypeSingleRelease, entities.EscrowTypeMultiRelease} {
+		t.Run(string(escrowType), func(t *testing.T) {
+			e, err := b.Escrow(testEscrow(escrowType), 100, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			validate(t, e)
+		})
+	}
+	checkProperties(t, SchemaEscrowV1, EscrowV1{})
+}
+
+func TestStateChangeV1Schema(t *testing.T) {
+	reason := types.StateChangeReasonCredit
+	sc := types.StateChange{
+		ToID:                1 << 32,
+		StateChangeOrder:    1,
+		StateChangeCategory: types.StateChangeCategoryBalance,
+		StateChangeReason:   &reason,
+		LedgerNumber:        100,
+		LedgerCreatedAt:     time.Now(),
+		AccountID:           "GACCOUNT",
+		OperationID:         1<<32 | 1,
+		TxHash:              "hash",
+		TokenID:             sql.NullString{String: "CTOKEN", Valid: true},
+		Amount:              sql.NullString{String: "100", Valid: true},
+		SignerWeights:       map[string]any{"GSIGNER": 1},
+		Flags:               []string{"auth_required"},
+		KeyValue:            map[string]any{"key": "value"},
+		Network:             "testnet",
+	}
+	e, err := NewBuilder("testnet").StateChange(sc, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validate(t, e)
+	checkProperties(t, SchemaStateChangeV1, StateChangeV1{})
+}
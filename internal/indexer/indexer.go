@@ -10,6 +10,9 @@ import (
 	"github.com/Trustless-Work/Indexer/internal/entities"
 	"github.com/Trustless-Work/Indexer/internal/indexer/processors"
 	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
 	"github.com/alitto/pond/v2"
 	"github.com/stellar/go-stellar-sdk/support/log"
 
@@ -19,6 +22,10 @@ import (
 	contract_processors "github.com/Trustless-Work/Indexer/internal/indexer/processors/contracts"
 )
 
+// tokenTransferProcessorName labels the metrics of the token transfer processor, which has no
+// Name method.
+const tokenTransferProcessorName = "token_transfer"
+
 // isContractAddress determines if the given address is a contract address (C...) or account address (G...)
 func isContractAddress(address string) bool {
 	// Contract addresses start with 'C' and account addresses start with 'G'
@@ -74,9 +81,12 @@ type Indexer struct {
 	skipTxMeta             bool
 	skipTxEnvelope         bool
 	networkPassphrase      string
+	// network is the name every entity is tagged with, e.g. "testnet".
+	network string
 }
 
-func NewIndexer(networkPassphrase string, pool pond.Pool, skipTxMeta bool, skipTxEnvelope bool) *Indexer {
+// NewIndexer creates an Indexer for the network named network, whose passphrase is networkPassphrase.
+func NewIndexer(network, networkPassphrase string, pool pond.Pool, skipTxMeta bool, skipTxEnvelope bool) *Indexer {
 	return &Indexer{
 		participantsProcessor:  processors.NewParticipantsProcessor(networkPassphrase),
 		tokenTransferProcessor: processors.NewTokenTransferProcessor(networkPassphrase),
@@ -89,6 +99,7 @@ func NewIndexer(networkPassphrase string, pool pond.Pool, skipTxMeta bool, skipT
 		skipTxMeta:        skipTxMeta,
 		skipTxEnvelope:    skipTxEnvelope,
 		networkPassphrase: networkPassphrase,
+		network:           network,
 	}
 }
 
@@ -137,7 +148,11 @@ func (i *Indexer) ProcessLedgerTransactions(ctx context.Context, transactions []
 	return totalParticipants, nil
 }
 
-func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransaction, buffer *IndexerBuffer) (int, error) {
+func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransaction, buffer *IndexerBuffer) (count int, err error) {
+	txHash := tx.Hash.HexString()
+	ctx = logging.WithFields(ctx, log.F{logging.FieldTxHash: txHash})
+	ctx, span := tracing.Start(ctx, "processTransaction", tracing.AttrTxHash.String(txHash))
+	defer func() { tracing.End(span, err) }()
 
 	// Get transaction participants
 	txParticipants, err := i.participantsProcessor.GetTransactionParticipants(tx)
@@ -160,8 +175,8 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 	// Get escrows
 	escrows := []entities.Escrow{}
 	for _, opPartipants := range opsParticipants {
-		escrowProcessed, err := i.escrowProcessor.ProcessTransaction(ctx, opPartipants.OpWrapper)
-		if err != nil && !errors.Is(err, processors.ErrInvalidOpType) {
+		escrowProcessed, err := i.processEscrows(ctx, opPartipants.OpWrapper)
+		if err != nil {
 			return 0, fmt.Errorf("processing escrow: %w", err)
 		}
 		escrows = append(escrows, escrowProcessed...)
@@ -169,7 +184,13 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 
 	// Insert escrows in buffer
 	for _, escrow := range escrows {
+		escrow.Network = i.network
 		buffer.PushEscrow(escrow)
+		if escrow.IsUpdate() {
+			metrics.EscrowUpdates.WithLabelValues(i.network, escrow.Function).Inc()
+		} else {
+			metrics.EscrowsDetected.WithLabelValues(i.network, string(escrow.EscrowType)).Inc()
+		}
 	}
 
 	// Convert transaction data
@@ -177,6 +198,7 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 	if err != nil {
 		return 0, fmt.Errorf("creating data transaction: %w", err)
 	}
+	dataTx.Network = i.network
 
 	// Count all unique participants for metrics
 	allParticipants := set.NewSet[string]()
@@ -200,6 +222,7 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 		if opErr != nil {
 			return 0, fmt.Errorf("creating data operation: %w", opErr)
 		}
+		dataOp.Network = i.network
 		operationsMap[opID] = dataOp
 		for participant := range opParticipants.Participants.Iter() {
 			buffer.PushOperation(participant, *dataOp, *dataTx)
@@ -216,6 +239,7 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 				OperationID:  stateChange.OperationID,
 				Asset:        stateChange.TrustlineAsset,
 				LedgerNumber: tx.Ledger.LedgerSequence(),
+				Network:      i.network,
 			}
 			//exhaustive:ignore
 			switch *stateChange.StateChangeReason {
@@ -241,6 +265,7 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 					ContractID:   stateChange.TokenID.String,
 					LedgerNumber: tx.Ledger.LedgerSequence(),
 					ContractType: stateChange.ContractType,
+					Network:      i.network,
 				}
 				buffer.PushContractChange(contractChange)
 			}
@@ -255,6 +280,7 @@ func (i *Indexer) processTransaction(ctx context.Context, tx ingest.LedgerTransa
 	perOpIdx := make(map[int64]int)
 	for idx := range stateChanges {
 		sc := &stateChanges[idx]
+		sc.Network = i.network
 
 		// State changes are 1-indexed within an operation/transaction.
 		if sc.OperationID != 0 {
@@ -297,8 +323,8 @@ func (i *Indexer) getTransactionStateChanges(ctx context.Context, transaction in
 	// Creating a worker pool here adds unnecessary overhead
 	for _, opParticipants := range opsParticipants {
 		for _, processor := range i.processors {
-			processorStateChanges, processorErr := processor.ProcessOperation(ctx, opParticipants.OpWrapper)
-			if processorErr != nil && !errors.Is(processorErr, processors.ErrInvalidOpType) {
+			processorStateChanges, processorErr := i.processOperation(ctx, processor, opParticipants.OpWrapper)
+			if processorErr != nil {
 				return nil, fmt.Errorf("processing %s state changes: %w", processor.Name(), processorErr)
 			}
 			stateChanges = append(stateChanges, processorStateChanges...)
@@ -306,11 +332,57 @@ func (i *Indexer) getTransactionStateChanges(ctx context.Context, transaction in
 	}
 
 	// Get token transfer state changes
-	tokenTransferStateChanges, err := i.tokenTransferProcessor.ProcessTransaction(ctx, transaction)
+	tokenCtx := logging.WithFields(ctx, log.F{logging.FieldProcessor: tokenTransferProcessorName})
+	tokenCtx, span := tracing.Start(tokenCtx, "ProcessTokenTransfers", tracing.AttrProcessor.String(tokenTransferProcessorName))
+	tokenTransferStateChanges, err := i.tokenTransferProcessor.ProcessTransaction(tokenCtx, transaction)
+	tracing.End(span, err)
 	if err != nil {
+		metrics.ProcessorErrors.WithLabelValues(i.network, tokenTransferProcessorName).Inc()
 		return nil, fmt.Errorf("processing token transfer state changes: %w", err)
 	}
+	metrics.ProcessorStateChanges.WithLabelValues(i.network, tokenTransferProcessorName).Add(float64(len(tokenTransferStateChanges)))
 	stateChanges = append(stateChanges, tokenTransferStateChanges...)
 
 	return stateChanges, nil
 }
+
+// processOperation runs an operation processor on one operation. Operations of a type the
+// processor does not handle yield no state changes.
+func (i *Indexer) processOperation(ctx context.Context, processor OperationProcessorInterface, opWrapper *processors.TransactionOperationWrapper) ([]types.StateChange, error) {
+	ctx = logging.WithFields(ctx, log.F{logging.FieldOpID: opWrapper.ID(), logging.FieldProcessor: processor.Name()})
+	ctx, span := tracing.Start(ctx, "ProcessOperation",
+		tracing.AttrProcessor.String(processor.Name()),
+		tracing.AttrOperationID.Int64(opWrapper.ID()),
+	)
+	stateChanges, err := processor.ProcessOperation(ctx, opWrapper)
+	if errors.Is(err, processors.ErrInvalidOpType) {
+		err = nil
+	}
+	tracing.End(span, err)
+	if err != nil {
+		metrics.ProcessorErrors.WithLabelValues(i.network, processor.Name()).Inc()
+		return nil, err
+	}
+	metrics.ProcessorStateChanges.WithLabelValues(i.network, processor.Name()).Add(float64(len(stateChanges)))
+	return stateChanges, nil
+}
+
+// processEscrows runs the escrow processor on one operation. Operations of a type the
+// processor does not handle yield no escrows.
+func (i *Indexer) processEscrows(ctx context.Context, opWrapper *processors.TransactionOperationWrapper) ([]entities.Escrow, error) {
+	ctx = logging.WithFields(ctx, log.F{logging.FieldOpID: opWrapper.ID(), logging.FieldProcessor: i.escrowProcessor.Name()})
+	ctx, span := tracing.Start(ctx, "ProcessEscrows",
+		tracing.AttrProcessor.String(i.escrowProcessor.Name()),
+		tracing.AttrOperationID.Int64(opWrapper.ID()),
+	)
+	escrows, err := i.escrowProcessor.ProcessTransaction(ctx, opWrapper)
+	if errors.Is(err, processors.ErrInvalidOpType) {
+		err = nil
+	}
+	tracing.End(span, err)
+	if err != nil {
+		metrics.ProcessorErrors.WithLabelValues(i.network, i.escrowProcessor.Name()).Inc()
+		return nil, err
+	}
+	return escrows, nil
+}
@@ -361,3 +361,87 @@ func (b *IndexerBuffer) GetAllParticipants() []string {
 
 	return b.allParticipants.ToSlice()
 }
+
+// BufferTransformer decides which entities of a buffer are kept by Transform, and may
+// modify the kept entities. Each method receives a copy of the entity, so changes never
+// affect the original buffer.
+type BufferTransformer interface {
+	Transaction(tx *types.Transaction) bool
+	Operation(op *types.Operation) bool
+	StateChange(sc *types.StateChange) bool
+	TrustlineChange(tc *types.TrustlineChange) bool
+	ContractChange(cc *types.ContractChange) bool
+	Escrow(escrow *entities.Escrow) bool
+}
+
+// Transform returns a new buffer containing the entities of this buffer accepted by t.
+// Participants of the kept transactions and operations are preserved, and the global
+// participants set is rebuilt from the kept entities.
+//
+// Thread-safe: uses read lock on this buffer.
+func (b *IndexerBuffer) Transform(t BufferTransformer) *IndexerBuffer {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	out := NewIndexerBuffer()
+
+	for txHash, txPtr := range b.txByHash {
+		tx := *txPtr
+		if !t.Transaction(&tx) {
+			continue
+		}
+		out.txByHash[txHash] = &tx
+		participants := set.NewSet[string]()
+		if txParticipants, ok := b.participantsByTxHash[txHash]; ok {
+			participants = txParticipants.Clone()
+		}
+		out.participantsByTxHash[txHash] = participants
+		out.allParticipants = out.allParticipants.Union(participants)
+	}
+
+	for opID, opPtr := range b.opByID {
+		op := *opPtr
+		if !t.Operation(&op) {
+			continue
+		}
+		out.opByID[opID] = &op
+		participants := set.NewSet[string]()
+		if opParticipants, ok := b.participantsByOpID[opID]; ok {
+			participants = opParticipants.Clone()
+		}
+		out.participantsByOpID[opID] = participants
+		out.allParticipants = out.allParticipants.Union(participants)
+	}
+
+	for _, sc := range b.stateChanges {
+		if t.StateChange(&sc) {
+			out.stateChanges = append(out.stateChanges, sc)
+			if sc.AccountID != "" {
+				out.allParticipants.Add(sc.AccountID)
+			}
+		}
+	}
+
+	for _, tc := range b.trustlineChanges {
+		if t.TrustlineChange(&tc) {
+			out.trustlineChanges = append(out.trustlineChanges, tc)
+		}
+	}
+
+	for _, cc := range b.contractChanges {
+		if t.ContractChange(&cc) {
+			out.contractChanges = append(out.contractChanges, cc)
+		}
+	}
+
+	for _, escrow := range b.escrows {
+		if t.Escrow(&escrow) {
+			out.escrows = append(out.escrows, escrow)
+		}
+	}
+
+	// Participants sets may contain the empty account of fee-less entries
+	out.allParticipants.Remove("")
+
+	return out
+}
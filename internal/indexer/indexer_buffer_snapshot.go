@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	set "github.com/deckarep/golang-set/v2"
+)
+
+// bufferSnapshot is the serializable representation of an IndexerBuffer. It is used to
+// persist a ledger buffer outside of the process (e.g. a sink dead-letter store) and to
+// rebuild an identical buffer later on.
+//
+// Canonical storage is flattened: each transaction/operation is stored once together with
+// the list of its participants, mirroring the participantsByTxHash/participantsByOpID maps.
+type bufferSnapshot struct {
+	Transactions     []transactionSnapshot   `json:"transactions"`
+	Operations       []operationSnapshot     `json:"operations"`
+	StateChanges     []stateChangeSnapshot   `json:"stateChanges"`
+	TrustlineChanges []types.TrustlineChange `json:"trustlineChanges"`
+	ContractChanges  []types.ContractChange  `json:"contractChanges"`
+	Escrows          []entities.Escrow       `json:"escrows"`
+	Participants     []string                `json:"participants"`
+}
+
+type transactionSnapshot struct {
+	Transaction  types.Transaction `json:"transaction"`
+	Participants []string          `json:"participants"`
+}
+
+type operationSnapshot struct {
+	Operation    types.Operation `json:"operation"`
+	Participants []string        `json:"participants"`
+}
+
+// stateChangeSnapshot keeps the internal state change fields that are hidden from the
+// regular JSON representation, so a restored buffer is identical to the original one.
+type stateChangeSnapshot struct {
+	types.StateChange
+	SortKey        string             `json:"sortKey"`
+	TxID           int64              `json:"txId"`
+	TrustlineAsset string             `json:"trustlineAsset"`
+	ContractType   types.ContractType `json:"contractType"`
+}
+
+var (
+	_ json.Marshaler   = (*IndexerBuffer)(nil)
+	_ json.Unmarshaler = (*IndexerBuffer)(nil)
+)
+
+// MarshalJSON serializes the full content of the buffer, including participants mappings.
+// Thread-safe: uses read lock.
+func (b *IndexerBuffer) MarshalJSON() ([]byte, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	snapshot := bufferSnapshot{
+		Transactions:     make([]transactionSnapshot, 0, len(b.txByHash)),
+		Operations:       make([]operationSnapshot, 0, len(b.opByID)),
+		StateChanges:     make([]stateChangeSnapshot, 0, len(b.stateChanges)),
+		TrustlineChanges: b.trustlineChanges,
+		ContractChanges:  b.contractChanges,
+		Escrows:          b.escrows,
+		Participants:     b.allParticipants.ToSlice(),
+	}
+
+	for txHash, tx := range b.txByHash {
+		var participants []string
+		if txParticipants, ok := b.participantsByTxHash[txHash]; ok {
+			participants = txParticipants.ToSlice()
+		}
+		snapshot.Transactions = append(snapshot.Transactions, transactionSnapshot{Transaction: *tx, Participants: participants})
+	}
+
+	for opID, op := range b.opByID {
+		var participants []string
+		if opParticipants, ok := b.participantsByOpID[opID]; ok {
+			participants = opParticipants.ToSlice()
+		}
+		snapshot.Operations = append(snapshot.Operations, operationSnapshot{Operation: *op, Participants: participants})
+	}
+
+	for _, sc := range b.stateChanges {
+		snapshot.StateChanges = append(snapshot.StateChanges, stateChangeSnapshot{
+			StateChange:    sc,
+			SortKey:        sc.SortKey,
+			TxID:           sc.TxID,
+			TrustlineAsset: sc.TrustlineAsset,
+			ContractType:   sc.ContractType,
+		})
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling buffer snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON replaces the content of the buffer with the serialized snapshot.
+// Thread-safe: acquires write lock.
+func (b *IndexerBuffer) UnmarshalJSON(data []byte) error {
+	var snapshot bufferSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("unmarshalling buffer snapshot: %w", err)
+	}
+
+	restored := NewIndexerBuffer()
+	for _, txSnapshot := range snapshot.Transactions {
+		tx := txSnapshot.Transaction
+		restored.txByHash[tx.Hash] = &tx
+		restored.participantsByTxHash[tx.Hash] = set.NewSet(txSnapshot.Participants...)
+	}
+	for _, opSnapshot := range snapshot.Operations {
+		op := opSnapshot.Operation
+		restored.opByID[op.ID] = &op
+		restored.participantsByOpID[op.ID] = set.NewSet(opSnapshot.Participants...)
+	}
+	for _, scSnapshot := range snapshot.StateChanges {
+		sc := scSnapshot.StateChange
+		sc.SortKey = scSnapshot.SortKey
+		sc.TxID = scSnapshot.TxID
+		sc.TrustlineAsset = scSnapshot.TrustlineAsset
+		sc.ContractType = scSnapshot.ContractType
+		restored.stateChanges = append(restored.stateChanges, sc)
+	}
+	restored.trustlineChanges = append(restored.trustlineChanges, snapshot.TrustlineChanges...)
+	restored.contractChanges = append(restored.contractChanges, snapshot.ContractChanges...)
+	restored.escrows = append(restored.escrows, snapshot.Escrows...)
+	restored.allParticipants.Append(snapshot.Participants...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.txByHash = restored.txByHash
+	b.participantsByTxHash = restored.participantsByTxHash
+	b.opByID = restored.opByID
+	b.participantsByOpID = restored.participantsByOpID
+	b.stateChanges = restored.stateChanges
+	b.trustlineChanges = restored.trustlineChanges
+	b.contractChanges = restored.contractChanges
+	b.escrows = restored.escrows
+	b.allParticipants = restored.allParticipants
+	return nil
+}
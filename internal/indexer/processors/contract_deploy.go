@@ -8,6 +8,7 @@ import (
 	"github.com/stellar/go-stellar-sdk/xdr"
 
 	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/logging"
 )
 
 // ContractDeployProcessor emits state changes for contract deployments.
@@ -29,7 +30,9 @@ func (p *ContractDeployProcessor) Name() string {
 func (p *ContractDeployProcessor) ProcessOperation(ctx context.Context, op *TransactionOperationWrapper) ([]types.StateChange, error) {
 
 	if op.OperationType() != xdr.OperationTypeInvokeHostFunction {
-		log.Ctx(ctx).Debugf("ContractDeployProcessor: skipping operation type %s (not InvokeHostFunction)", op.OperationType().String())
+		if logging.Sampled("contract_deploy_skip") {
+			log.Ctx(ctx).Debugf("ContractDeployProcessor: skipping operation type %s (not InvokeHostFunction)", op.OperationType().String())
+		}
 		return nil, ErrInvalidOpType
 	}
 	invokeHostOp := op.Operation.Body.MustInvokeHostFunctionOp()
@@ -109,8 +112,10 @@ func (p *ContractDeployProcessor) ProcessOperation(ctx context.Context, op *Tran
 	case xdr.HostFunctionTypeHostFunctionTypeUploadContractWasm:
 		log.Ctx(ctx).Debugf("InvokeHostFunction type: UploadContractWasm (OpID: %d)", opID)
 	case xdr.HostFunctionTypeHostFunctionTypeInvokeContract:
-		cc := hf.MustInvokeContract()
-		log.Ctx(ctx).Infof("Args: %v", cc.Args)
+		if logging.Sampled("contract_deploy_invoke") {
+			cc := hf.MustInvokeContract()
+			log.Ctx(ctx).WithFields(log.F{"function": string(cc.FunctionName), "args": len(cc.Args)}).Debug("InvokeHostFunction type: InvokeContract")
+		}
 	}
 
 	for _, auth := range invokeHostOp.Auth {
@@ -6,12 +6,14 @@ import (
 
 	"github.com/Trustless-Work/Indexer/internal/entities"
 	"github.com/Trustless-Work/Indexer/internal/indexer/processors"
+	"github.com/Trustless-Work/Indexer/internal/logging"
 	"github.com/stellar/go-stellar-sdk/strkey"
 	"github.com/stellar/go-stellar-sdk/support/log"
 	"github.com/stellar/go-stellar-sdk/xdr"
 )
 
-// ContractDeployProcessor emits state changes for contract deployments.
+// EscrowProcessor emits the escrows deployed through the escrow factories, and the state of an
+// escrow after each of its lifecycle invocations.
 type EscrowProcessor struct {
 	networkPassphrase string
 }
@@ -29,7 +31,9 @@ func (p *EscrowProcessor) Name() string {
 func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors.TransactionOperationWrapper) ([]entities.Escrow, error) {
 
 	if op.OperationType() != xdr.OperationTypeInvokeHostFunction {
-		log.Ctx(ctx).Debugf("ContractDeployProcessor: skipping operation type %s (not InvokeHostFunction)", op.OperationType().String())
+		if logging.Sampled("escrow_processor_skip") {
+			log.Ctx(ctx).Debugf("EscrowProcessor: skipping operation type %s (not InvokeHostFunction)", op.OperationType().String())
+		}
 		return nil, processors.ErrInvalidOpType
 	}
 
@@ -52,21 +56,8 @@ func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors
 		if err != nil {
 			return nil, fmt.Errorf("parsing single release escrow: %w", err)
 		}
-
-		log.Ctx(ctx).Infof("Single Release Escrow parsed successfully!")
-		log.Ctx(ctx).Infof("Contract ID (predicted): %s", escrow.ContractID)
-		log.Ctx(ctx).Infof("Deployer: %s", escrow.Deployer)
-		log.Ctx(ctx).Infof("Factory Contract: %s", escrow.FactoryContract)
-		log.Ctx(ctx).Infof("Title: %s", escrow.Title)
-		log.Ctx(ctx).Infof("Description: %s", escrow.Description)
-		log.Ctx(ctx).Infof("Amount: %d", escrow.Amount)
-		log.Ctx(ctx).Infof("EngagementID: %s", escrow.EngagementID)
-		log.Ctx(ctx).Infof("ServiceProvider: %s", escrow.Roles.ServiceProvider)
-		log.Ctx(ctx).Infof("Receiver: %s", escrow.Roles.Receiver)
-		if len(escrow.Milestones) > 0 {
-			log.Ctx(ctx).Infof("Milestones[0]: %s", escrow.Milestones[0].Description)
-		}
-
+		escrow.OperationID = op.ID()
+		logEscrowDetected(ctx, escrow)
 		return []entities.Escrow{*escrow}, nil
 
 	case "tw_new_multi_release_escrow":
@@ -74,26 +65,25 @@ func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors
 		if err != nil {
 			return nil, fmt.Errorf("parsing multi release escrow: %w", err)
 		}
+		escrow.OperationID = op.ID()
+		logEscrowDetected(ctx, escrow)
+		return []entities.Escrow{*escrow}, nil
 
-		log.Ctx(ctx).Infof("Multi Release Escrow parsed successfully!")
-		log.Ctx(ctx).Infof("Contract ID (predicted): %s", escrow.ContractID)
-		log.Ctx(ctx).Infof("Deployer: %s", escrow.Deployer)
-		log.Ctx(ctx).Infof("Factory Contract: %s", escrow.FactoryContract)
-		log.Ctx(ctx).Infof("Title: %s", escrow.Title)
-		log.Ctx(ctx).Infof("Description: %s", escrow.Description)
-		log.Ctx(ctx).Infof("EngagementID: %s", escrow.EngagementID)
-		log.Ctx(ctx).Infof("ServiceProvider: %s", escrow.Roles.ServiceProvider)
-		log.Ctx(ctx).Infof("Milestones count: %d", len(escrow.Milestones))
-		for i, m := range escrow.Milestones {
-			log.Ctx(ctx).Infof("  Milestone[%d]: %s, Amount: %d, Receiver: %s", i, m.Description, m.Amount, m.Receiver)
+	case "fund_escrow", "approve_milestone", "change_milestone_status", "update_escrow",
+		"dispute_escrow", "dispute_milestone", "resolve_dispute", "resolve_milestone_dispute",
+		"release_funds", "release_milestone_funds", "withdraw_remaining_funds":
+		escrow, err := p.escrowUpdate(op, contractID, string(functionName))
+		if err != nil {
+			return nil, fmt.Errorf("parsing escrow after %s: %w", functionName, err)
 		}
-
+		if escrow == nil {
+			return nil, nil
+		}
+		logEscrowUpdated(ctx, escrow)
 		return []entities.Escrow{*escrow}, nil
 
 	case "get_escrow":
-		log.Ctx(ctx).Infof("Escrow query parsed successfully!")
-		log.Ctx(ctx).Infof("Function: get_escrow")
-		log.Ctx(ctx).Infof("Contract invoked: %s", contractID)
+		logEscrowQuery(ctx, string(functionName), contractID, nil)
 		return nil, nil
 
 	case "get_escrow_by_contract_id":
@@ -101,10 +91,7 @@ func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors
 		if err != nil {
 			return nil, fmt.Errorf("parsing get_escrow_by_contract_id args: %w", err)
 		}
-		log.Ctx(ctx).Infof("Escrow query parsed successfully!")
-		log.Ctx(ctx).Infof("Function: get_escrow_by_contract_id")
-		log.Ctx(ctx).Infof("Contract invoked: %s", contractID)
-		log.Ctx(ctx).Infof("Contract ID: %s", escrowContractID)
+		logEscrowQuery(ctx, string(functionName), contractID, log.F{logging.FieldEscrowContract: escrowContractID})
 		return nil, nil
 
 	case "get_multiple_escrow_balances":
@@ -112,10 +99,7 @@ func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors
 		if err != nil {
 			return nil, fmt.Errorf("parsing get_multiple_escrow_balances args: %w", err)
 		}
-		log.Ctx(ctx).Infof("Escrow query parsed successfully!")
-		log.Ctx(ctx).Infof("Function: get_multiple_escrow_balances")
-		log.Ctx(ctx).Infof("Contract invoked: %s", contractID)
-		log.Ctx(ctx).Infof("Addresses: %v", addresses)
+		logEscrowQuery(ctx, string(functionName), contractID, log.F{"addresses": len(addresses)})
 		return nil, nil
 
 	default:
@@ -123,6 +107,81 @@ func (p *EscrowProcessor) ProcessTransaction(ctx context.Context, op *processors
 	}
 }
 
+// logEscrowDetected logs the deployment of an escrow as a single escrow_detected event.
+func logEscrowDetected(ctx context.Context, escrow *entities.Escrow) {
+	fields := log.F{
+		logging.FieldEvent:          "escrow_detected",
+		logging.FieldEscrowContract: escrow.ContractID,
+		"escrow_type":               escrow.EscrowType,
+		"engagement_id":             escrow.EngagementID,
+		"deployer":                  escrow.Deployer,
+		"factory_contract":          escrow.FactoryContract,
+		"platform_address":          escrow.Roles.PlatformAddress,
+		"service_provider":          escrow.Roles.ServiceProvider,
+		"milestones":                len(escrow.Milestones),
+	}
+	if escrow.EscrowType == entities.EscrowTypeSingleRelease {
+		fields["amount"] = escrow.Amount
+	}
+	log.Ctx(ctx).WithFields(fields).Info("Escrow detected")
+}
+
+// escrowUpdate reads the escrow a lifecycle invocation left in the instance storage of the
+// escrow contract. It returns nil when the invocation didn't change the escrow (e.g. a failed
+// transaction or a funding that only moved tokens).
+func (p *EscrowProcessor) escrowUpdate(op *processors.TransactionOperationWrapper, contractID, function string) (*entities.Escrow, error) {
+	changes, err := op.Transaction.GetOperationChanges(op.Index)
+	if err != nil {
+		return nil, fmt.Errorf("getting operation changes: %w", err)
+	}
+
+	for _, change := range changes {
+		if change.Type != xdr.LedgerEntryTypeContractData || change.Post == nil {
+			continue
+		}
+		contractData := change.Post.Data.MustContractData()
+		if contractData.Key.Type != xdr.ScValTypeScvLedgerKeyContractInstance {
+			continue
+		}
+		if id, err := p.getContractIDFromAddress(contractData.Contract); err != nil || id != contractID {
+			continue
+		}
+
+		escrow, err := ParseEscrowInstance(contractData.Val, contractID)
+		if err != nil || escrow == nil {
+			return nil, err
+		}
+		escrow.Function = function
+		escrow.OperationID = op.ID()
+		return escrow, nil
+	}
+	return nil, nil
+}
+
+// logEscrowUpdated logs the change of an escrow by a lifecycle invocation.
+func logEscrowUpdated(ctx context.Context, escrow *entities.Escrow) {
+	log.Ctx(ctx).WithFields(log.F{
+		logging.FieldEvent:          "escrow_updated",
+		logging.FieldEscrowContract: escrow.ContractID,
+		"escrow_type":               escrow.EscrowType,
+		"engagement_id":             escrow.EngagementID,
+		"function":                  escrow.Function,
+	}).Info("Escrow updated")
+}
+
+// logEscrowQuery logs a read-only call to an escrow contract. These calls are frequent, so
+// their logs are sampled.
+func logEscrowQuery(ctx context.Context, function, contractID string, fields log.F) {
+	if !logging.Sampled("escrow_query") {
+		return
+	}
+	entry := log.Ctx(ctx).WithFields(log.F{"function": function, "contract": contractID})
+	if fields != nil {
+		entry = entry.WithFields(fields)
+	}
+	entry.Debug("Escrow queried")
+}
+
 func (p *EscrowProcessor) getContractIDFromAddress(addr xdr.ScAddress) (string, error) {
 	if addr.Type != xdr.ScAddressTypeScAddressTypeContract {
 		return "", fmt.Errorf("not a contract address")
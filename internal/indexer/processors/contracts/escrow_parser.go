@@ -95,6 +95,49 @@ func parseEscrowArgs(args []xdr.ScVal, factoryContract string, networkPassphrase
 	return escrow, nil
 }
 
+// ParseEscrowInstance parses the escrow held in the instance storage of an escrow contract,
+// under the DataKey::Escrow key. It returns nil when the storage holds no escrow.
+func ParseEscrowInstance(instance xdr.ScVal, contractID string) (*entities.Escrow, error) {
+	contractInstance, ok := instance.GetInstance()
+	if !ok {
+		return nil, fmt.Errorf("invalid contract instance")
+	}
+	if contractInstance.Storage == nil {
+		return nil, nil
+	}
+
+	for _, entry := range *contractInstance.Storage {
+		if !isEscrowKey(entry.Key) {
+			continue
+		}
+		entries, err := extractMapFromScVal(entry.Val)
+		if err != nil {
+			return nil, fmt.Errorf("extracting escrow map: %w", err)
+		}
+
+		escrow := &entities.Escrow{ContractID: contractID, EscrowType: entities.EscrowTypeMultiRelease}
+		// Only single release escrows hold the amount at the escrow level
+		if _, ok := findInMap(entries, "amount"); ok {
+			escrow.EscrowType = entities.EscrowTypeSingleRelease
+		}
+		if err := parseEscrowData(entry.Val, escrow); err != nil {
+			return nil, fmt.Errorf("parsing escrow data: %w", err)
+		}
+		return escrow, nil
+	}
+	return nil, nil
+}
+
+// isEscrowKey reports whether a storage key is DataKey::Escrow, a unit enum variant encoded
+// as a vector holding its name.
+func isEscrowKey(key xdr.ScVal) bool {
+	if vec, ok := key.GetVec(); ok && vec != nil && len(*vec) == 1 {
+		key = (*vec)[0]
+	}
+	sym, ok := key.GetSym()
+	return ok && sym == "Escrow"
+}
+
 // deriveContractID calculates the contract ID from deployer address and salt
 func deriveContractID(networkPassphrase string, deployerAddr xdr.ScAddress, salt []byte) (string, error) {
 	// Convert salt to Uint256
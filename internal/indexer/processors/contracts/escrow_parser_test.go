@@ -0,0 +1,84 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+func symbol(s string) xdr.ScVal {
+	sym := xdr.ScSymbol(s)
+	return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &sym}
+}
+
+func boolean(b bool) xdr.ScVal {
+	return xdr.ScVal{Type: xdr.ScValTypeScvBool, B: &b}
+}
+
+func scMap(entries ...xdr.ScMapEntry) xdr.ScVal {
+	m := xdr.ScMap(entries)
+	mp := &m
+	return xdr.ScVal{Type: xdr.ScValTypeScvMap, Map: &mp}
+}
+
+func entry(key string, val xdr.ScVal) xdr.ScMapEntry {
+	return xdr.ScMapEntry{Key: symbol(key), Val: val}
+}
+
+func instance(storage ...xdr.ScMapEntry) xdr.ScVal {
+	m := xdr.ScMap(storage)
+	return xdr.ScVal{Type: xdr.ScValTypeScvContractInstance, Instance: &xdr.ScContractInstance{Storage: &m}}
+}
+
+func vec(vals ...xdr.ScVal) xdr.ScVal {
+	v := xdr.ScVec(vals)
+	vp := &v
+	return xdr.ScVal{Type: xdr.ScValTypeScvVec, Vec: &vp}
+}
+
+func TestParseEscrowInstance(t *testing.T) {
+	flags := scMap(entry("disputed", boolean(true)), entry("released", boolean(false)), entry("resolved", boolean(false)))
+	amount := xdr.ScVal{Type: xdr.ScValTypeScvI128, I128: &xdr.Int128Parts{Lo: 1000}}
+
+	tests := []struct {
+		name     string
+		escrow   xdr.ScVal
+		wantType entities.EscrowType
+		disputed func(*entities.Escrow) bool
+	}{
+		{
+			name:     "single release",
+			escrow:   scMap(entry("amount", amount), entry("flags", flags)),
+			wantType: entities.EscrowTypeSingleRelease,
+			disputed: func(e *entities.Escrow) bool { return e.Flags.Disputed },
+		},
+		{
+			name:     "multi release",
+			escrow:   scMap(entry("milestones", vec(scMap(entry("amount", amount), entry("flags", flags))))),
+			wantType: entities.EscrowTypeMultiRelease,
+			disputed: func(e *entities.Escrow) bool { return e.Milestones[0].Flags.Disputed },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			escrow, err := ParseEscrowInstance(instance(xdr.ScMapEntry{Key: vec(symbol("Escrow")), Val: tt.escrow}), "CESCROW")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if escrow == nil || escrow.ContractID != "CESCROW" || escrow.EscrowType != tt.wantType {
+				t.Fatalf("escrow = %+v, want a %s escrow CESCROW", escrow, tt.wantType)
+			}
+			if !tt.disputed(escrow) {
+				t.Fatalf("escrow = %+v, want it disputed", escrow)
+			}
+		})
+	}
+}
+
+func TestParseEscrowInstanceWithoutEscrow(t *testing.T) {
+	escrow, err := ParseEscrowInstance(instance(entry("Admin", boolean(true))), "CESCROW")
+	if err != nil || escrow != nil {
+		t.Fatalf("ParseEscrowInstance = %+v, %v, want no escrow", escrow, err)
+	}
+}
@@ -8,7 +8,7 @@
 //  1. DATABASE LAYER: A unified StateChange struct that contains all possible fields for any state change type.
 //     This allows efficient storage in a single database table with nullable fields.
 //
-//  2. GRAPHQL LAYER: A BaseStateChange interface with 8 concrete implementations (defined in
+//  2. GRAPHQL LAYER: A BaseStateChange interface with 10 concrete implementations (defined in
 //     internal/serve/graphql/schema/statechange.graphqls). Each type has only the fields relevant to that
 //     specific state change category, providing strong typing and clean API contracts.
 //
@@ -34,8 +34,11 @@ package types
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stellar/go-stellar-sdk/xdr"
@@ -56,6 +59,7 @@ type TrustlineChange struct {
 	OperationID  int64
 	LedgerNumber uint32
 	Operation    TrustlineOpType
+	Network      string
 }
 
 type TrustlineOpType string
@@ -71,6 +75,7 @@ type ContractChange struct {
 	OperationID  int64
 	LedgerNumber uint32
 	ContractType ContractType
+	Network      string
 }
 
 type Account struct {
@@ -105,6 +110,8 @@ type Transaction struct {
 	// or the transaction hash for regular transactions.
 	// This field is transient and not stored in the database.
 	InnerTransactionHash string `json:"innerTransactionHash,omitempty" db:"-"`
+	// Network is the name of the network the transaction was ingested from. Not stored in the database.
+	Network string `json:"network,omitempty" db:"-"`
 }
 
 type TransactionWithCursor struct {
@@ -204,6 +211,8 @@ type Operation struct {
 	Transaction  *Transaction  `json:"transaction,omitempty"`
 	Accounts     []Account     `json:"accounts,omitempty"`
 	StateChanges []StateChange `json:"stateChanges,omitempty"`
+	// Network is the name of the network the operation was ingested from. Not stored in the database.
+	Network string `json:"network,omitempty" db:"-"`
 }
 
 type OperationWithCursor struct {
@@ -325,6 +334,8 @@ type StateChange struct {
 	TrustlineAsset string `json:"-"`
 	// Internal only: used for filtering contract changes and identifying token type
 	ContractType ContractType `json:"-"`
+	// Network is the name of the network the state change was ingested from. Not stored in the database.
+	Network string `json:"network,omitempty" db:"-"`
 }
 
 type StateChangeWithCursor struct {
@@ -337,6 +348,32 @@ type StateChangeCursor struct {
 	StateChangeOrder int64 `db:"cursor_state_change_order"`
 }
 
+// Encode returns the opaque cursor of the state change APIs, the base64 of
+// "<to_id>:<state_change_order>".
+func (c StateChangeCursor) Encode() string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.ToID, c.StateChangeOrder)))
+}
+
+// DecodeStateChangeCursor parses a cursor returned by StateChangeCursor.Encode.
+func DecodeStateChangeCursor(cursor string) (StateChangeCursor, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return StateChangeCursor{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	toID, order, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return StateChangeCursor{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	parsed := StateChangeCursor{}
+	if parsed.ToID, err = strconv.ParseInt(toID, 10, 64); err != nil {
+		return StateChangeCursor{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	if parsed.StateChangeOrder, err = strconv.ParseInt(order, 10, 64); err != nil {
+		return StateChangeCursor{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return parsed, nil
+}
+
 type StateChangeCursorGetter interface {
 	GetCursor() StateChangeCursor
 }
@@ -527,7 +564,7 @@ func (sc StateChange) GetCursor() StateChangeCursor {
 // - gqlgen generates type-specific response marshaling based on the wrapper type
 //
 // This approach maintains the single-table database efficiency while satisfying GraphQL's
-// strong typing requirements. See gqlgen.yml lines 170-196 for the GraphQL-to-Go type mappings.
+// strong typing requirements. See the state change models in gqlgen.yml for the GraphQL-to-Go type mappings.
 
 // StandardBalanceStateChangeModel represents payment-related state changes from classic/SAC/SEP41 balances(CREDIT/DEBIT/MINT/BURN).
 // Maps to BalanceStateChange in GraphQL schema. Exposes tokenId, amount.
@@ -582,3 +619,9 @@ type BalanceAuthorizationStateChangeModel struct {
 type ReservesStateChangeModel struct {
 	StateChange
 }
+
+// EscrowStateChangeModel represents escrow contract changes.
+// Maps to EscrowStateChange in GraphQL schema. Exposes deployerAccountId, keyValue.
+type EscrowStateChangeModel struct {
+	StateChange
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"sync/atomic"
 	"time"
 
+	"github.com/Trustless-Work/Indexer/internal/admin"
+	"github.com/Trustless-Work/Indexer/internal/alert"
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/leader"
 	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
+	"github.com/Trustless-Work/Indexer/internal/utils"
 	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
 	"github.com/stellar/go-stellar-sdk/support/log"
+	"golang.org/x/sync/errgroup"
 )
 
 // LedgerBackendType represents the type of ledger backend to use
@@ -32,6 +42,10 @@ type Config struct {
 	NetworkPassphrase      string
 	GetLedgersLimit        int
 	LedgerBackendType      LedgerBackendType
+	// Networks lists the networks ingested side by side, each with its own ledger backend,
+	// indexer and cursors. When empty, the network fields above (Network, NetworkPassphrase,
+	// RPCURL, StartLedger, ...) describe the only network ingested.
+	Networks []NetworkConfig
 	// SkipTxMeta skips storing transaction metadata (meta_xdr) to reduce storage space
 	SkipTxMeta bool
 	// SkipTxEnvelope skips storing transaction envelope (envelope_xdr) to reduce storage space
@@ -51,63 +65,420 @@ type Config struct {
 	// CatchupThreshold is the number of ledgers behind network tip that triggers fast catchup.
 	// Defaults to 100.
 	CatchupThreshold int
+	// SinkType selects the registered sink implementation ("kafka", "noop", ...).
+	// Defaults to "noop". The sink package must be imported in the binary to be available.
+	// With several networks, the sink is shared by the networks that don't configure their own,
+	// and must implement sink.CursorScoper.
+	SinkType string
+	// SinkOptions is the configuration specific to the selected sink.
+	// Each implementation decodes the fields it needs.
+	SinkOptions map[string]any
+	// Sink, when set, is used instead of opening SinkType. It lets the caller share the sink
+	// destination, e.g. an in-memory store also read by the APIs.
+	Sink sink.Sink
+	// MetricsAddress, when set, is the address the Prometheus metrics (/metrics) and the
+	// health, readiness and status of the ingestion (/healthz, /readyz and /status) are
+	// served on.
+	MetricsAddress string
+	// Pprof serves the Go profiles (/debug/pprof/) along with the metrics. It is opt-in: the
+	// profiles expose the internals of the process and cost CPU while they are collected.
+	Pprof bool
+	// Tracing selects where the OpenTelemetry spans of the ingestion are exported. Tracing is
+	// disabled when it has no exporter.
+	Tracing tracing.Config
+	// LeaderElection, when its DatabaseURL is set, makes the replica ingest only while it holds
+	// the leader lock. Until then it stays a warm standby.
+	LeaderElection LeaderElectionConfig
+	// Admin, when its Address is set, serves the admin API controlling the ingestion of every
+	// network.
+	Admin admin.Config
+	// Alerts are the alerting rules evaluated on the ingested ledgers. Alerting is disabled
+	// without rules.
+	Alerts alert.Config
+	// LedgerStats, when set, persists the stats of every ingested ledger, e.g. to the
+	// ingest_ledger_stats table of the Postgres store.
+	LedgerStats services.LedgerStatsWriter
 }
 
-func Ingest(cfg Config) error {
-	ctx := context.Background()
+// NetworkConfig configures the ingestion of one network.
+type NetworkConfig struct {
+	// Network names the network in the entities, events, metrics, logs and status, e.g.
+	// "testnet". Defaults to the name of NetworkPassphrase (see event.NetworkName).
+	Network           string
+	NetworkPassphrase string
+	RPCURL            string
+	LedgerBackendType LedgerBackendType
+	GetLedgersLimit   int
+	StartLedger       uint32
+	// LatestLedgerCursorName is the name the shared sink commits the cursor of the network
+	// under. With several networks it defaults to "latest_ledger_<network>".
+	LatestLedgerCursorName string
+	// OldestLedgerCursorName defaults to "oldest_ledger_<network>" with several networks.
+	OldestLedgerCursorName string
+	// SinkType and SinkOptions, when SinkType is set, open a sink used by this network only
+	// instead of the shared sink. Sinks that key their data by ledger or ID alone, like
+	// postgres, can't be shared by several networks and must be configured here.
+	SinkType    string
+	SinkOptions map[string]any
+}
+
+// LeaderElectionConfig configures the election of the replica that ingests.
+type LeaderElectionConfig struct {
+	// DatabaseURL is the Postgres database holding the advisory lock. Leader election is
+	// disabled when empty.
+	DatabaseURL string
+	// LockName is the lock the replicas compete for. Defaults to leader.DefaultLockName.
+	LockName string
+}
+
+// Ingest ingests the networks of cfg until ctx is cancelled.
+func Ingest(ctx context.Context, cfg Config) error {
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("setting up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(ctx); err != nil {
+			log.Ctx(ctx).Errorf("Shutting down tracing: %v", err)
+		}
+	}()
 
-	ingestService, err := setupDeps(cfg)
+	networks, err := cfg.networks()
+	if err != nil {
+		return fmt.Errorf("configuring networks: %w", err)
+	}
+
+	var observer services.LedgerObserver
+	var alerts *alert.Engine
+	if len(cfg.Alerts.Rules) > 0 {
+		names := make([]string, 0, len(networks))
+		for _, n := range networks {
+			names = append(names, n.Network)
+		}
+		alerts, err = alert.NewEngine(cfg.Alerts, names)
+		if err != nil {
+			return fmt.Errorf("setting up alerting: %w", err)
+		}
+		defer alerts.Close()
+		go alerts.Run(ctx)
+		observer = alerts
+	}
+
+	ingestions, sinks, err := setupDeps(cfg, networks, observer)
 	if err != nil {
 		log.Ctx(ctx).Fatalf("Error setting up dependencies for ingest: %v", err)
 	}
+	// Sinks may be shared by several networks, so they are closed once every network stopped.
+	for _, s := range sinks {
+		defer utils.DeferredClose(ctx, s, "closing sink")
+	}
+
+	var elector leader.Elector
+	if cfg.LeaderElection.DatabaseURL != "" {
+		elector, err = leader.NewPostgresElector(ctx, cfg.LeaderElection.DatabaseURL, cfg.LeaderElection.LockName)
+		if err != nil {
+			return fmt.Errorf("setting up leader election: %w", err)
+		}
+		defer utils.DeferredClose(ctx, elector, "closing leader elector")
+	}
+	replica := &replicaRole{}
+	if elector != nil {
+		replica.set(leader.RoleStandby)
+	}
+
+	if cfg.Admin.Address != "" {
+		networkServices := make(map[string]services.IngestService, len(ingestions))
+		for _, ingestion := range ingestions {
+			networkServices[ingestion.network] = ingestion.service
+		}
+		adminServer, err := admin.NewServer(cfg.Admin, networkServices)
+		if err != nil {
+			return fmt.Errorf("setting up admin API: %w", err)
+		}
+		defer adminServer.Close()
+		go func() {
+			if err := adminServer.Serve(ctx, cfg.Admin.Address); err != nil {
+				log.Ctx(ctx).Errorf("Admin API stopped: %v", err)
+			}
+		}()
+	}
+
+	if cfg.MetricsAddress != "" {
+		go func() {
+			if err := serveOps(ctx, cfg.MetricsAddress, cfg.Pprof, ingestions, replica); err != nil {
+				log.Ctx(ctx).Errorf("Metrics and status server stopped: %v", err)
+			}
+		}()
+	}
+
+	runCtx := ctx
+	if elector != nil {
+		// The dependencies are set up and the backends prepared from the cursor committed by
+		// the sink, so the standby only waits for the lock. Once leader, it resumes from the
+		// cursor, on a new backend if the cursor moved in the meantime.
+		prepareNetworks(ctx, ingestions)
+		log.Ctx(ctx).Info("Standing by until this replica is elected leader")
+		runCtx, err = elector.Campaign(ctx)
+		if err != nil {
+			return fmt.Errorf("campaigning for leadership: %w", err)
+		}
+		replica.set(leader.RoleLeader)
+	}
 
-	if err = ingestService.Run(ctx, cfg.StartLedger, 0); err != nil {
-		log.Ctx(ctx).Fatalf("running 'ingest' from %d to %d: %v", cfg.StartLedger, cfg.EndLedger, err)
+	// Losing the leadership, or the failure of any network, stops the ingestion of every
+	// network and, with it, the process. The orchestrator restarts it. Cancelling ctx only
+	// stops the ingestion.
+	if err = runNetworks(runCtx, ingestions); err != nil && ctx.Err() == nil {
+		if alerts != nil {
+			// Fatalf skips the deferred calls, deliver the last alerts first.
+			alerts.Close()
+		}
+		log.Ctx(ctx).Fatalf("running 'ingest': %v", err)
 	}
 
 	return nil
 }
 
-func setupDeps(cfg Config) (services.IngestService, error) {
+// networkIngestion is the ingestion of one network.
+type networkIngestion struct {
+	network     string
+	startLedger uint32
+	service     services.IngestService
+}
+
+// runNetworks ingests every network concurrently until ctx is cancelled or one of them fails,
+// which stops the others.
+func runNetworks(ctx context.Context, ingestions []networkIngestion) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	for _, ingestion := range ingestions {
+		group.Go(func() error {
+			if err := ingestion.service.Run(groupCtx, ingestion.startLedger, 0); err != nil {
+				return fmt.Errorf("ingesting %s from ledger %d: %w", ingestion.network, ingestion.startLedger, err)
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+// prepareNetworks prepares the ledger backend of every network for its run. A network that
+// can't be prepared yet is prepared again by its run.
+func prepareNetworks(ctx context.Context, ingestions []networkIngestion) {
+	for _, ingestion := range ingestions {
+		if err := ingestion.service.Prepare(ctx, ingestion.startLedger); err != nil {
+			log.Ctx(ctx).Warnf("Preparing the ledger backend of %s: %v", ingestion.network, err)
+		}
+	}
+}
 
-	rpcService, err := services.NewRPCService(cfg.RPCURL, cfg.NetworkPassphrase, httpClient)
-	if err != nil {
-		return nil, fmt.Errorf("instantiating rpc service: %w", err)
+// networks returns the networks to ingest with their defaults applied: the network fields of
+// the config when it lists no network.
+func (c Config) networks() ([]NetworkConfig, error) {
+	networks := slices.Clone(c.Networks)
+	if len(networks) == 0 {
+		networks = []NetworkConfig{{
+			Network:                c.Network,
+			NetworkPassphrase:      c.NetworkPassphrase,
+			RPCURL:                 c.RPCURL,
+			LedgerBackendType:      c.LedgerBackendType,
+			GetLedgersLimit:        c.GetLedgersLimit,
+			StartLedger:            c.StartLedger,
+			LatestLedgerCursorName: c.LatestLedgerCursorName,
+			OldestLedgerCursorName: c.OldestLedgerCursorName,
+		}}
 	}
 
-	ledgerBackend, err := NewLedgerBackend(context.Background(), cfg)
-	if err != nil {
-		return nil, fmt.Errorf("creating ledger backend: %w", err)
-	}
-
-	// Create a factory function for parallel backfill (each batch needs its own backend)
-	ledgerBackendFactory := func(ctx context.Context) (ledgerbackend.LedgerBackend, error) {
-		return NewLedgerBackend(ctx, cfg)
-	}
-
-	ingestService, err := services.NewIngestService((services.IngestServiceConfig{
-		IngestionMode:              cfg.IngestionMode,
-		LatestLedgerCursorName:     cfg.LatestLedgerCursorName,
-		OldestLedgerCursorName:     cfg.OldestLedgerCursorName,
-		RPCService:                 rpcService,
-		LedgerBackend:              ledgerBackend,
-		LedgerBackendFactory:       ledgerBackendFactory,
-		GetLedgersLimit:            cfg.GetLedgersLimit,
-		Network:                    cfg.Network,
-		NetworkPassphrase:          cfg.NetworkPassphrase,
-		SkipTxMeta:                 cfg.SkipTxMeta,
-		SkipTxEnvelope:             cfg.SkipTxEnvelope,
-		EnableParticipantFiltering: cfg.EnableParticipantFiltering,
-		BackfillWorkers:            cfg.BackfillWorkers,
-		BackfillBatchSize:          cfg.BackfillBatchSize,
-		BackfillDBInsertBatchSize:  cfg.BackfillDBInsertBatchSize,
-		CatchupThreshold:           cfg.CatchupThreshold,
-	}))
+	seen := make(map[string]bool, len(networks))
+	for i := range networks {
+		n := &networks[i]
+		if n.NetworkPassphrase == "" {
+			return nil, fmt.Errorf("network %d has no passphrase", i)
+		}
+		if n.Network == "" {
+			n.Network = event.NetworkName(n.NetworkPassphrase)
+		}
+		if seen[n.Network] {
+			return nil, fmt.Errorf("network %q is configured twice, set a distinct Network name for each", n.Network)
+		}
+		seen[n.Network] = true
+
+		if len(networks) > 1 {
+			if n.LatestLedgerCursorName == "" {
+				n.LatestLedgerCursorName = "latest_ledger_" + n.Network
+			}
+			if n.OldestLedgerCursorName == "" {
+				n.OldestLedgerCursorName = "oldest_ledger_" + n.Network
+			}
+		}
+	}
+
+	// The Postgres store keys its rows by ledger and decodes transactions with the passphrase
+	// of one network, so a database can't hold several networks.
+	databases := make(map[string]string, len(networks))
+	for _, n := range networks {
+		urls, err := postgresDatabases(n.SinkType, n.SinkOptions)
+		if err != nil {
+			return nil, fmt.Errorf("sink options of %s: %w", n.Network, err)
+		}
+		for _, url := range urls {
+			if other, ok := databases[url]; ok && other != n.Network {
+				return nil, fmt.Errorf("networks %s and %s write to the same Postgres database, give each network its own", other, n.Network)
+			}
+			databases[url] = n.Network
+		}
+	}
+	return networks, nil
+}
+
+// postgresDatabases returns the database URLs of the postgres sinks in the options of a sink,
+// including the destinations of a multi sink.
+func postgresDatabases(sinkType string, options map[string]any) ([]string, error) {
+	switch sinkType {
+	case "postgres":
+		var cfg struct {
+			DatabaseURL string `json:"database_url"`
+		}
+		if err := sink.DecodeConfig(options, &cfg); err != nil {
+			return nil, err
+		}
+		return []string{cfg.DatabaseURL}, nil
+	case "multi":
+		var cfg struct {
+			Sinks []struct {
+				Type    string         `json:"type"`
+				Options map[string]any `json:"options"`
+			} `json:"sinks"`
+		}
+		if err := sink.DecodeConfig(options, &cfg); err != nil {
+			return nil, err
+		}
+		var urls []string
+		for _, s := range cfg.Sinks {
+			nested, err := postgresDatabases(s.Type, s.Options)
+			if err != nil {
+				return nil, err
+			}
+			urls = append(urls, nested...)
+		}
+		return urls, nil
+	}
+	return nil, nil
+}
+
+// replicaRole is the role of the replica in the leader election, empty without election.
+type replicaRole struct {
+	role atomic.Value
+}
+
+func (r *replicaRole) set(role leader.Role) {
+	r.role.Store(role)
+}
+
+func (r *replicaRole) get() leader.Role {
+	role, _ := r.role.Load().(leader.Role)
+	return role
+}
+
+// setupDeps creates the ingestion of every network, handing the ledgers to observer when set.
+// It returns the sinks to close once the ingestion stops.
+func setupDeps(cfg Config, networks []NetworkConfig, observer services.LedgerObserver) (ingestions []networkIngestion, sinks []sink.Sink, err error) {
+	defer func() {
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+		}
+	}()
+
+	shared, sharedType, err := openSharedSink(cfg, networks)
 	if err != nil {
-		return nil, fmt.Errorf("instantiating ingest service: %w", err)
+		return nil, nil, err
+	}
+	if shared != nil {
+		sinks = append(sinks, shared)
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	for _, n := range networks {
+		rpcService, err := services.NewRPCService(n.RPCURL, n.NetworkPassphrase, httpClient)
+		if err != nil {
+			return nil, sinks, fmt.Errorf("instantiating rpc service of %s: %w", n.Network, err)
+		}
+
+		ledgerBackend, err := NewLedgerBackend(context.Background(), n)
+		if err != nil {
+			return nil, sinks, fmt.Errorf("creating ledger backend of %s: %w", n.Network, err)
+		}
+
+		s, sinkType := shared, sharedType
+		switch {
+		case n.SinkType != "":
+			sinkType = n.SinkType
+			s, err = sink.Open(sinkType, n.SinkOptions)
+			if err != nil {
+				return nil, sinks, fmt.Errorf("creating sink %q of %s: %w", sinkType, n.Network, err)
+			}
+			sinks = append(sinks, s)
+		case len(networks) > 1:
+			scoper, ok := shared.(sink.CursorScoper)
+			if !ok {
+				return nil, sinks, fmt.Errorf("the shared sink can't keep a cursor per network, configure a sink for %s", n.Network)
+			}
+			s = scoper.WithCursorName(n.LatestLedgerCursorName)
+		}
+
+		// Create a factory function for parallel backfill (each batch needs its own backend)
+		ledgerBackendFactory := func(ctx context.Context) (ledgerbackend.LedgerBackend, error) {
+			return NewLedgerBackend(ctx, n)
+		}
+
+		ingestService, err := services.NewIngestService((services.IngestServiceConfig{
+			IngestionMode:              cfg.IngestionMode,
+			LatestLedgerCursorName:     n.LatestLedgerCursorName,
+			OldestLedgerCursorName:     n.OldestLedgerCursorName,
+			RPCService:                 rpcService,
+			LedgerBackend:              ledgerBackend,
+			LedgerBackendFactory:       ledgerBackendFactory,
+			GetLedgersLimit:            n.GetLedgersLimit,
+			Network:                    n.Network,
+			NetworkPassphrase:          n.NetworkPassphrase,
+			SkipTxMeta:                 cfg.SkipTxMeta,
+			SkipTxEnvelope:             cfg.SkipTxEnvelope,
+			EnableParticipantFiltering: cfg.EnableParticipantFiltering,
+			BackfillWorkers:            cfg.BackfillWorkers,
+			BackfillBatchSize:          cfg.BackfillBatchSize,
+			BackfillDBInsertBatchSize:  cfg.BackfillDBInsertBatchSize,
+			CatchupThreshold:           cfg.CatchupThreshold,
+			Sink:                       s,
+			SinkType:                   sinkType,
+			LedgerObserver:             observer,
+			LedgerStats:                cfg.LedgerStats,
+		}))
+		if err != nil {
+			return nil, sinks, fmt.Errorf("instantiating ingest service of %s: %w", n.Network, err)
+		}
+		ingestions = append(ingestions, networkIngestion{network: n.Network, startLedger: n.StartLedger, service: ingestService})
 	}
 
-	return ingestService, nil
+	return ingestions, sinks, nil
+}
+
+// openSharedSink opens the sink of the networks that don't configure their own, if any.
+func openSharedSink(cfg Config, networks []NetworkConfig) (sink.Sink, string, error) {
+	if !slices.ContainsFunc(networks, func(n NetworkConfig) bool { return n.SinkType == "" }) {
+		return nil, "", nil
+	}
+
+	sinkType := cfg.SinkType
+	if cfg.Sink != nil {
+		return cfg.Sink, sinkType, nil
+	}
+	if sinkType == "" {
+		sinkType = "noop"
+	}
+	s, err := sink.Open(sinkType, cfg.SinkOptions)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating sink %q: %w", sinkType, err)
+	}
+	return s, sinkType, nil
 }
@@ -0,0 +1,70 @@
+package ingest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworksRejectSharedDatabase(t *testing.T) {
+	postgres := func(url string) map[string]any {
+		return map[string]any{"database_url": url}
+	}
+	multi := func(url string) map[string]any {
+		return map[string]any{"sinks": []map[string]any{
+			{"type": "kafka", "options": map[string]any{"topic_prefix": "indexer"}},
+			{"type": "postgres", "options": postgres(url)},
+		}}
+	}
+
+	tests := []struct {
+		name    string
+		second  NetworkConfig
+		wantErr string
+	}{
+		{
+			name:   "distinct databases",
+			second: NetworkConfig{SinkType: "postgres", SinkOptions: postgres("postgres://db/pubnet")},
+		},
+		{
+			name:    "same database",
+			second:  NetworkConfig{SinkType: "postgres", SinkOptions: postgres("postgres://db/testnet")},
+			wantErr: "networks testnet and pubnet write to the same Postgres database",
+		},
+		{
+			name:    "same database in a multi sink",
+			second:  NetworkConfig{SinkType: "multi", SinkOptions: multi("postgres://db/testnet")},
+			wantErr: "networks testnet and pubnet write to the same Postgres database",
+		},
+		{
+			name:   "distinct database in a multi sink",
+			second: NetworkConfig{SinkType: "multi", SinkOptions: multi("postgres://db/pubnet")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			second := tt.second
+			second.Network = "pubnet"
+			second.NetworkPassphrase = "Public Global Stellar Network ; September 2015"
+			cfg := Config{Networks: []NetworkConfig{
+				{
+					Network:           "testnet",
+					NetworkPassphrase: "Test SDF Network ; September 2015",
+					SinkType:          "postgres",
+					SinkOptions:       postgres("postgres://db/testnet"),
+				},
+				second,
+			}}
+
+			_, err := cfg.networks()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("networks() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("networks() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/stellar/go-stellar-sdk/support/log"
 )
 
-func NewLedgerBackend(ctx context.Context, cfg Config) (ledgerbackend.LedgerBackend, error) {
+func NewLedgerBackend(ctx context.Context, cfg NetworkConfig) (ledgerbackend.LedgerBackend, error) {
 	switch cfg.LedgerBackendType {
 	case LedgerBackendTypeDatastore:
 		//return newDatastoreLedgerBackend(ctx, cfg)
@@ -21,11 +21,11 @@ func NewLedgerBackend(ctx context.Context, cfg Config) (ledgerbackend.LedgerBack
 	}
 }
 
-func newRPCLedgerBackend(cfg Config) (ledgerbackend.LedgerBackend, error) {
+func newRPCLedgerBackend(cfg NetworkConfig) (ledgerbackend.LedgerBackend, error) {
 	backend := ledgerbackend.NewRPCLedgerBackend(ledgerbackend.RPCLedgerBackendOptions{
 		RPCServerURL: cfg.RPCURL,
 		BufferSize:   uint32(cfg.GetLedgersLimit),
 	})
-	log.Infof("Using RPCLedgerBackend for ledger ingestion with buffer size %d", cfg.GetLedgersLimit)
+	log.Infof("Using RPCLedgerBackend for ledger ingestion of %s with buffer size %d", cfg.Network, cfg.GetLedgersLimit)
 	return backend, nil
 }
@@ -0,0 +1,113 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/leader"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// probeResponse is the body of the health and readiness probes.
+type probeResponse struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// replicaStatus is the status of the ingestion of every network along with the role of the
+// replica.
+type replicaStatus struct {
+	Role     leader.Role             `json:"role,omitempty"`
+	Networks []services.IngestStatus `json:"networks"`
+}
+
+// serveOps serves the metrics and the health, readiness and status of the ingestion on
+// address until ctx is cancelled. The replica is healthy and ready only when every network
+// is. A standby is healthy and ready: it only waits for the leadership, and reporting it
+// unready would stall rolling updates. With withPprof, the Go profiles are served under
+// /debug/pprof/.
+func serveOps(ctx context.Context, address string, withPprof bool, ingestions []networkIngestion, replica *replicaRole) error {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		if replica.get() == leader.RoleStandby {
+			writeJSON(w, http.StatusOK, probeResponse{Status: string(leader.RoleStandby)})
+			return
+		}
+		now := time.Now()
+		writeProbe(w, firstNetworkError(r.Context(), ingestions, func(status services.IngestStatus) error {
+			return status.LivenessError(now)
+		}))
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if replica.get() == leader.RoleStandby {
+			writeJSON(w, http.StatusOK, probeResponse{Status: string(leader.RoleStandby)})
+			return
+		}
+		writeProbe(w, firstNetworkError(r.Context(), ingestions, services.IngestStatus.ReadinessError))
+	})
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		status := replicaStatus{Role: replica.get(), Networks: make([]services.IngestStatus, 0, len(ingestions))}
+		for _, ingestion := range ingestions {
+			status.Networks = append(status.Networks, ingestion.service.Status(r.Context()))
+		}
+		writeJSON(w, http.StatusOK, status)
+	})
+	endpoints := "/metrics, /healthz, /readyz, /status"
+	if withPprof {
+		mux.HandleFunc("GET /debug/pprof/", pprof.Index)
+		mux.HandleFunc("GET /debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("GET /debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("GET /debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("POST /debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
+		endpoints += ", /debug/pprof/"
+	}
+	server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Ctx(ctx).Infof("Serving metrics and status on %s (%s)", address, endpoints)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serving metrics and status: %w", err)
+	}
+	return nil
+}
+
+// firstNetworkError returns the first error check finds in the status of the networks, prefixed
+// with the network.
+func firstNetworkError(ctx context.Context, ingestions []networkIngestion, check func(services.IngestStatus) error) error {
+	for _, ingestion := range ingestions {
+		if err := check(ingestion.service.Status(ctx)); err != nil {
+			return fmt.Errorf("%s: %w", ingestion.network, err)
+		}
+	}
+	return nil
+}
+
+// writeProbe answers a probe with 200 when err is nil, and 503 with the reason otherwise.
+func writeProbe(w http.ResponseWriter, err error) {
+	if err != nil {
+		writeJSON(w, http.StatusServiceUnavailable, probeResponse{Status: "unavailable", Reason: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, probeResponse{Status: "ok"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
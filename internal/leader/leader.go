@@ -0,0 +1,26 @@
+// Package leader elects the replica that ingests when several indexers run side by side.
+//
+// Only the leader runs the ingestion loop, so two replicas never write the same ledgers.
+// Standbys keep their dependencies open (RPC client, ledger backend, sink connections) and
+// campaign in a loop, so one of them takes over from the persisted sink cursor within
+// seconds of the leader dying.
+package leader
+
+import "context"
+
+// Elector elects a single leader among the replicas sharing its lock.
+type Elector interface {
+	// Campaign blocks until this replica becomes the leader or ctx is done. The returned
+	// context is cancelled when the leadership is lost; context.Cause tells why.
+	Campaign(ctx context.Context) (context.Context, error)
+	// Close gives up the leadership, if held, and releases the resources of the elector.
+	Close() error
+}
+
+// Role is the role of a replica in the election.
+type Role string
+
+const (
+	RoleStandby Role = "standby"
+	RoleLeader  Role = "leader"
+)
@@ -0,0 +1,157 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const (
+	// DefaultLockName is the name of the advisory lock of the ingesting replica.
+	DefaultLockName = "trustless-work-indexer"
+	// defaultRetryInterval is how often standbys try to take the lock.
+	defaultRetryInterval = 2 * time.Second
+	// defaultCheckInterval is how often the leader checks it still holds the lock.
+	defaultCheckInterval = 2 * time.Second
+)
+
+// ErrLeadershipLost is the cause of the cancellation of the leader context when the connection
+// holding the lock fails.
+var ErrLeadershipLost = errors.New("leadership lost")
+
+// PostgresElector elects the leader with a session-level Postgres advisory lock. The lock is
+// held by a dedicated connection: when the leader dies, Postgres closes its session and
+// releases the lock, and the next standby to retry takes it.
+type PostgresElector struct {
+	store         *pgstore.Store
+	lockName      string
+	retryInterval time.Duration
+	checkInterval time.Duration
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+var _ Elector = (*PostgresElector)(nil)
+
+// NewPostgresElector connects to the database of databaseURL. Replicas sharing lockName
+// compete for the same leadership; an empty lockName uses DefaultLockName.
+func NewPostgresElector(ctx context.Context, databaseURL, lockName string) (*PostgresElector, error) {
+	if lockName == "" {
+		lockName = DefaultLockName
+	}
+	store, err := pgstore.Open(ctx, databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("opening leader election database: %w", err)
+	}
+	return &PostgresElector{
+		store:         store,
+		lockName:      lockName,
+		retryInterval: defaultRetryInterval,
+		checkInterval: defaultCheckInterval,
+	}, nil
+}
+
+func (e *PostgresElector) Campaign(ctx context.Context) (context.Context, error) {
+	ticker := time.NewTicker(e.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		acquired, err := e.tryLock(ctx)
+		if err != nil {
+			log.Ctx(ctx).Warnf("Taking the leader lock %q: %v", e.lockName, err)
+		}
+		if acquired {
+			log.Ctx(ctx).Infof("Took the leader lock %q, this replica is the leader", e.lockName)
+			leaderCtx, cancel := context.WithCancelCause(ctx)
+			go e.hold(leaderCtx, cancel)
+			return leaderCtx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryLock takes the advisory lock on a dedicated connection, kept open while the lock is held.
+func (e *PostgresElector) tryLock(ctx context.Context) (bool, error) {
+	conn, err := e.store.DB().Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting connection: %w", err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", e.lockName).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("querying advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+
+	e.mu.Lock()
+	e.conn = conn
+	e.mu.Unlock()
+	return true, nil
+}
+
+// hold checks the connection holding the lock every checkInterval, cancelling the leader
+// context when it fails. A failed connection means the session, and with it the lock, may be
+// gone, so another replica may already lead.
+func (e *PostgresElector) hold(ctx context.Context, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		e.mu.Lock()
+		conn := e.conn
+		e.mu.Unlock()
+		if conn == nil {
+			cancel(ErrLeadershipLost)
+			return
+		}
+		checkCtx, checkCancel := context.WithTimeout(ctx, e.checkInterval)
+		_, err := conn.ExecContext(checkCtx, "SELECT 1")
+		checkCancel()
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Errorf("Lost the connection holding the leader lock %q: %v", e.lockName, err)
+			cancel(fmt.Errorf("%w: %w", ErrLeadershipLost, err))
+			return
+		}
+	}
+}
+
+func (e *PostgresElector) Close() error {
+	e.mu.Lock()
+	conn := e.conn
+	e.conn = nil
+	e.mu.Unlock()
+
+	var errs []error
+	if conn != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), e.checkInterval)
+		defer cancel()
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", e.lockName); err != nil {
+			errs = append(errs, fmt.Errorf("releasing the leader lock: %w", err))
+		}
+		errs = append(errs, conn.Close())
+	}
+	errs = append(errs, e.store.Close())
+	return errors.Join(errs...)
+}
@@ -0,0 +1,170 @@
+// Package logging configures the logger of the indexer and defines the fields of its
+// structured logs.
+//
+// Logs go through the stellar support/log package. The fields of the network, ledger,
+// transaction and operation being processed are bound to the context with WithFields, so every
+// line logged with log.Ctx(ctx) down the pipeline carries them. Hot paths, which log once per
+// operation, guard their lines with Sampled to keep the volume bounded on busy ledgers.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// Fields of the structured logs.
+const (
+	FieldEvent          = "event"
+	FieldNetwork        = "network"
+	FieldLedger         = "ledger"
+	FieldTxHash         = "tx_hash"
+	FieldOpID           = "op_id"
+	FieldProcessor      = "processor"
+	FieldEscrowContract = "escrow_contract"
+)
+
+// Formats of the logs.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+const (
+	// sampleTick is the period the sampling counters are reset on.
+	sampleTick = time.Second
+	// sampleFirst is how many lines of a sampled message are logged every tick before sampling.
+	sampleFirst = 10
+	// sampleThereafter is the rate of the lines logged past sampleFirst: one in sampleThereafter.
+	sampleThereafter = 100
+)
+
+// Config configures the logger.
+type Config struct {
+	// Level is the minimum level logged: trace, debug, info, warn or error.
+	Level string
+	// Format is text or json.
+	Format string
+	// Sampling bounds the lines logged by hot paths (see Sampled). When disabled, every line
+	// is logged.
+	Sampling bool
+}
+
+var (
+	// sampler is the sampler of Sampled, nil when sampling is disabled.
+	sampler atomic.Pointer[Sampler]
+	// level is the level of the default logger, which the logger doesn't expose.
+	level atomic.Uint32
+)
+
+func init() {
+	sampler.Store(NewSampler(sampleTick, sampleFirst, sampleThereafter))
+	level.Store(uint32(logrus.InfoLevel))
+}
+
+// Configure sets the level and format of the default logger and the sampling of hot paths.
+func Configure(cfg Config) error {
+	parsed, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return fmt.Errorf("parsing log level: %w", err)
+	}
+
+	switch strings.ToLower(cfg.Format) {
+	case FormatText, "":
+	case FormatJSON:
+		log.DefaultLogger.UseJSONFormatter()
+	default:
+		return fmt.Errorf("unknown log format %q, expected %q or %q", cfg.Format, FormatText, FormatJSON)
+	}
+	setLevel(parsed)
+
+	if cfg.Sampling {
+		sampler.Store(NewSampler(sampleTick, sampleFirst, sampleThereafter))
+	} else {
+		sampler.Store(nil)
+	}
+	return nil
+}
+
+// SetLevel changes the level of the default logger at runtime.
+func SetLevel(level string) error {
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		return fmt.Errorf("parsing log level: %w", err)
+	}
+	setLevel(parsed)
+	return nil
+}
+
+// Level returns the level of the default logger.
+func Level() string {
+	return logrus.Level(level.Load()).String()
+}
+
+func setLevel(parsed logrus.Level) {
+	log.DefaultLogger.SetLevel(parsed)
+	level.Store(uint32(parsed))
+}
+
+// WithFields binds fields to the logger of ctx.
+func WithFields(ctx context.Context, fields log.F) context.Context {
+	return log.PushContext(ctx, func(entry *log.Entry) *log.Entry {
+		return entry.WithFields(fields)
+	})
+}
+
+// Sampled reports whether a line of a hot path, identified by key, should be logged.
+func Sampled(key string) bool {
+	return sampler.Load().Allow(key)
+}
+
+// Sampler lets through the first lines of every key in each tick, then one in thereafter.
+type Sampler struct {
+	tick       time.Duration
+	first      uint64
+	thereafter uint64
+	counters   sync.Map // key -> *sampleCounter
+}
+
+type sampleCounter struct {
+	resetAt atomic.Int64
+	count   atomic.Uint64
+}
+
+// NewSampler creates a Sampler. A thereafter of 0 drops every line past the first ones.
+func NewSampler(tick time.Duration, first, thereafter uint64) *Sampler {
+	return &Sampler{tick: tick, first: first, thereafter: thereafter}
+}
+
+// Allow reports whether the next line of key should be logged. A nil Sampler allows every line.
+func (s *Sampler) Allow(key string) bool {
+	if s == nil {
+		return true
+	}
+
+	value, ok := s.counters.Load(key)
+	if !ok {
+		value, _ = s.counters.LoadOrStore(key, &sampleCounter{})
+	}
+	counter := value.(*sampleCounter)
+
+	now := time.Now().UnixNano()
+	var n uint64
+	if resetAt := counter.resetAt.Load(); now > resetAt && counter.resetAt.CompareAndSwap(resetAt, now+int64(s.tick)) {
+		counter.count.Store(1)
+		n = 1
+	} else {
+		n = counter.count.Add(1)
+	}
+
+	if n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
@@ -0,0 +1,262 @@
+// Package metrics defines the Prometheus metrics of the indexer and their /metrics handler.
+//
+// The collectors are package level so the ingestion pipeline, the processors and the sinks
+// record to them without threading a registry through their constructors. They are registered
+// on Registry, which also exposes the Go runtime and process metrics.
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/alitto/pond/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const namespace = "indexer"
+
+// Phases of the ingestion of a ledger, the values of the phase label of LedgerPhaseDuration.
+const (
+	PhaseFetch     = "fetch"
+	PhaseProcess   = "process"
+	PhaseSinkWrite = "sink_write"
+)
+
+// Registry holds every metric of the indexer.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// LatestIngestedLedger is the last ledger written to the sink.
+	LatestIngestedLedger = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "latest_ledger",
+		Help:      "Last ledger written to the sink, by network.",
+	}, []string{"network"})
+	// RPCLatestLedger is the tip of the network, as last reported by the RPC server.
+	RPCLatestLedger = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "rpc_latest_ledger",
+		Help:      "Latest ledger reported by the RPC server, by network.",
+	}, []string{"network"})
+	// LedgerLag is the number of ledgers between the RPC tip and the last ingested ledger.
+	LedgerLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "ledger_lag",
+		Help:      "Ledgers between the tip of the RPC server and the last ingested ledger, by network.",
+	}, []string{"network"})
+	// Backfilling is 1 while a backfill interrupts the live ingestion of a network.
+	Backfilling = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "backfilling",
+		Help:      "1 while a backfill interrupts the live ingestion, by network.",
+	}, []string{"network"})
+	LedgersIngested = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "ledgers_total",
+		Help:      "Ledgers ingested, by network.",
+	}, []string{"network"})
+	LedgerPhaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "phase_duration_seconds",
+		Help:      "Duration of the phases of the ingestion of a ledger: fetch, process and sink_write, by network.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"network", "phase"})
+	// BufferSize is the number of entities of each kind in the buffer of the last ledger.
+	BufferSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "buffer_size",
+		Help:      "Entities in the buffer of the last ingested ledger, by network and entity.",
+	}, []string{"network", "entity"})
+
+	ProcessorStateChanges = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "state_changes_total",
+		Help:      "State changes produced, by network and processor.",
+	}, []string{"network", "processor"})
+	ProcessorErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "errors_total",
+		Help:      "Errors returned by processors, by network and processor.",
+	}, []string{"network", "processor"})
+	EscrowsDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "escrows_detected_total",
+		Help:      "Escrow deployments detected, by network and escrow type.",
+	}, []string{"network", "escrow_type"})
+	EscrowUpdates = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "escrow_updates_total",
+		Help:      "Escrow changes by lifecycle invocations, by network and escrow function.",
+	}, []string{"network", "function"})
+
+	SinkWriteRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sink",
+		Name:      "write_retries_total",
+		Help:      "Retried ledger writes, by sink of the multi sink.",
+	}, []string{"sink"})
+	SinkWriteErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sink",
+		Name:      "write_errors_total",
+		Help:      "Ledger writes that failed after their retries, by sink of the multi sink.",
+	}, []string{"sink"})
+	DeadLetterSpooled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sink",
+		Name:      "dead_letter_spooled_total",
+		Help:      "Ledgers spooled to the dead-letter store, by sink of the multi sink.",
+	}, []string{"sink"})
+	DeadLetterReplayed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sink",
+		Name:      "dead_letter_replayed_total",
+		Help:      "Ledgers replayed from the dead-letter store, by sink of the multi sink.",
+	}, []string{"sink"})
+	DeadLetterPending = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "sink",
+		Name:      "dead_letter_pending",
+		Help:      "Ledgers waiting in the dead-letter store, by sink of the multi sink.",
+	}, []string{"sink"})
+
+	AlertsRaised = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "alert",
+		Name:      "raised_total",
+		Help:      "Alerts raised, by rule and state (firing or resolved).",
+	}, []string{"rule", "state"})
+	AlertDeliveryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "alert",
+		Name:      "delivery_errors_total",
+		Help:      "Alerts that could not be delivered, by rule and action.",
+	}, []string{"rule", "action"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		LatestIngestedLedger,
+		RPCLatestLedger,
+		LedgerLag,
+		Backfilling,
+		LedgersIngested,
+		LedgerPhaseDuration,
+		BufferSize,
+		ProcessorStateChanges,
+		ProcessorErrors,
+		EscrowsDetected,
+		EscrowUpdates,
+		SinkWriteRetries,
+		SinkWriteErrors,
+		DeadLetterSpooled,
+		DeadLetterReplayed,
+		DeadLetterPending,
+		AlertsRaised,
+		AlertDeliveryErrors,
+	)
+}
+
+// RegisterPool exposes the queue depth and running workers of a worker pool, labelled by name.
+// Registering a name twice keeps the first pool.
+func RegisterPool(name string, pool pond.Pool) {
+	labels := prometheus.Labels{"pool": name}
+	poolCollectors := []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "pool",
+			Name:        "queue_depth",
+			Help:        "Tasks waiting for a worker, by pool.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(pool.WaitingTasks()) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "pool",
+			Name:        "running_workers",
+			Help:        "Workers running a task, by pool.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(pool.RunningWorkers()) }),
+	}
+	for _, collector := range poolCollectors {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if err := Registry.Register(collector); err != nil && !errors.As(err, &alreadyRegistered) {
+			log.Warnf("Registering metrics of pool %q: %v", name, err)
+		}
+	}
+}
+
+// ObservePhase records the duration of an ingestion phase of network started at start.
+func ObservePhase(network, phase string, start time.Time) {
+	LedgerPhaseDuration.WithLabelValues(network, phase).Observe(time.Since(start).Seconds())
+}
+
+// Handler serves the metrics of Registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Sum returns the sum of the series of the metric name, e.g. "indexer_ingest_ledger_lag",
+// whose labels include labels, as gathered from Registry. It returns false when no series
+// matches. Only counters and gauges are summed.
+func Sum(name string, labels map[string]string) (float64, bool, error) {
+	families, err := Registry.Gather()
+	if err != nil {
+		return 0, false, fmt.Errorf("gathering metrics: %w", err)
+	}
+	var (
+		sum   float64
+		found bool
+	)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if !hasLabels(metric.GetLabel(), labels) {
+				continue
+			}
+			switch {
+			case metric.Counter != nil:
+				sum += metric.GetCounter().GetValue()
+			case metric.Gauge != nil:
+				sum += metric.GetGauge().GetValue()
+			default:
+				continue
+			}
+			found = true
+		}
+	}
+	return sum, found, nil
+}
+
+func hasLabels(pairs []*dto.LabelPair, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range pairs {
+		if value, ok := labels[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
@@ -0,0 +1,242 @@
+// Package pubsub distributes processed ledgers to in-process subscribers (streaming APIs).
+//
+// The Broker keeps a replay window with the most recent ledgers, so a subscriber can resume
+// from a ledger it already saw instead of only receiving new ledgers:
+//
+//	Publish(ledger N) --> replay window [N-k .. N] --> Subscription(from N-2): N-2, N-1, N, N+1, ...
+//
+// Each subscription has a bounded queue. A subscriber that can't keep up is closed with
+// ErrSlowSubscriber rather than slowing down ingestion, and can resume from its last ledger.
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+)
+
+const (
+	defaultReplayLedgers = 1024
+	defaultQueueSize     = 256
+)
+
+var (
+	// ErrSlowSubscriber is returned by Next when the subscriber queue overflowed.
+	ErrSlowSubscriber = errors.New("subscriber is too slow, resume from the last received ledger")
+	// ErrClosed is returned by Next when the broker or the subscription is closed.
+	ErrClosed = errors.New("subscription closed")
+)
+
+// LedgerOutOfRangeError is returned by Subscribe when the requested ledger is no longer
+// in the replay window. Oldest is 0 when no ledger was published yet.
+type LedgerOutOfRangeError struct {
+	Requested uint32
+	Oldest    uint32
+}
+
+func (e *LedgerOutOfRangeError) Error() string {
+	if e.Oldest == 0 {
+		return fmt.Sprintf("ledger %d is not available, no ledger was published since the start", e.Requested)
+	}
+	return fmt.Sprintf("ledger %d is no longer available, the oldest replayable ledger is %d", e.Requested, e.Oldest)
+}
+
+// Ledger is a processed ledger. Buffer must not be modified by subscribers.
+type Ledger struct {
+	Sequence uint32
+	Buffer   indexer.IndexerBufferInterface
+}
+
+// Config configures a Broker.
+type Config struct {
+	// ReplayLedgers is the number of recent ledgers kept for subscribers that resume from a cursor.
+	ReplayLedgers int
+	// QueueSize is the number of ledgers queued per subscriber before it is considered too slow.
+	QueueSize int
+}
+
+// Broker fans out published ledgers to its subscriptions.
+type Broker struct {
+	mu            sync.Mutex
+	replay        []Ledger
+	replayLedgers int
+	queueSize     int
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+}
+
+// NewBroker creates a Broker. Zero config values use the defaults.
+func NewBroker(cfg Config) *Broker {
+	if cfg.ReplayLedgers <= 0 {
+		cfg.ReplayLedgers = defaultReplayLedgers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	return &Broker{
+		replayLedgers: cfg.ReplayLedgers,
+		queueSize:     cfg.QueueSize,
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish appends the ledger to the replay window and delivers it to every subscription.
+// It never blocks: subscriptions with a full queue are closed with ErrSlowSubscriber.
+func (b *Broker) Publish(ledger Ledger) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.replay = append(b.replay, ledger)
+	if len(b.replay) > b.replayLedgers {
+		b.replay = b.replay[len(b.replay)-b.replayLedgers:]
+	}
+
+	for s := range b.subscriptions {
+		select {
+		case s.queue <- ledger:
+		default:
+			s.closeWithError(ErrSlowSubscriber)
+			delete(b.subscriptions, s)
+		}
+	}
+}
+
+// Latest returns the sequence of the last published ledger, or 0 if none was published.
+func (b *Broker) Latest() uint32 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.replay) == 0 {
+		return 0
+	}
+	return b.replay[len(b.replay)-1].Sequence
+}
+
+// Subscribe creates a subscription starting at fromLedger. A fromLedger of 0 only receives
+// ledgers published after the call. Ledgers older than the replay window return a
+// *LedgerOutOfRangeError, and so does any fromLedger before the first ledger is published
+// (e.g. after a restart), as the ledgers the subscriber missed can't be told apart from the
+// ones it is waiting for.
+func (b *Broker) Subscribe(fromLedger uint32) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	s := &Subscription{
+		broker: b,
+		from:   fromLedger,
+		queue:  make(chan Ledger, b.queueSize),
+		done:   make(chan struct{}),
+	}
+
+	if fromLedger > 0 {
+		if len(b.replay) == 0 {
+			return nil, &LedgerOutOfRangeError{Requested: fromLedger}
+		}
+		oldest := b.replay[0].Sequence
+		if fromLedger < oldest {
+			return nil, &LedgerOutOfRangeError{Requested: fromLedger, Oldest: oldest}
+		}
+		for _, ledger := range b.replay {
+			if ledger.Sequence >= fromLedger {
+				s.backlog = append(s.backlog, ledger)
+			}
+		}
+	}
+
+	b.subscriptions[s] = struct{}{}
+	return s, nil
+}
+
+// Close closes every subscription. Published ledgers are ignored afterwards.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for s := range b.subscriptions {
+		s.closeWithError(ErrClosed)
+		delete(b.subscriptions, s)
+	}
+}
+
+func (b *Broker) unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscriptions[s]; ok {
+		s.closeWithError(ErrClosed)
+		delete(b.subscriptions, s)
+	}
+}
+
+// Subscription receives the ledgers of a Broker in order, starting with the replayed ones.
+type Subscription struct {
+	broker  *Broker
+	from    uint32
+	backlog []Ledger
+	queue   chan Ledger
+	done    chan struct{}
+	err     error
+	once    sync.Once
+}
+
+// Next blocks until the next ledger is available, the context is done or the subscription
+// is closed. Ledgers already queued are delivered before a close error is returned.
+func (s *Subscription) Next(ctx context.Context) (Ledger, error) {
+	for {
+		ledger, err := s.next(ctx)
+		if err != nil {
+			return Ledger{}, err
+		}
+		// Skip live ledgers older than a start ledger that was ahead of the broker.
+		if ledger.Sequence >= s.from {
+			return ledger, nil
+		}
+	}
+}
+
+func (s *Subscription) next(ctx context.Context) (Ledger, error) {
+	if len(s.backlog) > 0 {
+		ledger := s.backlog[0]
+		s.backlog = s.backlog[1:]
+		return ledger, nil
+	}
+
+	select {
+	case ledger := <-s.queue:
+		return ledger, nil
+	case <-s.done:
+		select {
+		case ledger := <-s.queue:
+			return ledger, nil
+		default:
+			return Ledger{}, s.err
+		}
+	case <-ctx.Done():
+		return Ledger{}, ctx.Err()
+	}
+}
+
+// Close unsubscribes from the broker.
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
+
+// closeWithError is called with the broker lock held.
+func (s *Subscription) closeWithError(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
@@ -0,0 +1,60 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+)
+
+func publish(b *Broker, from, to uint32) {
+	for seq := from; seq <= to; seq++ {
+		b.Publish(Ledger{Sequence: seq, Buffer: indexer.NewIndexerBuffer()})
+	}
+}
+
+func TestSubscribeOutOfRange(t *testing.T) {
+	b := NewBroker(Config{ReplayLedgers: 3})
+
+	// Nothing is published after a restart, the subscriber may have missed ledgers.
+	_, err := b.Subscribe(100)
+	var outOfRange *LedgerOutOfRangeError
+	if !errors.As(err, &outOfRange) || outOfRange.Oldest != 0 {
+		t.Fatalf("Subscribe on an empty window = %v, want a *LedgerOutOfRangeError", err)
+	}
+	if s, err := b.Subscribe(0); err != nil {
+		t.Fatalf("Subscribe(0) = %v", err)
+	} else {
+		s.Close()
+	}
+
+	publish(b, 100, 104)
+	_, err = b.Subscribe(101)
+	if !errors.As(err, &outOfRange) || outOfRange.Oldest != 102 {
+		t.Fatalf("Subscribe(101) = %v, want oldest ledger 102", err)
+	}
+}
+
+func TestSubscribeReplay(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroker(Config{ReplayLedgers: 3})
+	publish(b, 100, 104)
+
+	s, err := b.Subscribe(103)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	publish(b, 105, 105)
+
+	for want := uint32(103); want <= 105; want++ {
+		ledger, err := s.Next(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ledger.Sequence != want {
+			t.Fatalf("received ledger %d, want %d", ledger.Sequence, want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package pubsub
+
+import (
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	set "github.com/deckarep/golang-set/v2"
+)
+
+// Filter selects the entities a subscriber is interested in. An entity matches if it matches
+// any of the account, contract or engagement filters. An empty filter matches everything.
+type Filter struct {
+	accounts    set.Set[string]
+	contracts   set.Set[string]
+	engagements set.Set[string]
+}
+
+// NewFilter creates a filter on account addresses, contract IDs and escrow engagement IDs.
+func NewFilter(accounts, contracts, engagements []string) *Filter {
+	return &Filter{
+		accounts:    set.NewThreadUnsafeSet(accounts...),
+		contracts:   set.NewThreadUnsafeSet(contracts...),
+		engagements: set.NewThreadUnsafeSet(engagements...),
+	}
+}
+
+// IsEmpty reports whether the filter matches everything.
+func (f *Filter) IsEmpty() bool {
+	return f.accounts.IsEmpty() && f.contracts.IsEmpty() && f.engagements.IsEmpty()
+}
+
+// MatchAddress reports whether the address is one of the filtered accounts or contracts.
+func (f *Filter) MatchAddress(address string) bool {
+	return f.IsEmpty() || f.accounts.Contains(address) || f.contracts.Contains(address)
+}
+
+// MatchParticipants reports whether any participant is one of the filtered accounts or contracts.
+func (f *Filter) MatchParticipants(participants set.Set[string]) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if participants == nil {
+		return false
+	}
+	members := participants.ToSlice()
+	return f.accounts.ContainsAny(members...) || f.contracts.ContainsAny(members...)
+}
+
+// MatchEscrow reports whether the escrow contract, its engagement, its deployer or any of its
+// roles is filtered.
+func (f *Filter) MatchEscrow(escrow entities.Escrow) bool {
+	if f.IsEmpty() || f.contracts.Contains(escrow.ContractID) || f.engagements.Contains(escrow.EngagementID) {
+		return true
+	}
+	return f.accounts.ContainsAny(
+		escrow.Deployer,
+		escrow.Roles.ServiceProvider,
+		escrow.Roles.Receiver,
+		escrow.Roles.Approver,
+		escrow.Roles.ReleaseSigner,
+		escrow.Roles.DisputeResolver,
+		escrow.Roles.PlatformAddress,
+	)
+}
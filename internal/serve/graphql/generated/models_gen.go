@@ -0,0 +1,62 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+type BaseStateChange interface {
+	IsBaseStateChange()
+}
+
+type EscrowConnection struct {
+	Edges    []*EscrowEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type EscrowEdge struct {
+	Node   *store.EscrowWithCursor `json:"node"`
+	Cursor string                  `json:"cursor"`
+}
+
+type OperationConnection struct {
+	Edges    []*OperationEdge `json:"edges"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type OperationEdge struct {
+	Node   *types.Operation `json:"node"`
+	Cursor string           `json:"cursor"`
+}
+
+type PageInfo struct {
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+}
+
+type Query struct {
+}
+
+type StateChangeConnection struct {
+	Edges    []*StateChangeEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type StateChangeEdge struct {
+	Node   BaseStateChange `json:"node"`
+	Cursor string          `json:"cursor"`
+}
+
+type TransactionConnection struct {
+	Edges    []*TransactionEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type TransactionEdge struct {
+	Node   *types.Transaction `json:"node"`
+	Cursor string             `json:"cursor"`
+}
@@ -0,0 +1,33 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *accountResolver) Transactions(ctx context.Context, obj *types.Account, first *int, after *string, last *int, before *string) (*generated.TransactionConnection, error) {
+	return r.transactionConnection(ctx, store.TransactionFilter{AccountID: obj.StellarAddress}, first, after, last, before)
+}
+
+func (r *accountResolver) Operations(ctx context.Context, obj *types.Account, first *int, after *string, last *int, before *string) (*generated.OperationConnection, error) {
+	return r.operationConnection(ctx, store.OperationFilter{AccountID: obj.StellarAddress}, first, after, last, before)
+}
+
+func (r *accountResolver) StateChanges(ctx context.Context, obj *types.Account, category *types.StateChangeCategory, first *int, after *string, last *int, before *string) (*generated.StateChangeConnection, error) {
+	filter := store.StateChangeFilter{AccountID: obj.StellarAddress}
+	if category != nil {
+		filter.Category = *category
+	}
+	return r.stateChangeConnection(ctx, filter, first, after, last, before)
+}
+
+func (r *Resolver) Account() generated.AccountResolver { return &accountResolver{r} }
+
+type accountResolver struct{ *Resolver }
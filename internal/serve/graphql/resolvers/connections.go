@@ -0,0 +1,143 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *Resolver) transactionConnection(ctx context.Context, filter store.TransactionFilter, first *int, after *string, last *int, before *string) (*generated.TransactionConnection, error) {
+	p, err := parsePagination(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeInt64Cursor(p.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := r.store.GetTransactions(ctx, filter, page(p, cursor))
+	if err != nil {
+		return nil, err
+	}
+	txs, cursors, pageInfo := connection(txs, p, func(tx *types.TransactionWithCursor) string {
+		return encodeInt64Cursor(tx.Cursor)
+	})
+
+	edges := make([]*generated.TransactionEdge, len(txs))
+	for i, tx := range txs {
+		edges[i] = &generated.TransactionEdge{Node: &tx.Transaction, Cursor: cursors[i]}
+	}
+	return &generated.TransactionConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+func (r *Resolver) operationConnection(ctx context.Context, filter store.OperationFilter, first *int, after *string, last *int, before *string) (*generated.OperationConnection, error) {
+	p, err := parsePagination(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeInt64Cursor(p.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := r.store.GetOperations(ctx, filter, page(p, cursor))
+	if err != nil {
+		return nil, err
+	}
+	ops, cursors, pageInfo := connection(ops, p, func(op *types.OperationWithCursor) string {
+		return encodeInt64Cursor(op.Cursor)
+	})
+
+	edges := make([]*generated.OperationEdge, len(ops))
+	for i, op := range ops {
+		edges[i] = &generated.OperationEdge{Node: &op.Operation, Cursor: cursors[i]}
+	}
+	return &generated.OperationConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+func (r *Resolver) stateChangeConnection(ctx context.Context, filter store.StateChangeFilter, first *int, after *string, last *int, before *string) (*generated.StateChangeConnection, error) {
+	p, err := parsePagination(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeStateChangeCursor(p.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges, err := r.store.GetStateChanges(ctx, filter, page(p, cursor))
+	if err != nil {
+		return nil, err
+	}
+	stateChanges, cursors, pageInfo := connection(stateChanges, p, func(sc *types.StateChangeWithCursor) string {
+		return encodeStateChangeCursor(sc.Cursor)
+	})
+
+	edges := make([]*generated.StateChangeEdge, len(stateChanges))
+	for i, sc := range stateChanges {
+		node, err := convertStateChangeTypes(sc.StateChange)
+		if err != nil {
+			return nil, err
+		}
+		edges[i] = &generated.StateChangeEdge{Node: node, Cursor: cursors[i]}
+	}
+	return &generated.StateChangeConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+func (r *Resolver) escrowConnection(ctx context.Context, filter store.EscrowFilter, first *int, after *string, last *int, before *string) (*generated.EscrowConnection, error) {
+	p, err := parsePagination(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeInt64Cursor(p.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	escrows, err := r.store.GetEscrows(ctx, filter, page(p, cursor))
+	if err != nil {
+		return nil, err
+	}
+	escrows, cursors, pageInfo := connection(escrows, p, func(escrow *store.EscrowWithCursor) string {
+		return encodeInt64Cursor(escrow.Cursor)
+	})
+
+	edges := make([]*generated.EscrowEdge, len(escrows))
+	for i, escrow := range escrows {
+		edges[i] = &generated.EscrowEdge{Node: escrow, Cursor: cursors[i]}
+	}
+	return &generated.EscrowConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// stateChangeAccount, stateChangeOperation and stateChangeTransaction resolve the
+// relationships shared by every concrete state change type.
+func (r *Resolver) stateChangeAccount(ctx context.Context, sc types.StateChange) (*types.Account, error) {
+	return r.store.GetAccount(ctx, sc.AccountID)
+}
+
+func (r *Resolver) stateChangeOperation(ctx context.Context, sc types.StateChange) (*types.Operation, error) {
+	// Fee state changes don't belong to an operation.
+	if sc.OperationID == 0 {
+		return nil, nil
+	}
+	return optional(r.store.GetOperationByID(ctx, sc.OperationID))
+}
+
+func (r *Resolver) stateChangeTransaction(ctx context.Context, sc types.StateChange) (*types.Transaction, error) {
+	return r.store.GetTransactionByHash(ctx, sc.TxHash)
+}
+
+// optional turns a store.ErrNotFound into a null result.
+func optional[T any](value *T, err error) (*T, error) {
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,45 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *escrowResolver) Amount(ctx context.Context, obj *store.EscrowWithCursor) (*string, error) {
+	if obj.EscrowType != entities.EscrowTypeSingleRelease {
+		return nil, nil
+	}
+	amount := strconv.FormatUint(obj.Amount, 10)
+	return &amount, nil
+}
+
+func (r *escrowResolver) Flags(ctx context.Context, obj *store.EscrowWithCursor) (*entities.EscrowFlags, error) {
+	if obj.EscrowType != entities.EscrowTypeSingleRelease {
+		return nil, nil
+	}
+	return &obj.Flags, nil
+}
+
+func (r *milestoneResolver) Amount(ctx context.Context, obj *entities.Milestone) (*string, error) {
+	// Multi release milestones carry their own amount and flags.
+	if obj.Flags == nil && obj.Amount == 0 {
+		return nil, nil
+	}
+	amount := strconv.FormatUint(obj.Amount, 10)
+	return &amount, nil
+}
+
+func (r *Resolver) Escrow() generated.EscrowResolver { return &escrowResolver{r} }
+
+func (r *Resolver) Milestone() generated.MilestoneResolver { return &milestoneResolver{r} }
+
+type escrowResolver struct{ *Resolver }
+type milestoneResolver struct{ *Resolver }
@@ -0,0 +1,29 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *operationResolver) Transaction(ctx context.Context, obj *types.Operation) (*types.Transaction, error) {
+	return r.store.GetTransactionByHash(ctx, obj.TxHash)
+}
+
+func (r *operationResolver) Accounts(ctx context.Context, obj *types.Operation) ([]*types.Account, error) {
+	return r.store.GetAccountsByOperationID(ctx, obj.ID)
+}
+
+func (r *operationResolver) StateChanges(ctx context.Context, obj *types.Operation, first *int, after *string, last *int, before *string) (*generated.StateChangeConnection, error) {
+	return r.stateChangeConnection(ctx, store.StateChangeFilter{OperationID: obj.ID}, first, after, last, before)
+}
+
+func (r *Resolver) Operation() generated.OperationResolver { return &operationResolver{r} }
+
+type operationResolver struct{ *Resolver }
@@ -0,0 +1,60 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *queryResolver) Account(ctx context.Context, address string) (*types.Account, error) {
+	return optional(r.store.GetAccount(ctx, address))
+}
+
+func (r *queryResolver) TransactionByHash(ctx context.Context, hash string) (*types.Transaction, error) {
+	return optional(r.store.GetTransactionByHash(ctx, hash))
+}
+
+func (r *queryResolver) Transactions(ctx context.Context, first *int, after *string, last *int, before *string) (*generated.TransactionConnection, error) {
+	return r.transactionConnection(ctx, store.TransactionFilter{}, first, after, last, before)
+}
+
+func (r *queryResolver) OperationByID(ctx context.Context, id int64) (*types.Operation, error) {
+	return optional(r.store.GetOperationByID(ctx, id))
+}
+
+func (r *queryResolver) Operations(ctx context.Context, first *int, after *string, last *int, before *string) (*generated.OperationConnection, error) {
+	return r.operationConnection(ctx, store.OperationFilter{}, first, after, last, before)
+}
+
+func (r *queryResolver) StateChanges(ctx context.Context, category *types.StateChangeCategory, first *int, after *string, last *int, before *string) (*generated.StateChangeConnection, error) {
+	filter := store.StateChangeFilter{}
+	if category != nil {
+		filter.Category = *category
+	}
+	return r.stateChangeConnection(ctx, filter, first, after, last, before)
+}
+
+func (r *queryResolver) Escrow(ctx context.Context, contractID string) (*store.EscrowWithCursor, error) {
+	return optional(r.store.GetEscrow(ctx, contractID))
+}
+
+func (r *queryResolver) Escrows(ctx context.Context, engagementID *string, platformAddress *string, first *int, after *string, last *int, before *string) (*generated.EscrowConnection, error) {
+	filter := store.EscrowFilter{}
+	if engagementID != nil {
+		filter.EngagementID = *engagementID
+	}
+	if platformAddress != nil {
+		filter.Roles.PlatformAddress = *platformAddress
+	}
+	return r.escrowConnection(ctx, filter, first, after, last, before)
+}
+
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
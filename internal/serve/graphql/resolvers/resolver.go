@@ -0,0 +1,19 @@
+// Package resolvers implements the GraphQL API of the serve command on top of the store
+// readers. The resolver methods in *.resolvers.go are kept by gqlgen when regenerating.
+package resolvers
+
+import (
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+//go:generate go tool gqlgen generate
+
+// Resolver is the root resolver, holding the dependencies shared by every resolver.
+type Resolver struct {
+	store store.Reader
+}
+
+// NewResolver creates the root resolver.
+func NewResolver(store store.Reader) *Resolver {
+	return &Resolver{store: store}
+}
@@ -0,0 +1,271 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+)
+
+func (r *accountStateChangeResolver) Account(ctx context.Context, obj *types.AccountStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *accountStateChangeResolver) Operation(ctx context.Context, obj *types.AccountStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *accountStateChangeResolver) Transaction(ctx context.Context, obj *types.AccountStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *accountStateChangeResolver) TokenID(ctx context.Context, obj *types.AccountStateChangeModel) (*string, error) {
+	return nullString(obj.TokenID), nil
+}
+
+func (r *accountStateChangeResolver) Amount(ctx context.Context, obj *types.AccountStateChangeModel) (*string, error) {
+	return nullString(obj.Amount), nil
+}
+
+func (r *accountStateChangeResolver) FunderAccountID(ctx context.Context, obj *types.AccountStateChangeModel) (*string, error) {
+	return nullString(obj.FunderAccountID), nil
+}
+
+func (r *accountStateChangeResolver) DeployerAccountID(ctx context.Context, obj *types.AccountStateChangeModel) (*string, error) {
+	return nullString(obj.DeployerAccountID), nil
+}
+
+func (r *balanceAuthorizationStateChangeResolver) Account(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *balanceAuthorizationStateChangeResolver) Operation(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *balanceAuthorizationStateChangeResolver) Transaction(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *balanceAuthorizationStateChangeResolver) TokenID(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) (*string, error) {
+	return nullString(obj.TokenID), nil
+}
+
+func (r *balanceAuthorizationStateChangeResolver) Flags(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) ([]string, error) {
+	return flags(obj.Flags), nil
+}
+
+func (r *balanceAuthorizationStateChangeResolver) KeyValue(ctx context.Context, obj *types.BalanceAuthorizationStateChangeModel) (map[string]any, error) {
+	return obj.KeyValue, nil
+}
+
+func (r *balanceStateChangeResolver) Account(ctx context.Context, obj *types.StandardBalanceStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *balanceStateChangeResolver) Operation(ctx context.Context, obj *types.StandardBalanceStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *balanceStateChangeResolver) Transaction(ctx context.Context, obj *types.StandardBalanceStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *balanceStateChangeResolver) TokenID(ctx context.Context, obj *types.StandardBalanceStateChangeModel) (string, error) {
+	return obj.TokenID.String, nil
+}
+
+func (r *balanceStateChangeResolver) Amount(ctx context.Context, obj *types.StandardBalanceStateChangeModel) (string, error) {
+	return obj.Amount.String, nil
+}
+
+func (r *escrowStateChangeResolver) Account(ctx context.Context, obj *types.EscrowStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *escrowStateChangeResolver) Operation(ctx context.Context, obj *types.EscrowStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *escrowStateChangeResolver) Transaction(ctx context.Context, obj *types.EscrowStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *escrowStateChangeResolver) DeployerAccountID(ctx context.Context, obj *types.EscrowStateChangeModel) (*string, error) {
+	return nullString(obj.DeployerAccountID), nil
+}
+
+func (r *escrowStateChangeResolver) KeyValue(ctx context.Context, obj *types.EscrowStateChangeModel) (map[string]any, error) {
+	return obj.KeyValue, nil
+}
+
+func (r *flagsStateChangeResolver) Account(ctx context.Context, obj *types.FlagsStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *flagsStateChangeResolver) Operation(ctx context.Context, obj *types.FlagsStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *flagsStateChangeResolver) Transaction(ctx context.Context, obj *types.FlagsStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *flagsStateChangeResolver) TokenID(ctx context.Context, obj *types.FlagsStateChangeModel) (*string, error) {
+	return nullString(obj.TokenID), nil
+}
+
+func (r *flagsStateChangeResolver) Flags(ctx context.Context, obj *types.FlagsStateChangeModel) ([]string, error) {
+	return flags(obj.Flags), nil
+}
+
+func (r *metadataStateChangeResolver) Account(ctx context.Context, obj *types.MetadataStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *metadataStateChangeResolver) Operation(ctx context.Context, obj *types.MetadataStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *metadataStateChangeResolver) Transaction(ctx context.Context, obj *types.MetadataStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *metadataStateChangeResolver) KeyValue(ctx context.Context, obj *types.MetadataStateChangeModel) (map[string]any, error) {
+	return obj.KeyValue, nil
+}
+
+func (r *reservesStateChangeResolver) Account(ctx context.Context, obj *types.ReservesStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *reservesStateChangeResolver) Operation(ctx context.Context, obj *types.ReservesStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *reservesStateChangeResolver) Transaction(ctx context.Context, obj *types.ReservesStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *reservesStateChangeResolver) SponsoredAccountID(ctx context.Context, obj *types.ReservesStateChangeModel) (*string, error) {
+	return nullString(obj.SponsoredAccountID), nil
+}
+
+func (r *reservesStateChangeResolver) SponsorAccountID(ctx context.Context, obj *types.ReservesStateChangeModel) (*string, error) {
+	return nullString(obj.SponsorAccountID), nil
+}
+
+func (r *reservesStateChangeResolver) KeyValue(ctx context.Context, obj *types.ReservesStateChangeModel) (map[string]any, error) {
+	return obj.KeyValue, nil
+}
+
+func (r *signerStateChangeResolver) Account(ctx context.Context, obj *types.SignerStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *signerStateChangeResolver) Operation(ctx context.Context, obj *types.SignerStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *signerStateChangeResolver) Transaction(ctx context.Context, obj *types.SignerStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *signerStateChangeResolver) SignerAccountID(ctx context.Context, obj *types.SignerStateChangeModel) (*string, error) {
+	return nullString(obj.SignerAccountID), nil
+}
+
+func (r *signerStateChangeResolver) SignerWeights(ctx context.Context, obj *types.SignerStateChangeModel) (map[string]any, error) {
+	return obj.SignerWeights, nil
+}
+
+func (r *signerThresholdsStateChangeResolver) Account(ctx context.Context, obj *types.SignerThresholdsStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *signerThresholdsStateChangeResolver) Operation(ctx context.Context, obj *types.SignerThresholdsStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *signerThresholdsStateChangeResolver) Transaction(ctx context.Context, obj *types.SignerThresholdsStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *signerThresholdsStateChangeResolver) Thresholds(ctx context.Context, obj *types.SignerThresholdsStateChangeModel) (map[string]any, error) {
+	return obj.Thresholds, nil
+}
+
+func (r *trustlineStateChangeResolver) Account(ctx context.Context, obj *types.TrustlineStateChangeModel) (*types.Account, error) {
+	return r.stateChangeAccount(ctx, obj.StateChange)
+}
+
+func (r *trustlineStateChangeResolver) Operation(ctx context.Context, obj *types.TrustlineStateChangeModel) (*types.Operation, error) {
+	return r.stateChangeOperation(ctx, obj.StateChange)
+}
+
+func (r *trustlineStateChangeResolver) Transaction(ctx context.Context, obj *types.TrustlineStateChangeModel) (*types.Transaction, error) {
+	return r.stateChangeTransaction(ctx, obj.StateChange)
+}
+
+func (r *trustlineStateChangeResolver) TokenID(ctx context.Context, obj *types.TrustlineStateChangeModel) (*string, error) {
+	return nullString(obj.TokenID), nil
+}
+
+func (r *trustlineStateChangeResolver) Limit(ctx context.Context, obj *types.TrustlineStateChangeModel) (map[string]any, error) {
+	return obj.TrustlineLimit, nil
+}
+
+func (r *Resolver) AccountStateChange() generated.AccountStateChangeResolver {
+	return &accountStateChangeResolver{r}
+}
+
+func (r *Resolver) BalanceAuthorizationStateChange() generated.BalanceAuthorizationStateChangeResolver {
+	return &balanceAuthorizationStateChangeResolver{r}
+}
+
+func (r *Resolver) BalanceStateChange() generated.BalanceStateChangeResolver {
+	return &balanceStateChangeResolver{r}
+}
+
+func (r *Resolver) EscrowStateChange() generated.EscrowStateChangeResolver {
+	return &escrowStateChangeResolver{r}
+}
+
+func (r *Resolver) FlagsStateChange() generated.FlagsStateChangeResolver {
+	return &flagsStateChangeResolver{r}
+}
+
+func (r *Resolver) MetadataStateChange() generated.MetadataStateChangeResolver {
+	return &metadataStateChangeResolver{r}
+}
+
+func (r *Resolver) ReservesStateChange() generated.ReservesStateChangeResolver {
+	return &reservesStateChangeResolver{r}
+}
+
+func (r *Resolver) SignerStateChange() generated.SignerStateChangeResolver {
+	return &signerStateChangeResolver{r}
+}
+
+func (r *Resolver) SignerThresholdsStateChange() generated.SignerThresholdsStateChangeResolver {
+	return &signerThresholdsStateChangeResolver{r}
+}
+
+func (r *Resolver) TrustlineStateChange() generated.TrustlineStateChangeResolver {
+	return &trustlineStateChangeResolver{r}
+}
+
+type accountStateChangeResolver struct{ *Resolver }
+type balanceAuthorizationStateChangeResolver struct{ *Resolver }
+type balanceStateChangeResolver struct{ *Resolver }
+type escrowStateChangeResolver struct{ *Resolver }
+type flagsStateChangeResolver struct{ *Resolver }
+type metadataStateChangeResolver struct{ *Resolver }
+type reservesStateChangeResolver struct{ *Resolver }
+type signerStateChangeResolver struct{ *Resolver }
+type signerThresholdsStateChangeResolver struct{ *Resolver }
+type trustlineStateChangeResolver struct{ *Resolver }
@@ -0,0 +1,29 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+func (r *transactionResolver) Accounts(ctx context.Context, obj *types.Transaction) ([]*types.Account, error) {
+	return r.store.GetAccountsByTxHash(ctx, obj.Hash)
+}
+
+func (r *transactionResolver) Operations(ctx context.Context, obj *types.Transaction, first *int, after *string, last *int, before *string) (*generated.OperationConnection, error) {
+	return r.operationConnection(ctx, store.OperationFilter{TxHash: obj.Hash}, first, after, last, before)
+}
+
+func (r *transactionResolver) StateChanges(ctx context.Context, obj *types.Transaction, first *int, after *string, last *int, before *string) (*generated.StateChangeConnection, error) {
+	return r.stateChangeConnection(ctx, store.StateChangeFilter{TxHash: obj.Hash}, first, after, last, before)
+}
+
+func (r *Resolver) Transaction() generated.TransactionResolver { return &transactionResolver{r} }
+
+type transactionResolver struct{ *Resolver }
@@ -0,0 +1,179 @@
+package resolvers
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+// pagination holds the decoded Relay pagination arguments of a connection field.
+type pagination struct {
+	limit    int
+	cursor   *string
+	order    store.SortOrder
+	backward bool
+}
+
+// parsePagination validates the Relay arguments. first/after pages from the newest entities
+// to the oldest ones, last/before pages the other way around.
+func parsePagination(first *int, after *string, last *int, before *string) (pagination, error) {
+	if first != nil && last != nil {
+		return pagination{}, errors.New("first and last can't be used together")
+	}
+	if after != nil && before != nil {
+		return pagination{}, errors.New("after and before can't be used together")
+	}
+	if (first != nil && *first < 0) || (last != nil && *last < 0) {
+		return pagination{}, errors.New("first and last must be positive")
+	}
+
+	p := pagination{limit: store.DefaultPageLimit, order: store.SortDesc}
+	if last != nil || before != nil {
+		p.backward = true
+		p.order = store.SortAsc
+		p.cursor = before
+		if last != nil {
+			p.limit = *last
+		}
+	} else {
+		p.cursor = after
+		if first != nil {
+			p.limit = *first
+		}
+	}
+	p.limit = min(p.limit, store.MaxPageLimit)
+	return p, nil
+}
+
+// page returns the store page for the decoded cursor. It requests one extra row to know
+// whether there are more entities in the paging direction.
+func page[C any](p pagination, cursor *C) store.Page[C] {
+	return store.Page[C]{Limit: p.limit + 1, Cursor: cursor, Order: p.order}
+}
+
+// connection trims the extra row requested by page, restores the newest-first order of
+// backward pages and builds the PageInfo.
+func connection[T any](items []T, p pagination, cursor func(T) string) ([]T, []string, *generated.PageInfo) {
+	hasMore := len(items) > p.limit
+	if hasMore {
+		items = items[:p.limit]
+	}
+	if p.backward {
+		slices.Reverse(items)
+	}
+
+	cursors := make([]string, len(items))
+	for i, item := range items {
+		cursors[i] = cursor(item)
+	}
+
+	pageInfo := &generated.PageInfo{}
+	if len(cursors) > 0 {
+		pageInfo.StartCursor = &cursors[0]
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+	if p.backward {
+		pageInfo.HasPreviousPage = hasMore
+		pageInfo.HasNextPage = p.cursor != nil
+	} else {
+		pageInfo.HasNextPage = hasMore
+		pageInfo.HasPreviousPage = p.cursor != nil
+	}
+	return items, cursors, pageInfo
+}
+
+func encodeCursor(cursor string) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return string(decoded), nil
+}
+
+func encodeInt64Cursor(cursor int64) string {
+	return encodeCursor(strconv.FormatInt(cursor, 10))
+}
+
+func decodeInt64Cursor(cursor *string) (*int64, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+	decoded, err := decodeCursor(*cursor)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(decoded, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q", *cursor)
+	}
+	return &value, nil
+}
+
+func encodeStateChangeCursor(cursor types.StateChangeCursor) string {
+	return cursor.Encode()
+}
+
+func decodeStateChangeCursor(cursor *string) (*types.StateChangeCursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+	parsed, err := types.DecodeStateChangeCursor(*cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// convertStateChangeTypes wraps the unified state change in the adapter model of its
+// category, which gqlgen resolves to the concrete GraphQL type.
+func convertStateChangeTypes(sc types.StateChange) (generated.BaseStateChange, error) {
+	switch sc.StateChangeCategory {
+	case types.StateChangeCategoryBalance:
+		return &types.StandardBalanceStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryAccount:
+		return &types.AccountStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategorySigner:
+		return &types.SignerStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategorySignatureThreshold:
+		return &types.SignerThresholdsStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryMetadata:
+		return &types.MetadataStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryFlags:
+		return &types.FlagsStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryTrustline:
+		return &types.TrustlineStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryBalanceAuthorization, types.StateChangeCategoryAuthorization:
+		return &types.BalanceAuthorizationStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryReserves:
+		return &types.ReservesStateChangeModel{StateChange: sc}, nil
+	case types.StateChangeCategoryEscrow:
+		return &types.EscrowStateChangeModel{StateChange: sc}, nil
+	default:
+		return nil, fmt.Errorf("unknown state change category %q", sc.StateChangeCategory)
+	}
+}
+
+func nullString(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
+
+func flags(value types.NullableJSON) []string {
+	if value == nil {
+		return []string{}
+	}
+	return value
+}
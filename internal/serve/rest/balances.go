@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/strkey"
+)
+
+// Balance is the JSON representation of a token balance. Amounts are in the smallest unit
+// of the token.
+type Balance struct {
+	AccountID    string             `json:"accountId"`
+	TokenID      string             `json:"tokenId"`
+	Amount       string             `json:"amount"`
+	LedgerNumber uint32             `json:"ledgerNumber"`
+	Reconciled   *ReconciledBalance `json:"reconciled,omitempty"`
+}
+
+// ReconciledBalance is the balance last read from the ledger entries of the network.
+type ReconciledBalance struct {
+	Amount       string     `json:"amount"`
+	LedgerNumber uint32     `json:"ledgerNumber"`
+	At           *time.Time `json:"at,omitempty"`
+}
+
+// BalanceList holds the balances of an account.
+type BalanceList struct {
+	Balances []Balance `json:"balances"`
+}
+
+// Reconciliation is the result of a reconciliation. Matched is false when the indexed
+// balance differed from the on-chain one.
+type Reconciliation struct {
+	IndexedAmount string  `json:"indexedAmount"`
+	OnChainAmount string  `json:"onChainAmount"`
+	LedgerNumber  uint32  `json:"ledgerNumber"`
+	Matched       bool    `json:"matched"`
+	Balance       Balance `json:"balance"`
+}
+
+func newBalance(balance *store.Balance) Balance {
+	b := Balance{
+		AccountID:    balance.AccountID,
+		TokenID:      balance.TokenID,
+		Amount:       balance.Amount,
+		LedgerNumber: balance.LedgerNumber,
+	}
+	if balance.ReconciledAmount != nil && balance.ReconciledLedger != nil {
+		b.Reconciled = &ReconciledBalance{
+			Amount:       *balance.ReconciledAmount,
+			LedgerNumber: *balance.ReconciledLedger,
+			At:           balance.ReconciledAt,
+		}
+	}
+	return b
+}
+
+func (h *Handler) listBalances(w http.ResponseWriter, r *http.Request) {
+	address, err := h.authorizedAccount(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	balances, err := h.balances.GetBalances(r.Context(), address)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := BalanceList{Balances: make([]Balance, 0, len(balances))}
+	for _, balance := range balances {
+		resp.Balances = append(resp.Balances, newBalance(balance))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *Handler) getBalance(w http.ResponseWriter, r *http.Request) {
+	address, err := h.authorizedAccount(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	ledger, err := parseUint32(r.URL.Query(), "ledger")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	tokenID, err := tokenAddress(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	balance, err := h.balances.GetBalance(r.Context(), address, tokenID, ledger)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newBalance(balance))
+}
+
+func (h *Handler) reconcileBalance(w http.ResponseWriter, r *http.Request) {
+	address, err := h.authorizedAccount(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	tokenID, err := tokenAddress(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	reconciliation, err := h.balances.Reconcile(r.Context(), address, tokenID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newReconciliation(reconciliation))
+}
+
+func newReconciliation(reconciliation *services.Reconciliation) Reconciliation {
+	return Reconciliation{
+		IndexedAmount: reconciliation.IndexedAmount,
+		OnChainAmount: reconciliation.OnChainAmount,
+		LedgerNumber:  reconciliation.Ledger,
+		Matched:       reconciliation.Matched(),
+		Balance:       newBalance(reconciliation.Balance),
+	}
+}
+
+// tokenAddress returns the tokenId path parameter, the contract address of a token.
+func tokenAddress(r *http.Request) (string, error) {
+	tokenID := r.PathValue("tokenId")
+	if !strkey.IsValidContractAddress(tokenID) {
+		return "", &badRequestError{fmt.Sprintf("invalid tokenId %q", tokenID)}
+	}
+	return tokenID, nil
+}
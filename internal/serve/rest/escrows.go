@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+// Escrow is the JSON representation of an escrow, with its milestones embedded. It shares
+// the v1 escrow payload of the events (see internal/event). LedgerNumber is the ledger the
+// escrow was deployed in, UpdatedLedgerNumber the ledger of its last change.
+type Escrow struct {
+	event.EscrowV1
+	LedgerNumber        uint32     `json:"ledgerNumber"`
+	LedgerCreatedAt     *time.Time `json:"ledgerCreatedAt,omitempty"`
+	UpdatedLedgerNumber uint32     `json:"updatedLedgerNumber"`
+}
+
+// EscrowPage is a page of escrows. NextCursor is omitted on the last page.
+type EscrowPage struct {
+	Escrows    []Escrow `json:"escrows"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+func newEscrow(escrow *store.EscrowWithCursor) Escrow {
+	e := Escrow{EscrowV1: event.NewEscrowV1(escrow.Escrow), LedgerNumber: escrow.LedgerNumber, UpdatedLedgerNumber: escrow.UpdatedLedgerNumber}
+	if !escrow.LedgerCreatedAt.IsZero() {
+		e.LedgerCreatedAt = &escrow.LedgerCreatedAt
+	}
+	return e
+}
+
+func (h *Handler) getEscrow(w http.ResponseWriter, r *http.Request) {
+	contractID := r.PathValue("contractId")
+	escrow, err := h.store.GetEscrow(r.Context(), contractID)
+	if err == nil && !auth.FromContext(r.Context()).AllowsPlatform(escrow.Roles.PlatformAddress) {
+		err = fmt.Errorf("escrow %s: %w", contractID, store.ErrNotFound)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newEscrow(escrow))
+}
+
+func (h *Handler) listEscrows(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	filter, err := parseEscrowFilter(params)
+	if err == nil {
+		err = scopeEscrowFilter(r, &filter)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	page, err := parsePage(params, decodeCursor)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Request one extra escrow to know whether there is a next page.
+	limit := page.Limit
+	page.Limit++
+	escrows, err := h.store.GetEscrows(r.Context(), filter, page)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := EscrowPage{Escrows: make([]Escrow, 0, len(escrows))}
+	if len(escrows) > limit {
+		escrows = escrows[:limit]
+		resp.NextCursor = encodeCursor(escrows[len(escrows)-1].Cursor)
+	}
+	for _, escrow := range escrows {
+		resp.Escrows = append(resp.Escrows, newEscrow(escrow))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func parseEscrowFilter(params url.Values) (store.EscrowFilter, error) {
+	filter := store.EscrowFilter{
+		EngagementID: params.Get("engagementId"),
+		EscrowType:   entities.EscrowType(params.Get("escrowType")),
+		Roles: entities.EscrowRoles{
+			ServiceProvider: params.Get("serviceProvider"),
+			Receiver:        params.Get("receiver"),
+			Approver:        params.Get("approver"),
+			ReleaseSigner:   params.Get("releaseSigner"),
+			DisputeResolver: params.Get("disputeResolver"),
+			PlatformAddress: params.Get("platformAddress"),
+		},
+	}
+	switch filter.EscrowType {
+	case "", entities.EscrowTypeSingleRelease, entities.EscrowTypeMultiRelease:
+	default:
+		return store.EscrowFilter{}, &badRequestError{fmt.Sprintf("invalid escrowType %q", filter.EscrowType)}
+	}
+
+	flags := []struct {
+		name  string
+		value **bool
+	}{
+		{"approved", &filter.Flags.Approved},
+		{"disputed", &filter.Flags.Disputed},
+		{"released", &filter.Flags.Released},
+		{"resolved", &filter.Flags.Resolved},
+	}
+	for _, flag := range flags {
+		if !params.Has(flag.name) {
+			continue
+		}
+		value, err := strconv.ParseBool(params.Get(flag.name))
+		if err != nil {
+			return store.EscrowFilter{}, &badRequestError{fmt.Sprintf("invalid %s %q", flag.name, params.Get(flag.name))}
+		}
+		*flag.value = &value
+	}
+
+	var err error
+	if filter.FromLedger, err = parseUint32(params, "fromLedger"); err != nil {
+		return store.EscrowFilter{}, err
+	}
+	if filter.ToLedger, err = parseUint32(params, "toLedger"); err != nil {
+		return store.EscrowFilter{}, err
+	}
+	if filter.ToLedger != 0 && filter.FromLedger > filter.ToLedger {
+		return store.EscrowFilter{}, &badRequestError{"fromLedger must not be greater than toLedger"}
+	}
+	return filter, nil
+}
+
+// parsePage reads the limit, cursor and order parameters. Cursors are opaque to clients and
+// stay valid while new entities are indexed.
+func parsePage[C any](params url.Values, decodeCursor func(string) (C, error)) (store.Page[C], error) {
+	page := store.Page[C]{Limit: store.DefaultPageLimit, Order: store.SortDesc}
+	if params.Has("limit") {
+		limit, err := strconv.Atoi(params.Get("limit"))
+		if err != nil || limit <= 0 {
+			return page, &badRequestError{fmt.Sprintf("invalid limit %q", params.Get("limit"))}
+		}
+		page.Limit = min(limit, store.MaxPageLimit)
+	}
+	switch params.Get("order") {
+	case "", "desc":
+	case "asc":
+		page.Order = store.SortAsc
+	default:
+		return page, &badRequestError{fmt.Sprintf("invalid order %q", params.Get("order"))}
+	}
+	if params.Has("cursor") {
+		cursor, err := decodeCursor(params.Get("cursor"))
+		if err != nil {
+			return page, err
+		}
+		page.Cursor = &cursor
+	}
+	return page, nil
+}
+
+func parseUint32(params url.Values, name string) (uint32, error) {
+	if !params.Has(name) {
+		return 0, nil
+	}
+	value, err := strconv.ParseUint(params.Get(name), 10, 32)
+	if err != nil {
+		return 0, &badRequestError{fmt.Sprintf("invalid %s %q", name, params.Get(name))}
+	}
+	return uint32(value), nil
+}
+
+func encodeCursor(cursor int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(cursor, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, &badRequestError{fmt.Sprintf("invalid cursor %q", cursor)}
+	}
+	value, err := strconv.ParseInt(string(decoded), 10, 64)
+	if err != nil {
+		return 0, &badRequestError{fmt.Sprintf("invalid cursor %q", cursor)}
+	}
+	return value, nil
+}
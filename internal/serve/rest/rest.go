@@ -0,0 +1,105 @@
+// Package rest implements the REST API of the serve command. The API is described by the
+// OpenAPI document served on /v1/openapi.yaml.
+package rest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Trustless-Work/Indexer/internal/services"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// Handler serves the /v1 endpoints.
+type Handler struct {
+	store             store.Reader
+	balances          services.BalanceService
+	networkPassphrase string
+	mux               *http.ServeMux
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler creates the REST API handler. networkPassphrase is the passphrase of the
+// network the stored transactions belong to. The balance endpoints are only served when
+// balances is not nil.
+func NewHandler(store store.Reader, balances services.BalanceService, networkPassphrase string) *Handler {
+	h := &Handler{store: store, balances: balances, networkPassphrase: networkPassphrase, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /v1/openapi.yaml", h.openAPI)
+	h.mux.HandleFunc("GET /v1/escrows", h.listEscrows)
+	h.mux.HandleFunc("GET /v1/escrows/search", h.searchEscrows)
+	h.mux.HandleFunc("GET /v1/escrows/{contractId}", h.getEscrow)
+	h.mux.HandleFunc("GET /v1/statements", h.getStatement)
+	h.mux.HandleFunc("GET /v1/transactions/{hash}/decoded", h.getDecodedTransaction)
+	h.mux.HandleFunc("GET /v1/accounts/{address}/timeline", h.getAccountTimeline)
+	if balances != nil {
+		h.mux.HandleFunc("GET /v1/accounts/{address}/balances", h.listBalances)
+		h.mux.HandleFunc("GET /v1/accounts/{address}/balances/{tokenId}", h.getBalance)
+		h.mux.HandleFunc("POST /v1/accounts/{address}/balances/{tokenId}/reconcile", h.reconcileBalance)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(openAPISpec)
+}
+
+// accountAddress returns the address path parameter, an account or contract address.
+func accountAddress(r *http.Request) (string, error) {
+	address := r.PathValue("address")
+	if !strkey.IsValidEd25519PublicKey(address) && !strkey.IsValidContractAddress(address) {
+		return "", &badRequestError{fmt.Sprintf("invalid address %q", address)}
+	}
+	return address, nil
+}
+
+// errorResponse is the body of every non 2xx response.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// badRequestError marks errors caused by invalid request parameters.
+type badRequestError struct {
+	message string
+}
+
+func (e *badRequestError) Error() string {
+	return e.message
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError maps the error to a status code. Internal errors are logged and hidden from
+// the client.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var badRequest *badRequestError
+	var forbidden *forbiddenError
+	switch {
+	case errors.As(err, &badRequest):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: badRequest.message})
+	case errors.As(err, &forbidden):
+		writeJSON(w, http.StatusForbidden, errorResponse{Error: forbidden.message})
+	case errors.Is(err, store.ErrNotFound):
+		writeJSON(w, http.StatusNotFound, errorResponse{Error: err.Error()})
+	default:
+		log.Ctx(r.Context()).Errorf("%s %s: %v", r.Method, r.URL.Path, err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "internal error"})
+	}
+}
@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+// API keys scoped to platforms (see auth.Principal) read the escrows of their platforms, and
+// the accounts and transactions of the participants of these escrows.
+
+// forbiddenError marks requests outside of the scope of their API key.
+type forbiddenError struct {
+	message string
+}
+
+func (e *forbiddenError) Error() string {
+	return e.message
+}
+
+// scopeEscrowFilter restricts an escrow filter to the platforms of the API key.
+func scopeEscrowFilter(r *http.Request, filter *store.EscrowFilter) error {
+	principal := auth.FromContext(r.Context())
+	if !principal.Scoped() {
+		return nil
+	}
+	if platform := filter.Roles.PlatformAddress; platform != "" && !principal.AllowsPlatform(platform) {
+		return &forbiddenError{fmt.Sprintf("platform %s is outside the scope of the API key", platform)}
+	}
+	filter.PlatformAddresses = principal.PlatformAddresses
+	return nil
+}
+
+// authorizedAccount returns the address path parameter, when the API key can read it.
+func (h *Handler) authorizedAccount(r *http.Request) (string, error) {
+	address, err := accountAddress(r)
+	if err != nil {
+		return "", err
+	}
+	if err := h.authorizeAccount(r, address); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// authorizeAccount checks that the API key can read an account: one of its platforms, an escrow
+// of its platforms, or an address holding a role in one of these escrows.
+func (h *Handler) authorizeAccount(r *http.Request, address string) error {
+	principal := auth.FromContext(r.Context())
+	if !principal.Scoped() || slices.Contains(principal.PlatformAddresses, address) {
+		return nil
+	}
+
+	escrow, err := h.store.GetEscrow(r.Context(), address)
+	switch {
+	case err == nil && principal.AllowsPlatform(escrow.Roles.PlatformAddress):
+		return nil
+	case err != nil && !errors.Is(err, store.ErrNotFound):
+		return err
+	}
+
+	roles := []entities.EscrowRoles{
+		{ServiceProvider: address},
+		{Receiver: address},
+		{Approver: address},
+		{ReleaseSigner: address},
+		{DisputeResolver: address},
+	}
+	for _, role := range roles {
+		filter := store.EscrowFilter{Roles: role, PlatformAddresses: principal.PlatformAddresses}
+		escrows, err := h.store.GetEscrows(r.Context(), filter, store.Page[int64]{Limit: 1})
+		if err != nil {
+			return err
+		}
+		if len(escrows) > 0 {
+			return nil
+		}
+	}
+	return &forbiddenError{fmt.Sprintf("address %s is outside the scope of the API key", address)}
+}
+
+// authorizeTransaction checks that the API key can read one of the participants of a transaction.
+func (h *Handler) authorizeTransaction(r *http.Request, txHash string) error {
+	if !auth.FromContext(r.Context()).Scoped() {
+		return nil
+	}
+	accounts, err := h.store.GetAccountsByTxHash(r.Context(), txHash)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		err := h.authorizeAccount(r, account.StellarAddress)
+		var forbidden *forbiddenError
+		if !errors.As(err, &forbidden) {
+			return err
+		}
+	}
+	return &forbiddenError{fmt.Sprintf("transaction %s is outside the scope of the API key", txHash)}
+}
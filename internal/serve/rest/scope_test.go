@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store/memory"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/toid"
+)
+
+// scopeFixture is two platforms, each with an escrow whose receiver sent a transaction.
+type scopeFixture struct {
+	handler                   *Handler
+	platform, otherPlatform   string
+	escrow, otherEscrow       string
+	receiver, otherReceiver   string
+	approver                  string
+	tx, otherTx, sharedTx     string
+	unknownAccount, unknownTx string
+}
+
+func newScopeFixture(t *testing.T) scopeFixture {
+	t.Helper()
+	address := func() string { return keypair.MustRandom().Address() }
+	f := scopeFixture{
+		platform:       address(),
+		otherPlatform:  address(),
+		escrow:         "CESCROW",
+		otherEscrow:    "COTHERESCROW",
+		receiver:       address(),
+		otherReceiver:  address(),
+		approver:       address(),
+		tx:             "hash-100",
+		otherTx:        "hash-101",
+		sharedTx:       "hash-102",
+		unknownAccount: address(),
+		unknownTx:      "hash-unknown",
+	}
+
+	mem := memory.New()
+	write := func(seq uint32, participants []string, escrows ...entities.Escrow) {
+		t.Helper()
+		tx := types.Transaction{
+			Hash:            fmt.Sprintf("hash-%d", seq),
+			ToID:            toid.New(int32(seq), 1, 0).ToInt64(),
+			LedgerNumber:    seq,
+			LedgerCreatedAt: time.Date(2026, 1, 1, 0, 0, int(seq), 0, time.UTC),
+		}
+		buffer := indexer.NewIndexerBuffer()
+		for _, participant := range participants {
+			buffer.PushTransaction(participant, tx)
+		}
+		for _, escrow := range escrows {
+			buffer.PushEscrow(escrow)
+		}
+		if err := mem.WriteLedger(context.Background(), buffer, seq); err != nil {
+			t.Fatalf("writing ledger %d: %v", seq, err)
+		}
+	}
+	write(100, []string{f.receiver}, entities.Escrow{
+		ContractID: f.escrow,
+		EscrowType: entities.EscrowTypeSingleRelease,
+		Roles:      entities.EscrowRoles{PlatformAddress: f.platform, Receiver: f.receiver, Approver: f.approver},
+	})
+	write(101, []string{f.otherReceiver}, entities.Escrow{
+		ContractID: f.otherEscrow,
+		EscrowType: entities.EscrowTypeSingleRelease,
+		Roles:      entities.EscrowRoles{PlatformAddress: f.otherPlatform, Receiver: f.otherReceiver},
+	})
+	write(102, []string{f.otherReceiver, f.receiver})
+
+	f.handler = NewHandler(mem, nil, "Test SDF Network ; September 2015")
+	return f
+}
+
+// request is a GET request to path made with the API key of principal.
+func (f scopeFixture) request(path string, principal *auth.Principal) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	if principal != nil {
+		r = r.WithContext(auth.NewContext(r.Context(), principal))
+	}
+	return r
+}
+
+func TestAuthorizeAccount(t *testing.T) {
+	f := newScopeFixture(t)
+	scoped := &auth.Principal{KeyID: "scoped", PlatformAddresses: []string{f.platform}}
+	for _, tc := range []struct {
+		name          string
+		principal     *auth.Principal
+		address       string
+		wantForbidden bool
+	}{
+		{"authentication disabled", nil, f.otherReceiver, false},
+		{"unscoped key", &auth.Principal{KeyID: "unscoped"}, f.otherReceiver, false},
+		{"own platform", scoped, f.platform, false},
+		{"escrow of own platform", scoped, f.escrow, false},
+		{"receiver of own platform", scoped, f.receiver, false},
+		{"approver of own platform", scoped, f.approver, false},
+		{"other platform", scoped, f.otherPlatform, true},
+		{"escrow of other platform", scoped, f.otherEscrow, true},
+		{"receiver of other platform", scoped, f.otherReceiver, true},
+		{"unknown account", scoped, f.unknownAccount, true},
+		{"several platforms", &auth.Principal{KeyID: "both", PlatformAddresses: []string{f.platform, f.otherPlatform}}, f.otherReceiver, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.handler.authorizeAccount(f.request("/", tc.principal), tc.address)
+			checkForbidden(t, err, tc.wantForbidden)
+		})
+	}
+}
+
+func TestAuthorizeTransaction(t *testing.T) {
+	f := newScopeFixture(t)
+	scoped := &auth.Principal{KeyID: "scoped", PlatformAddresses: []string{f.platform}}
+	for _, tc := range []struct {
+		name          string
+		principal     *auth.Principal
+		txHash        string
+		wantForbidden bool
+	}{
+		{"authentication disabled", nil, f.otherTx, false},
+		{"unscoped key", &auth.Principal{KeyID: "unscoped"}, f.otherTx, false},
+		{"transaction of own platform", scoped, f.tx, false},
+		{"transaction shared with other platform", scoped, f.sharedTx, false},
+		{"transaction of other platform", scoped, f.otherTx, true},
+		{"unknown transaction", scoped, f.unknownTx, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.handler.authorizeTransaction(f.request("/", tc.principal), tc.txHash)
+			checkForbidden(t, err, tc.wantForbidden)
+		})
+	}
+}
+
+func TestScopedEndpoints(t *testing.T) {
+	f := newScopeFixture(t)
+	scoped := &auth.Principal{KeyID: "scoped", PlatformAddresses: []string{f.platform}}
+	for _, tc := range []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{"timeline of own platform", "/v1/accounts/" + f.receiver + "/timeline", http.StatusOK},
+		{"timeline of other platform", "/v1/accounts/" + f.otherReceiver + "/timeline", http.StatusForbidden},
+		{"transaction of other platform", "/v1/transactions/" + f.otherTx + "/decoded", http.StatusForbidden},
+		// The escrows of other platforms are hidden rather than refused.
+		{"escrow of other platform", "/v1/escrows/" + f.otherEscrow, http.StatusNotFound},
+		{"escrows of other platform", "/v1/escrows?platformAddress=" + f.otherPlatform, http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			f.handler.ServeHTTP(w, f.request(tc.path, scoped))
+			if w.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	f.handler.ServeHTTP(w, f.request("/v1/escrows", scoped))
+	var page EscrowPage
+	if err := json.NewDecoder(w.Body).Decode(&page); err != nil {
+		t.Fatalf("decoding escrows: %v", err)
+	}
+	if len(page.Escrows) != 1 || page.Escrows[0].ContractID != f.escrow {
+		t.Errorf("listed %+v, want only escrow %s", page.Escrows, f.escrow)
+	}
+}
+
+func checkForbidden(t *testing.T, err error, wantForbidden bool) {
+	t.Helper()
+	var forbidden *forbiddenError
+	switch {
+	case wantForbidden && !errors.As(err, &forbidden):
+		t.Errorf("error = %v, want forbidden", err)
+	case !wantForbidden && err != nil:
+		t.Errorf("error = %v, want none", err)
+	}
+}
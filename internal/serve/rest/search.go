@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+// maxSearchLength bounds the length of search queries.
+const maxSearchLength = 256
+
+// EscrowSearchResult is an escrow matching a search, with its rank. Higher ranks match better.
+type EscrowSearchResult struct {
+	Escrow
+	Rank float32 `json:"rank"`
+}
+
+// EscrowSearchPage is a page of search results. NextCursor is omitted on the last page.
+type EscrowSearchPage struct {
+	Results    []EscrowSearchResult `json:"results"`
+	NextCursor string               `json:"nextCursor,omitempty"`
+}
+
+// searchEscrows searches the free text of the escrows, taking the filters of listEscrows.
+func (h *Handler) searchEscrows(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := strings.TrimSpace(params.Get("q"))
+	switch {
+	case query == "":
+		writeError(w, r, &badRequestError{"q is required"})
+		return
+	case len(query) > maxSearchLength:
+		writeError(w, r, &badRequestError{fmt.Sprintf("q must not exceed %d bytes", maxSearchLength)})
+		return
+	}
+	filter, err := parseEscrowFilter(params)
+	if err == nil {
+		err = scopeEscrowFilter(r, &filter)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	page, err := parsePage(params, decodeSearchCursor)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Request one extra result to know whether there is a next page.
+	limit := page.Limit
+	page.Limit++
+	results, err := h.store.SearchEscrows(r.Context(), query, filter, page)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := EscrowSearchPage{Results: make([]EscrowSearchResult, 0, len(results))}
+	if len(results) > limit {
+		results = results[:limit]
+		resp.NextCursor = encodeSearchCursor(results[len(results)-1].SearchCursor())
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, EscrowSearchResult{Escrow: newEscrow(&result.EscrowWithCursor), Rank: result.Rank})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func encodeSearchCursor(cursor store.SearchCursor) string {
+	rank := strconv.FormatFloat(float64(cursor.Rank), 'g', -1, 32)
+	return base64.RawURLEncoding.EncodeToString([]byte(rank + ":" + strconv.FormatInt(cursor.EscrowID, 10)))
+}
+
+func decodeSearchCursor(cursor string) (store.SearchCursor, error) {
+	invalid := &badRequestError{fmt.Sprintf("invalid cursor %q", cursor)}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return store.SearchCursor{}, invalid
+	}
+	rank, id, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return store.SearchCursor{}, invalid
+	}
+	parsedRank, err := strconv.ParseFloat(rank, 32)
+	if err != nil {
+		return store.SearchCursor{}, invalid
+	}
+	parsedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return store.SearchCursor{}, invalid
+	}
+	return store.SearchCursor{Rank: float32(parsedRank), EscrowID: parsedID}, nil
+}
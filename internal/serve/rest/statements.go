@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/statement"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// getStatement exports the statement of the escrows of an engagement or a platform, as JSON,
+// CSV or PDF.
+func (h *Handler) getStatement(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	req := statement.Request{Filter: store.EscrowFilter{
+		EngagementID: params.Get("engagementId"),
+		Roles:        entities.EscrowRoles{PlatformAddress: params.Get("platformAddress")},
+	}}
+	from, to, err := statement.ParsePeriod(params.Get("from"), params.Get("to"))
+	if err != nil {
+		writeError(w, r, &badRequestError{err.Error()})
+		return
+	}
+	req.From, req.To = from, to
+	if err := req.Validate(); err != nil {
+		writeError(w, r, &badRequestError{err.Error()})
+		return
+	}
+	format := strings.ToLower(params.Get("format"))
+	switch format {
+	case "":
+		format = "json"
+	case "json", "csv", "pdf":
+	default:
+		writeError(w, r, &badRequestError{fmt.Sprintf("invalid format %q", format)})
+		return
+	}
+	if err := scopeEscrowFilter(r, &req.Filter); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	result, err := statement.Build(r.Context(), h.store, req)
+	if errors.Is(err, statement.ErrTooManyEscrows) {
+		err = &badRequestError{err.Error()}
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	filename := "statement-" + result.GeneratedAt.Format("20060102T150405Z") + "." + format
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		err = statement.WriteCSV(w, result)
+	case "pdf":
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		err = statement.WritePDF(w, result)
+	default:
+		writeJSON(w, http.StatusOK, result)
+	}
+	if err != nil {
+		// The status is already sent, the client sees a truncated body.
+		log.Ctx(r.Context()).Errorf("Writing statement: %v", err)
+	}
+}
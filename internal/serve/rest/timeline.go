@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/Trustless-Work/Indexer/internal/timeline"
+)
+
+// TimelineEntry is a transaction, operation or state change of an account, with a summary
+// of what happened to the account. Only the field matching Type is set.
+type TimelineEntry struct {
+	Type            store.TimelineEntryType `json:"type"`
+	Cursor          string                  `json:"cursor"`
+	LedgerNumber    uint32                  `json:"ledgerNumber"`
+	LedgerCreatedAt time.Time               `json:"ledgerCreatedAt"`
+	TxHash          string                  `json:"txHash"`
+	Summary         string                  `json:"summary"`
+	Transaction     *TimelineTransaction    `json:"transaction,omitempty"`
+	Operation       *TimelineOperation      `json:"operation,omitempty"`
+	StateChange     *event.StateChangeV1    `json:"stateChange,omitempty"`
+}
+
+// TimelineTransaction is the JSON representation of a transaction in a timeline.
+type TimelineTransaction struct {
+	Hash      string `json:"hash"`
+	ToID      int64  `json:"toId"`
+	ResultXDR string `json:"resultXdr"`
+}
+
+// TimelineOperation is the JSON representation of an operation in a timeline.
+type TimelineOperation struct {
+	ID            int64  `json:"id"`
+	OperationType string `json:"operationType"`
+	OperationXDR  string `json:"operationXdr"`
+}
+
+// TimelinePage is a page of a timeline. NextCursor is omitted on the last page.
+type TimelinePage struct {
+	Entries    []TimelineEntry `json:"entries"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+func (h *Handler) getAccountTimeline(w http.ResponseWriter, r *http.Request) {
+	address, err := h.authorizedAccount(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	page, err := parsePage(r.URL.Query(), decodeTimelineCursor)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Request one extra entry to know whether there is a next page.
+	limit := page.Limit
+	page.Limit++
+	entries, err := h.store.GetAccountTimeline(r.Context(), address, page)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := TimelinePage{Entries: make([]TimelineEntry, 0, len(entries))}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		resp.NextCursor = encodeTimelineCursor(entries[len(entries)-1].Cursor)
+	}
+
+	// The counterparty of a balance change is found in the other state changes of its operation.
+	var operationIDs []int64
+	for _, entry := range entries {
+		if sc := entry.StateChange; sc != nil && sc.StateChangeCategory == types.StateChangeCategoryBalance && sc.OperationID != 0 {
+			operationIDs = append(operationIDs, sc.OperationID)
+		}
+	}
+	related, err := h.store.GetStateChangesByOperationIDs(r.Context(), operationIDs)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, newTimelineEntry(address, entry, related))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func newTimelineEntry(address string, entry *store.TimelineEntry, related []types.StateChange) TimelineEntry {
+	e := TimelineEntry{
+		Type:    entry.Type,
+		Cursor:  encodeTimelineCursor(entry.Cursor),
+		Summary: timeline.Summarize(address, entry, related),
+	}
+	switch {
+	case entry.Transaction != nil:
+		tx := entry.Transaction
+		e.LedgerNumber, e.LedgerCreatedAt, e.TxHash = tx.LedgerNumber, tx.LedgerCreatedAt, tx.Hash
+		e.Transaction = &TimelineTransaction{Hash: tx.Hash, ToID: tx.ToID, ResultXDR: tx.ResultXDR}
+	case entry.Operation != nil:
+		op := entry.Operation
+		e.LedgerNumber, e.LedgerCreatedAt, e.TxHash = op.LedgerNumber, op.LedgerCreatedAt, op.TxHash
+		e.Operation = &TimelineOperation{ID: op.ID, OperationType: string(op.OperationType), OperationXDR: op.OperationXDR}
+	case entry.StateChange != nil:
+		sc := entry.StateChange
+		e.LedgerNumber, e.LedgerCreatedAt, e.TxHash = sc.LedgerNumber, sc.LedgerCreatedAt, sc.TxHash
+		data := event.NewStateChangeV1(*sc)
+		e.StateChange = &data
+	}
+	return e
+}
+
+func encodeTimelineCursor(cursor store.TimelineCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d:%d", cursor.ToID, cursor.Rank, cursor.Order)))
+}
+
+func decodeTimelineCursor(cursor string) (store.TimelineCursor, error) {
+	invalid := &badRequestError{fmt.Sprintf("invalid cursor %q", cursor)}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return store.TimelineCursor{}, invalid
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 3 {
+		return store.TimelineCursor{}, invalid
+	}
+	var parsed store.TimelineCursor
+	if parsed.ToID, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return store.TimelineCursor{}, invalid
+	}
+	if parsed.Rank, err = strconv.Atoi(parts[1]); err != nil {
+		return store.TimelineCursor{}, invalid
+	}
+	if parsed.Order, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+		return store.TimelineCursor{}, invalid
+	}
+	return parsed, nil
+}
@@ -0,0 +1,32 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/Trustless-Work/Indexer/internal/txdecode"
+)
+
+// getDecodedTransaction decodes the stored envelope, result and meta of a transaction.
+func (h *Handler) getDecodedTransaction(w http.ResponseWriter, r *http.Request) {
+	hash := r.PathValue("hash")
+	tx, err := h.store.GetTransactionByHash(r.Context(), hash)
+	if err == nil {
+		err = h.authorizeTransaction(r, hash)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	decoded, err := txdecode.Decode(tx, h.networkPassphrase)
+	if errors.Is(err, txdecode.ErrNoEnvelope) {
+		err = fmt.Errorf("envelope of transaction %s: %w", hash, store.ErrNotFound)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, decoded)
+}
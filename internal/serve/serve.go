@@ -0,0 +1,211 @@
+// Package serve runs the HTTP server exposing the indexed data through the GraphQL and REST
+// APIs. The data is read from Postgres, or ingested into memory in demo mode.
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/ingest"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/generated"
+	"github.com/Trustless-Work/Indexer/internal/serve/graphql/resolvers"
+	"github.com/Trustless-Work/Indexer/internal/serve/rest"
+	"github.com/Trustless-Work/Indexer/internal/services"
+	memsink "github.com/Trustless-Work/Indexer/internal/sink/memory"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	memstore "github.com/Trustless-Work/Indexer/internal/store/memory"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	defaultListenAddress = ":8000"
+	// maxQueryComplexity bounds nested connections, e.g. accounts -> transactions -> state changes.
+	maxQueryComplexity = 1000
+	shutdownTimeout    = 10 * time.Second
+	rpcTimeout         = 30 * time.Second
+	demoLedgersLimit   = 100
+)
+
+// Storage backends the APIs are served from.
+const (
+	// StoragePostgres reads the data written by the postgres sink to DatabaseURL.
+	StoragePostgres = "postgres"
+	// StorageMemory ingests the network from its tip into memory and serves it, without a
+	// database. Data is lost on exit and balances aren't available.
+	StorageMemory = "memory"
+)
+
+type Config struct {
+	// ListenAddress is the address of the HTTP server. Defaults to ":8000".
+	ListenAddress string
+	// Storage is StoragePostgres (default) or StorageMemory.
+	Storage string
+	// DatabaseURL is the connection string of the database written by the postgres sink.
+	DatabaseURL string
+	// EnablePlayground serves the GraphQL playground on /graphql/playground.
+	EnablePlayground bool
+	// RPCURL and NetworkPassphrase identify the RPC server balances are reconciled against.
+	// NetworkPassphrase is also the network of the decoded transactions: a database holds a
+	// single network.
+	RPCURL            string
+	NetworkPassphrase string
+	// RequireAPIKey authenticates the requests with the API keys stored in the database,
+	// except on the public endpoints of AuthConfig. Requires StoragePostgres.
+	RequireAPIKey bool
+	AuthConfig    auth.Config
+}
+
+// Serve runs the HTTP server until the process receives SIGINT or SIGTERM.
+func Serve(cfg Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if cfg.ListenAddress == "" {
+		cfg.ListenAddress = defaultListenAddress
+	}
+
+	rpcService, err := services.NewRPCService(cfg.RPCURL, cfg.NetworkPassphrase, &http.Client{Timeout: rpcTimeout})
+	if err != nil {
+		return fmt.Errorf("creating RPC service: %w", err)
+	}
+
+	var reader store.Reader
+	// ingestErr receives the error that stopped the demo ingestion. It stays nil, and never
+	// ready, with the postgres storage.
+	var ingestErr <-chan error
+	var balanceService services.BalanceService
+	var keyStore auth.KeyStore
+	switch cfg.Storage {
+	case "", StoragePostgres:
+		if cfg.DatabaseURL == "" {
+			return errors.New("database URL is required")
+		}
+		pg, err := pgstore.Open(ctx, cfg.DatabaseURL)
+		if err != nil {
+			return err
+		}
+		defer pg.Close()
+		if balanceService, err = services.NewBalanceService(pg, rpcService); err != nil {
+			return fmt.Errorf("creating balance service: %w", err)
+		}
+		reader = pg
+		keyStore = pg
+	case StorageMemory:
+		mem := memstore.New()
+		if ingestErr, err = ingestIntoMemory(ctx, cfg, rpcService, mem); err != nil {
+			return err
+		}
+		reader = mem
+	default:
+		return fmt.Errorf("unknown storage %q", cfg.Storage)
+	}
+
+	handler := newHandler(cfg, reader, balanceService)
+	if cfg.RequireAPIKey {
+		if keyStore == nil {
+			return errors.New("API keys require the postgres storage")
+		}
+		authenticator, err := auth.NewAuthenticator(keyStore, cfg.AuthConfig)
+		if err != nil {
+			return fmt.Errorf("creating authenticator: %w", err)
+		}
+		defer authenticator.Close()
+		handler = authenticator.Middleware(handler)
+	}
+
+	server := &http.Server{
+		Addr:              cfg.ListenAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Ctx(ctx).Infof("Serving on %s", cfg.ListenAddress)
+		errCh <- server.ListenAndServe()
+	}()
+
+	var stopErr error
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serving on %s: %w", cfg.ListenAddress, err)
+	case err := <-ingestErr:
+		stopErr = fmt.Errorf("ingesting into memory: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Ctx(ctx).Info("Shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutting down server: %w", err)
+	}
+	return stopErr
+}
+
+// ingestIntoMemory ingests the network from its latest ledger into the store, in the
+// background until ctx is cancelled. As with the ingest command, ingestion failures terminate the process, and the
+// returned channel receives the setup errors of the ingestion, which shut the server down.
+func ingestIntoMemory(ctx context.Context, cfg Config, rpcService services.RPCService, mem *memstore.Store) (<-chan error, error) {
+	health, err := rpcService.GetHealth()
+	if err != nil {
+		return nil, fmt.Errorf("getting latest ledger: %w", err)
+	}
+	memorySink, err := memsink.New(mem)
+	if err != nil {
+		return nil, fmt.Errorf("creating memory sink: %w", err)
+	}
+
+	log.Ctx(ctx).Infof("Demo mode: ingesting into memory from ledger %d", health.LatestLedger)
+	errCh := make(chan error, 1)
+	go func() {
+		err := ingest.Ingest(ctx, ingest.Config{
+			StartLedger:       health.LatestLedger,
+			RPCURL:            cfg.RPCURL,
+			NetworkPassphrase: cfg.NetworkPassphrase,
+			GetLedgersLimit:   demoLedgersLimit,
+			LedgerBackendType: ingest.LedgerBackendTypeRPC,
+			Sink:              memorySink,
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
+	return errCh, nil
+}
+
+func newHandler(cfg Config, reader store.Reader, balances services.BalanceService) http.Handler {
+	mux := http.NewServeMux()
+	// GraphQL queries can't be restricted to the platforms of a scoped API key.
+	mux.Handle("/graphql", auth.RequireUnscoped(newGraphQLHandler(reader)))
+	mux.Handle("/v1/", rest.NewHandler(reader, balances, cfg.NetworkPassphrase))
+	if cfg.EnablePlayground {
+		mux.Handle("/graphql/playground", playground.Handler("Trustless Work Indexer", "/graphql"))
+	}
+	return mux
+}
+
+func newGraphQLHandler(reader store.Reader) http.Handler {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers: resolvers.NewResolver(reader),
+	}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxQueryComplexity))
+	return srv
+}
@@ -0,0 +1,37 @@
+package serve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/auth"
+	"github.com/Trustless-Work/Indexer/internal/store/memory"
+)
+
+func TestGraphQLRequiresUnscopedKey(t *testing.T) {
+	handler := newHandler(Config{}, memory.New(), nil)
+	for _, tc := range []struct {
+		name       string
+		principal  *auth.Principal
+		wantStatus int
+	}{
+		{"authentication disabled", nil, http.StatusOK},
+		{"unscoped key", &auth.Principal{KeyID: "unscoped"}, http.StatusOK},
+		{"scoped key", &auth.Principal{KeyID: "scoped", PlatformAddresses: []string{"GPLATFORM"}}, http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ __typename }"}`))
+			r.Header.Set("Content-Type", "application/json")
+			if tc.principal != nil {
+				r = r.WithContext(auth.NewContext(r.Context(), tc.principal))
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+		})
+	}
+}
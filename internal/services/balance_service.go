@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	pgstore "github.com/Trustless-Work/Indexer/internal/store/postgres"
+	"github.com/Trustless-Work/Indexer/internal/utils"
+	"github.com/stellar/go-stellar-sdk/amount"
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+var ErrUnsupportedBalanceEntry = errors.New("unsupported balance ledger entry")
+
+// BalanceService serves the token balances derived from the indexed balance changes, and
+// reconciles them with the ledger entries of the network.
+type BalanceService interface {
+	// GetBalances returns the current balances of an account.
+	GetBalances(ctx context.Context, accountID string) ([]*store.Balance, error)
+	// GetBalance returns the balance of a token held by an account, at the end of atLedger
+	// or currently if atLedger is 0.
+	GetBalance(ctx context.Context, accountID, tokenID string, atLedger uint32) (*store.Balance, error)
+	// Reconcile reads the balance from the ledger entries of the network and resets the
+	// indexed balance to it.
+	Reconcile(ctx context.Context, accountID, tokenID string) (*Reconciliation, error)
+}
+
+// Reconciliation is the result of comparing an indexed balance with the ledger.
+type Reconciliation struct {
+	// IndexedAmount is the balance before the reconciliation, "0" if it wasn't indexed.
+	IndexedAmount string
+	// OnChainAmount is the balance read from the ledger entries at Ledger.
+	OnChainAmount string
+	Ledger        uint32
+	// Balance is the indexed balance after the reconciliation.
+	Balance *store.Balance
+}
+
+// Matched reports whether the indexed balance was the on-chain one.
+func (r *Reconciliation) Matched() bool {
+	return r.IndexedAmount == r.OnChainAmount
+}
+
+type balanceService struct {
+	store       *pgstore.Store
+	rpcService  RPCService
+	nativeToken string
+}
+
+var _ BalanceService = (*balanceService)(nil)
+
+func NewBalanceService(store *pgstore.Store, rpcService RPCService) (*balanceService, error) {
+	if store == nil {
+		return nil, errors.New("store is required")
+	}
+	if rpcService == nil {
+		return nil, errors.New("rpcService is required")
+	}
+
+	nativeContractID, err := xdr.MustNewNativeAsset().ContractID(rpcService.NetworkPassphrase())
+	if err != nil {
+		return nil, fmt.Errorf("computing native asset contract ID: %w", err)
+	}
+	nativeToken, err := strkey.Encode(strkey.VersionByteContract, nativeContractID[:])
+	if err != nil {
+		return nil, fmt.Errorf("encoding native asset contract ID: %w", err)
+	}
+
+	return &balanceService{store: store, rpcService: rpcService, nativeToken: nativeToken}, nil
+}
+
+func (s *balanceService) GetBalances(ctx context.Context, accountID string) ([]*store.Balance, error) {
+	return s.store.GetBalances(ctx, accountID)
+}
+
+func (s *balanceService) GetBalance(ctx context.Context, accountID, tokenID string, atLedger uint32) (*store.Balance, error) {
+	return s.store.GetBalance(ctx, accountID, tokenID, atLedger)
+}
+
+func (s *balanceService) Reconcile(ctx context.Context, accountID, tokenID string) (*Reconciliation, error) {
+	indexedAmount := "0"
+	indexed, err := s.store.GetBalance(ctx, accountID, tokenID, 0)
+	switch {
+	case err == nil:
+		indexedAmount = indexed.Amount
+	case !errors.Is(err, store.ErrNotFound):
+		return nil, err
+	}
+
+	onChainAmount, ledger, err := s.onChainBalance(ctx, accountID, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.store.SetReconciledBalance(ctx, accountID, tokenID, onChainAmount, ledger)
+	if err != nil {
+		return nil, err
+	}
+	return &Reconciliation{
+		IndexedAmount: indexedAmount,
+		OnChainAmount: onChainAmount,
+		Ledger:        ledger,
+		Balance:       balance,
+	}, nil
+}
+
+// onChainBalance reads the balance of a token from the ledger entry holding it: the account
+// entry for XLM, the trustline for the classic assets wrapped by a SAC, and the contract data
+// of the token otherwise (contract holders, custom tokens).
+func (s *balanceService) onChainBalance(ctx context.Context, accountID, tokenID string) (string, uint32, error) {
+	key, err := s.balanceLedgerKey(ctx, accountID, tokenID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	result, err := s.rpcService.GetLedgerEntries([]string{key})
+	if err != nil {
+		return "", 0, fmt.Errorf("getting balance entry of %s for %s: %w", accountID, tokenID, err)
+	}
+	if len(result.Entries) == 0 {
+		return "0", result.LatestLedger, nil
+	}
+
+	var data xdr.LedgerEntryData
+	if err := xdr.SafeUnmarshalBase64(result.Entries[0].DataXDR, &data); err != nil {
+		return "", 0, fmt.Errorf("decoding balance entry of %s for %s: %w", accountID, tokenID, err)
+	}
+	balance, err := balanceFromLedgerEntry(data)
+	if err != nil {
+		return "", 0, fmt.Errorf("reading balance entry of %s for %s: %w", accountID, tokenID, err)
+	}
+	return balance, result.LatestLedger, nil
+}
+
+func (s *balanceService) balanceLedgerKey(ctx context.Context, accountID, tokenID string) (string, error) {
+	if utils.IsContractAddress(accountID) {
+		return utils.GetContractDataEntryLedgerKey(accountID, tokenID)
+	}
+	if tokenID == s.nativeToken {
+		return utils.GetAccountLedgerKey(accountID)
+	}
+
+	assets, err := s.store.GetTrustlineAssets(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	for _, asset := range assets {
+		code, issuer, found := strings.Cut(asset, ":")
+		if !found {
+			continue
+		}
+		creditAsset, err := xdr.NewCreditAsset(code, issuer)
+		if err != nil {
+			continue
+		}
+		contractID, err := creditAsset.ContractID(s.rpcService.NetworkPassphrase())
+		if err != nil {
+			return "", fmt.Errorf("computing contract ID of %s: %w", asset, err)
+		}
+		if strkey.MustEncode(strkey.VersionByteContract, contractID[:]) == tokenID {
+			return utils.GetTrustlineLedgerKey(accountID, code, issuer)
+		}
+	}
+	return utils.GetContractDataEntryLedgerKey(accountID, tokenID)
+}
+
+// balanceFromLedgerEntry returns the balance, in the smallest unit of the token, held by an
+// account, trustline or contract data balance entry.
+func balanceFromLedgerEntry(data xdr.LedgerEntryData) (string, error) {
+	switch data.Type {
+	case xdr.LedgerEntryTypeAccount:
+		return amount.String64Raw(data.MustAccount().Balance), nil
+	case xdr.LedgerEntryTypeTrustline:
+		return amount.String64Raw(data.MustTrustLine().Balance), nil
+	case xdr.LedgerEntryTypeContractData:
+		return balanceFromScVal(data.MustContractData().Val)
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedBalanceEntry, data.Type)
+	}
+}
+
+// balanceFromScVal reads SAC balances, a map with an "amount" entry, and the plain i128
+// balances of most custom tokens.
+func balanceFromScVal(val xdr.ScVal) (string, error) {
+	switch val.Type {
+	case xdr.ScValTypeScvI128:
+		return amount.String128Raw(val.MustI128()), nil
+	case xdr.ScValTypeScvMap:
+		if entries, ok := val.GetMap(); ok && entries != nil {
+			for _, entry := range *entries {
+				if sym, ok := entry.Key.GetSym(); ok && sym == "amount" {
+					return balanceFromScVal(entry.Val)
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: contract data %s", ErrUnsupportedBalanceEntry, val.Type)
+}
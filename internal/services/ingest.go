@@ -5,9 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/logging"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/sink/noop"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
 	"github.com/Trustless-Work/Indexer/internal/utils"
 	"github.com/alitto/pond/v2"
 	"github.com/stellar/go-stellar-sdk/historyarchive"
@@ -22,10 +31,20 @@ const (
 	maxLedgerFetchRetries = 10
 	// maxRetryBackoff is the maximum backoff duration between retry attempts.
 	maxRetryBackoff = 30 * time.Second
+	// defaultCatchupThreshold is the lag, in ledgers, above which live ingestion is catching up.
+	defaultCatchupThreshold = 100
+	// ingestStallTimeout is how long ingestion may go without completing a ledger before it is
+	// reported as not live.
+	ingestStallTimeout = 5 * time.Minute
+	// sinkPingTimeout bounds the ping of the sink when reporting its health.
+	sinkPingTimeout = 2 * time.Second
 	// IngestionModeLive represents continuous ingestion from the latest ledger onwards.
 	IngestionModeLive = "live"
 	// IngestionModeBackfill represents historical ledger ingestion for a specified range.
 	IngestionModeBackfill = "backfill"
+	// IngestionModeCatchup represents live ingestion running more than the catch-up threshold
+	// behind the tip of the network.
+	IngestionModeCatchup = "catchup"
 )
 
 // LedgerBackendFactory creates new LedgerBackend instances for parallel batch processing.
@@ -39,6 +58,7 @@ type IngestServiceConfig struct {
 	//Models        *data.Models
 
 	// === Stellar Network ===
+	// Network names the network in the entities, metrics and status, e.g. "testnet".
 	Network           string
 	NetworkPassphrase string
 	Archive           historyarchive.ArchiveInterface
@@ -63,10 +83,108 @@ type IngestServiceConfig struct {
 	BackfillBatchSize         int
 	BackfillDBInsertBatchSize int
 	CatchupThreshold          int
+
+	// === Output ===
+	// Sink receives the buffer of every processed ledger. If nil, a NoopSink is used. The
+	// caller owns the sink and closes it once Run returns.
+	Sink sink.Sink
+	// SinkType names the sink in the status of the ingestion.
+	SinkType string
+	// LedgerObserver, when set, is handed the buffer of every ledger written to the sink.
+	LedgerObserver LedgerObserver
+	// LedgerStats, when set, persists the stats of every ledger written to the sink.
+	LedgerStats LedgerStatsWriter
+}
+
+// LedgerObserver is notified of the ledgers written to the sink, e.g. to evaluate alerting
+// rules. It is called on the ingestion loop and must not block.
+type LedgerObserver interface {
+	ObserveLedger(ctx context.Context, network string, ledgerSeq uint32, buffer *indexer.IndexerBuffer)
 }
 
 type IngestService interface {
+	// Prepare prepares the ledger backend for a live run from startLedger, or from the sink
+	// cursor, ahead of Run, e.g. while the replica stands by for the leadership.
+	Prepare(ctx context.Context, startLedger uint32) error
 	Run(ctx context.Context, startLedger uint32, endLedger uint32) error
+	// Status returns a snapshot of the ingestion.
+	Status(ctx context.Context) IngestStatus
+
+	// The controls below act on a running ingestion between two ledgers, never on the ledger
+	// in flight.
+
+	// Pause stops the ingestion after the ledger in flight, and Resume resumes it.
+	Pause(ctx context.Context) error
+	Resume()
+	// Rewind makes live ingestion continue from an earlier ledger.
+	Rewind(ledger uint32) error
+	// Backfill queues the ingestion of a range of ledgers, after which live ingestion resumes.
+	Backfill(ledgers LedgerRange) error
+	// Drain pauses the ingestion and flushes the sink.
+	Drain(ctx context.Context) error
+}
+
+// IngestStatus is a snapshot of the ingestion, as reported by the health and status endpoints.
+type IngestStatus struct {
+	Network string `json:"network"`
+	// Mode is live, catchup or backfill.
+	Mode    string `json:"mode"`
+	Running bool   `json:"running"`
+	Paused  bool   `json:"paused"`
+	// Backfill is the range being backfilled, and PendingBackfills the ranges queued after it.
+	Backfill         *LedgerRange  `json:"backfill,omitempty"`
+	PendingBackfills []LedgerRange `json:"pendingBackfills,omitempty"`
+	// CurrentLedger is the last ledger written to the sink.
+	CurrentLedger uint32 `json:"currentLedger"`
+	// NetworkTip is the latest ledger of the RPC server, as of its last healthy response.
+	NetworkTip      uint32        `json:"networkTip"`
+	Lag             uint32        `json:"lag"`
+	StartedAt       time.Time     `json:"startedAt,omitzero"`
+	LastLedgerAt    time.Time     `json:"lastLedgerAt,omitzero"`
+	RPCHealthy      bool          `json:"rpcHealthy"`
+	LastHeartbeatAt time.Time     `json:"lastHeartbeatAt,omitzero"`
+	Sinks           []sink.Health `json:"sinks"`
+	LastError       string        `json:"lastError,omitempty"`
+	LastErrorAt     time.Time     `json:"lastErrorAt,omitzero"`
+}
+
+// LivenessError returns why the ingestion is not live at now: it stopped, or it has not
+// completed a ledger for too long. It returns nil while ingestion makes progress or is paused.
+func (s IngestStatus) LivenessError(now time.Time) error {
+	if !s.Running {
+		return errors.New("ingestion is not running")
+	}
+	if s.Paused {
+		return nil
+	}
+	lastProgress := s.StartedAt
+	if s.LastLedgerAt.After(lastProgress) {
+		lastProgress = s.LastLedgerAt
+	}
+	if stalled := now.Sub(lastProgress); stalled > ingestStallTimeout {
+		return fmt.Errorf("no ledger ingested for %v", stalled.Truncate(time.Second))
+	}
+	return nil
+}
+
+// ReadinessError returns why the ingestion is not ready: it has not ingested a ledger yet, the
+// RPC server is unhealthy or a required sink is degraded. It returns nil when ready.
+func (s IngestStatus) ReadinessError() error {
+	if !s.Running {
+		return errors.New("ingestion is not running")
+	}
+	if s.CurrentLedger == 0 {
+		return errors.New("no ledger ingested yet")
+	}
+	if !s.RPCHealthy {
+		return errors.New("RPC server is unhealthy")
+	}
+	for _, h := range s.Sinks {
+		if h.Required && h.State == sink.StateDegraded {
+			return fmt.Errorf("sink %q is degraded", h.Name)
+		}
+	}
+	return nil
 }
 
 var _ IngestService = (*ingestService)(nil)
@@ -75,73 +193,375 @@ type ingestService struct {
 	rpcService           RPCService
 	ledgerBackend        ledgerbackend.LedgerBackend
 	ledgerBackendFactory LedgerBackendFactory
+	network              string
 	networkPassphrase    string
 	getLedgersLimit      int
 	ledgerIndexer        *indexer.Indexer
+	sink                 sink.Sink
+	sinkType             string
+	ledgerObserver       LedgerObserver
+	ledgerStatsWriter    LedgerStatsWriter
+	catchupThreshold     uint32
+	// ledgerStats records the stats of the ledgers while Run runs, when ledgerStatsWriter is set.
+	ledgerStats *ledgerStatsRecorder
+	// backendPrepared is set once the ledger backend is prepared, by Prepare or Run.
+	backendPrepared bool
+	// latestLedger and networkTip feed the ledger lag metric and the status.
+	latestLedger atomic.Uint32
+	networkTip   atomic.Uint32
+
+	// mu guards the fields below, the rest of the status.
+	mu              sync.Mutex
+	running         bool
+	endLedger       uint32
+	startedAt       time.Time
+	lastLedgerAt    time.Time
+	lastHeartbeatAt time.Time
+	lastErr         error
+	lastErrAt       time.Time
+
+	// Controls, applied by the ingestion loop between two ledgers (see ingest_control.go).
+	paused           bool
+	resumed          chan struct{}
+	parked           chan struct{}
+	rewindTo         uint32
+	pendingBackfills []LedgerRange
+	backfill         *activeBackfill
 }
 
 func NewIngestService(cfg IngestServiceConfig) (*ingestService, error) {
 	// Create worker pool for the ledger indexer (parallel transaction processing within a ledger)
 	ledgerIndexerPool := pond.NewPool(0)
+	metrics.RegisterPool("ledger_indexer_"+cfg.Network, ledgerIndexerPool)
+
+	s := cfg.Sink
+	if s == nil {
+		s = &noop.NoopSink{}
+	}
+
+	catchupThreshold := cfg.CatchupThreshold
+	if catchupThreshold <= 0 {
+		catchupThreshold = defaultCatchupThreshold
+	}
 
 	return &ingestService{
 		rpcService:           cfg.RPCService,
 		ledgerBackend:        cfg.LedgerBackend,
 		ledgerBackendFactory: cfg.LedgerBackendFactory,
+		network:              cfg.Network,
 		networkPassphrase:    cfg.NetworkPassphrase,
 		getLedgersLimit:      cfg.GetLedgersLimit,
-		ledgerIndexer:        indexer.NewIndexer(cfg.NetworkPassphrase, ledgerIndexerPool, cfg.SkipTxMeta, cfg.SkipTxEnvelope),
+		ledgerIndexer:        indexer.NewIndexer(cfg.Network, cfg.NetworkPassphrase, ledgerIndexerPool, cfg.SkipTxMeta, cfg.SkipTxEnvelope),
+		sink:                 s,
+		sinkType:             cfg.SinkType,
+		ledgerObserver:       cfg.LedgerObserver,
+		ledgerStatsWriter:    cfg.LedgerStats,
+		catchupThreshold:     uint32(catchupThreshold),
 	}, nil
 }
 
 func (m *ingestService) Run(ctx context.Context, startLedger uint32, endLedger uint32) error {
+	ctx = logging.WithFields(ctx, log.F{logging.FieldNetwork: m.network})
+
+	m.mu.Lock()
+	m.running = true
+	m.endLedger = endLedger
+	m.startedAt = time.Now()
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.running = false
+		m.mu.Unlock()
+	}()
+
+	if m.ledgerStatsWriter != nil {
+		m.ledgerStats = newLedgerStatsRecorder(m.ledgerStatsWriter)
+		defer m.ledgerStats.close()
+	}
+
+	// Unbounded runs resume from the sink cursor. Bounded runs ingest their whole range, the
+	// ledgers the cursor already holds as backfills so that it doesn't move back.
+	var sinkCursor uint32
+	var err error
+	if endLedger == 0 {
+		startLedger, err = m.resumeFromSinkCursor(ctx, startLedger)
+		if err != nil {
+			return fmt.Errorf("resuming from sink cursor: %w", err)
+		}
+	} else {
+		sinkCursor, err = m.sinkCursor(ctx)
+		if err != nil {
+			return fmt.Errorf("getting latest ledger from sink: %w", err)
+		}
+		if sinkCursor >= startLedger {
+			log.Ctx(ctx).Infof("Sink cursor is at ledger %d, ingesting ledgers %d to %d as a backfill",
+				sinkCursor, startLedger, min(sinkCursor, endLedger))
+		}
+	}
 
-	// Prepare backend range
-	err := m.prepareBackendRange(ctx, startLedger, endLedger)
+	err = m.prepareBackendRange(ctx, startLedger, endLedger)
 	if err != nil {
 		return fmt.Errorf("preparing backend range: %w", err)
 	}
 
+	if m.rpcService != nil {
+		healthCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go m.rpcService.TrackRPCServiceHealth(healthCtx)
+		go m.trackNetworkTip(healthCtx)
+	}
+
 	currentLedger := startLedger
 	log.Ctx(ctx).Infof("Starting ingestion loop from ledger: %d", currentLedger)
 	for endLedger == 0 || currentLedger < endLedger {
-		ledgerMeta, ledgerErr := m.ledgerBackend.GetLedger(ctx, currentLedger)
+		if ctx.Err() != nil {
+			return fmt.Errorf("ingestion stopped before ledger %d: %w", currentLedger, context.Cause(ctx))
+		}
+		if endLedger == 0 {
+			next, err := m.applyControls(ctx, currentLedger)
+			if err != nil {
+				return fmt.Errorf("applying controls before ledger %d: %w", currentLedger, err)
+			}
+			currentLedger = next
+		}
+		ledgerCtx := logging.WithFields(ctx, log.F{logging.FieldLedger: currentLedger})
+		// Backfilled ledgers are older than the cursor of the sink and than the ledgers
+		// ingested live, which they must not move back.
+		backfilling := currentLedger <= sinkCursor || m.backfilling(currentLedger)
+		if backfilling {
+			ledgerCtx = sink.WithBackfill(ledgerCtx)
+		}
+		ledgerCtx, span := tracing.Start(ledgerCtx, "IngestLedger",
+			tracing.AttrNetwork.String(m.network),
+			tracing.AttrLedgerSequence.Int64(int64(currentLedger)),
+		)
+		fetchStart := time.Now()
+		ledgerMeta, ledgerErr := m.fetchLedger(ledgerCtx, currentLedger)
 		if ledgerErr != nil {
+			tracing.End(span, ledgerErr)
 			if endLedger > 0 && currentLedger > endLedger {
 				log.Ctx(ctx).Infof("Backfill complete: processed ledgers %d to %d", startLedger, endLedger)
 				return nil
 			}
+			m.recordError(fmt.Errorf("fetching ledger %d: %w", currentLedger, ledgerErr))
 			log.Ctx(ctx).Warnf("Error fetching ledger %d: %v, retrying...", currentLedger, ledgerErr)
 			time.Sleep(time.Second)
 			continue
 		}
 
 		totalStart := time.Now()
-		if processErr := m.processLedger(ctx, ledgerMeta); processErr != nil {
-			return fmt.Errorf("processing ledger %d: %w", currentLedger, processErr)
+		processErr := m.processLedger(ledgerCtx, ledgerMeta, totalStart.Sub(fetchStart))
+		tracing.End(span, processErr)
+		if processErr != nil {
+			err := fmt.Errorf("processing ledger %d: %w", currentLedger, processErr)
+			m.recordError(err)
+			return err
 		}
 
 		log.Ctx(ctx).Infof("Processed ledger %d in %v", currentLedger, time.Since(totalStart))
+		if !backfilling {
+			m.ledgerIngested(currentLedger)
+		}
 		currentLedger++
 
 	}
 	return nil
 }
 
-// prepareBackendRange prepares the ledger backend with the appropriate range type.
-// Returns the operating mode (livestreaming vs backfill).
+// fetchLedger reads a ledger from the ledger backend.
+func (m *ingestService) fetchLedger(ctx context.Context, ledgerSeq uint32) (xdr.LedgerCloseMeta, error) {
+	ctx, span := tracing.Start(ctx, "GetLedger", tracing.AttrLedgerSequence.Int64(int64(ledgerSeq)))
+	fetchStart := time.Now()
+	ledgerMeta, err := m.ledgerBackend.GetLedger(ctx, ledgerSeq)
+	tracing.End(span, err)
+	if err != nil {
+		return xdr.LedgerCloseMeta{}, err
+	}
+	metrics.ObservePhase(m.network, metrics.PhaseFetch, fetchStart)
+	return ledgerMeta, nil
+}
+
+// ledgerIngested updates the ingestion metrics and status once a ledger reached the sink.
+func (m *ingestService) ledgerIngested(ledgerSeq uint32) {
+	m.mu.Lock()
+	m.lastLedgerAt = time.Now()
+	m.mu.Unlock()
+	m.latestLedger.Store(ledgerSeq)
+	metrics.LedgersIngested.WithLabelValues(m.network).Inc()
+	metrics.LatestIngestedLedger.WithLabelValues(m.network).Set(float64(ledgerSeq))
+	if tip := m.networkTip.Load(); tip > 0 {
+		metrics.LedgerLag.WithLabelValues(m.network).Set(float64(max(int64(tip)-int64(ledgerSeq), 0)))
+	}
+}
+
+// trackNetworkTip follows the heartbeats of the RPC health monitor until ctx is cancelled, for
+// the ledger lag metric and the status.
+func (m *ingestService) trackNetworkTip(ctx context.Context) {
+	heartbeats := m.rpcService.GetHeartbeatChannel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case health := <-heartbeats:
+			m.mu.Lock()
+			m.lastHeartbeatAt = time.Now()
+			m.mu.Unlock()
+			m.networkTip.Store(health.LatestLedger)
+			metrics.RPCLatestLedger.WithLabelValues(m.network).Set(float64(health.LatestLedger))
+			if latest := m.latestLedger.Load(); latest > 0 {
+				metrics.LedgerLag.WithLabelValues(m.network).Set(float64(max(int64(health.LatestLedger)-int64(latest), 0)))
+			}
+		}
+	}
+}
+
+// recordError keeps the last ingestion error for the status.
+func (m *ingestService) recordError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastErr = err
+	m.lastErrAt = time.Now()
+}
+
+func (m *ingestService) Status(ctx context.Context) IngestStatus {
+	m.mu.Lock()
+	status := IngestStatus{
+		Network:          m.network,
+		Running:          m.running,
+		Paused:           m.paused,
+		PendingBackfills: slices.Clone(m.pendingBackfills),
+		StartedAt:        m.startedAt,
+		LastLedgerAt:     m.lastLedgerAt,
+		LastHeartbeatAt:  m.lastHeartbeatAt,
+		RPCHealthy:       !m.lastHeartbeatAt.IsZero() && time.Since(m.lastHeartbeatAt) < defaultHealthCheckWarningInterval,
+		LastErrorAt:      m.lastErrAt,
+	}
+	if m.lastErr != nil {
+		status.LastError = m.lastErr.Error()
+	}
+	if m.backfill != nil {
+		backfill := m.backfill.LedgerRange
+		status.Backfill = &backfill
+	}
+	endLedger := m.endLedger
+	m.mu.Unlock()
+
+	status.CurrentLedger = m.latestLedger.Load()
+	status.NetworkTip = m.networkTip.Load()
+	if status.NetworkTip > status.CurrentLedger && status.CurrentLedger > 0 {
+		status.Lag = status.NetworkTip - status.CurrentLedger
+	}
+	switch {
+	case endLedger > 0, status.Backfill != nil:
+		status.Mode = IngestionModeBackfill
+	case status.Lag > m.catchupThreshold:
+		status.Mode = IngestionModeCatchup
+	default:
+		status.Mode = IngestionModeLive
+	}
+	status.Sinks = m.sinkHealth(ctx)
+	return status
+}
+
+// sinkHealth reports the health of the sink: its own report for composite sinks, otherwise a
+// single entry checked with a ping when the sink supports it.
+func (m *ingestService) sinkHealth(ctx context.Context) []sink.Health {
+	if reporter, ok := m.sink.(sink.HealthReporter); ok {
+		return reporter.Health(ctx)
+	}
+
+	h := sink.Health{
+		Name:       m.sinkType,
+		Type:       m.sinkType,
+		State:      sink.StateHealthy,
+		Required:   true,
+		LastLedger: m.latestLedger.Load(),
+	}
+	if checker, ok := m.sink.(sink.HealthChecker); ok {
+		pingCtx, cancel := context.WithTimeout(ctx, sinkPingTimeout)
+		defer cancel()
+		if err := checker.Ping(pingCtx); err != nil {
+			h.State = sink.StateDegraded
+			h.LastError = err.Error()
+			h.LastErrorAt = time.Now()
+		}
+	}
+	return []sink.Health{h}
+}
+
+// resumeFromSinkCursor returns the ledger to start ingesting from. When the sink commits the
+// ingestion cursor together with the ledger data, ingestion resumes right after the last
+// committed ledger so that no ledger is skipped or written twice across restarts.
+func (m *ingestService) resumeFromSinkCursor(ctx context.Context, startLedger uint32) (uint32, error) {
+	if _, ok := m.sink.(sink.Cursor); !ok {
+		return startLedger, nil
+	}
+
+	latestLedger, err := m.sinkCursor(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting latest ledger from sink: %w", err)
+	}
+	if latestLedger == 0 {
+		log.Ctx(ctx).Infof("Sink has no committed cursor, starting from ledger %d", startLedger)
+		return startLedger, nil
+	}
+
+	log.Ctx(ctx).Infof("Sink cursor is at ledger %d, resuming from ledger %d", latestLedger, latestLedger+1)
+	return latestLedger + 1, nil
+}
+
+func (m *ingestService) Prepare(ctx context.Context, startLedger uint32) error {
+	ctx = logging.WithFields(ctx, log.F{logging.FieldNetwork: m.network})
+	startLedger, err := m.resumeFromSinkCursor(ctx, startLedger)
+	if err != nil {
+		return fmt.Errorf("resuming from sink cursor: %w", err)
+	}
+	return m.prepareBackendRange(ctx, startLedger, 0)
+}
+
+// sinkCursor returns the last ledger committed by the sink, or 0 when the sink commits no
+// cursor.
+func (m *ingestService) sinkCursor(ctx context.Context) (uint32, error) {
+	cursor, ok := m.sink.(sink.Cursor)
+	if !ok {
+		return 0, nil
+	}
+	return cursor.LatestLedger(ctx)
+}
+
+// prepareBackendRange prepares the ledger backend with the appropriate range type. A backend
+// already prepared by Prepare is kept when it holds the range. Otherwise, e.g. when the sink
+// cursor moved while the replica stood by, a new backend is prepared in its place, since a
+// prepared backend can't be prepared again.
 func (m *ingestService) prepareBackendRange(ctx context.Context, startLedger, endLedger uint32) error {
 	var ledgerRange ledgerbackend.Range
 	if endLedger == 0 {
 		ledgerRange = ledgerbackend.UnboundedRange(startLedger)
-		log.Ctx(ctx).Infof("Prepared backend with unbounded range starting from ledger %d", startLedger)
 	} else {
 		ledgerRange = ledgerbackend.BoundedRange(startLedger, endLedger)
-		log.Ctx(ctx).Infof("Prepared backend with bounded range [%d, %d]", startLedger, endLedger)
 	}
 
-	if err := m.ledgerBackend.PrepareRange(ctx, ledgerRange); err != nil {
-		return fmt.Errorf("preparing datastore backend unbounded range from %d: %w", startLedger, err)
+	if m.backendPrepared {
+		prepared, err := m.ledgerBackend.IsPrepared(ctx, ledgerRange)
+		if err != nil {
+			log.Ctx(ctx).Warnf("Checking the prepared range of the backend: %v", err)
+		}
+		if prepared {
+			log.Ctx(ctx).Infof("Backend is already prepared from ledger %d", startLedger)
+			return nil
+		}
+		if err := m.switchBackend(ctx, ledgerRange); err != nil {
+			return fmt.Errorf("preparing new backend from %d: %w", startLedger, err)
+		}
+	} else if err := m.ledgerBackend.PrepareRange(ctx, ledgerRange); err != nil {
+		return fmt.Errorf("preparing backend range from %d: %w", startLedger, err)
+	}
+	m.backendPrepared = true
+
+	if endLedger == 0 {
+		log.Ctx(ctx).Infof("Prepared backend with unbounded range starting from ledger %d", startLedger)
+	} else {
+		log.Ctx(ctx).Infof("Prepared backend with bounded range [%d, %d]", startLedger, endLedger)
 	}
 	return nil
 }
@@ -149,11 +569,13 @@ func (m *ingestService) prepareBackendRange(ctx context.Context, startLedger, en
 // processLedger processes a single ledger through all ingestion phases.
 // Phase 1: Get transactions from ledger
 // Phase 2: Process transactions using Indexer (parallel within ledger)
-// Phase 3: Insert all data into DB
-func (m *ingestService) processLedger(ctx context.Context, ledgerMeta xdr.LedgerCloseMeta) error {
+// Phase 3: Write all data to the configured sink
+// fetchDuration is how long the ledger took to fetch, for its stats.
+func (m *ingestService) processLedger(ctx context.Context, ledgerMeta xdr.LedgerCloseMeta, fetchDuration time.Duration) error {
 	ledgerSeq := ledgerMeta.LedgerSequence()
 
 	// Phase 1: Get transactions from ledger
+	processStart := time.Now()
 	transactions, err := m.getLedgerTransactions(ctx, ledgerMeta)
 	if err != nil {
 		return fmt.Errorf("getting transactions for ledger %d: %w", ledgerSeq, err)
@@ -161,19 +583,82 @@ func (m *ingestService) processLedger(ctx context.Context, ledgerMeta xdr.Ledger
 
 	// Phase 2: Process transactions using Indexer (parallel within ledger)
 	buffer := indexer.NewIndexerBuffer()
-	_, err = m.ledgerIndexer.ProcessLedgerTransactions(ctx, transactions, buffer)
+	processCtx, span := tracing.Start(ctx, "ProcessLedgerTransactions", tracing.AttrTransactionCount.Int(len(transactions)))
+	_, err = m.ledgerIndexer.ProcessLedgerTransactions(processCtx, transactions, buffer)
+	tracing.End(span, err)
 	if err != nil {
 		return fmt.Errorf("processing transactions for ledger %d: %w", ledgerSeq, err)
 	}
 
-	// Phase 3: Insert all data into DB
-	//if err := m.ingestProcessedData(ctx, buffer); err != nil {
-	//	return fmt.Errorf("ingesting processed data for ledger %d: %w", ledgerSeq, err)
-	//}
+	metrics.ObservePhase(m.network, metrics.PhaseProcess, processStart)
+	m.observeBuffer(buffer)
 
+	// Phase 3: Write all data to the configured sink
+	sinkStart := time.Now()
+	sinkCtx, span := tracing.Start(ctx, "SinkWrite", tracing.AttrLedgerSequence.Int64(int64(ledgerSeq)))
+	sinkCtx, sinkTimings := sink.WithWriteTimings(sinkCtx)
+	err = m.sink.Write(sinkCtx, buffer, ledgerSeq)
+	tracing.End(span, err)
+	if err != nil {
+		return fmt.Errorf("writing ledger %d to sink: %w", ledgerSeq, err)
+	}
+	ingestedAt := time.Now()
+	metrics.ObservePhase(m.network, metrics.PhaseSinkWrite, sinkStart)
+
+	if m.ledgerObserver != nil {
+		m.ledgerObserver.ObserveLedger(ctx, m.network, ledgerSeq, buffer)
+	}
+	if m.ledgerStats != nil {
+		m.ledgerStats.record(ctx, store.LedgerStats{
+			Network:         m.network,
+			Ledger:          ledgerSeq,
+			Mode:            m.ledgerMode(ctx, ledgerSeq, ledgerMeta.ClosedAt()),
+			ClosedAt:        ledgerMeta.ClosedAt(),
+			IngestedAt:      ingestedAt,
+			Transactions:    buffer.GetNumberOfTransactions(),
+			Operations:      buffer.GetNumberOfOperations(),
+			Participants:    len(buffer.GetAllParticipants()),
+			StateChanges:    len(buffer.GetStateChanges()),
+			Escrows:         len(buffer.GetEscrows()),
+			FetchDuration:   fetchDuration,
+			ProcessDuration: sinkStart.Sub(processStart),
+			SinkDuration:    ingestedAt.Sub(sinkStart),
+			SinkDurations:   sinkTimings.Durations(),
+		})
+	}
 	return nil
 }
 
+// ledgerMode returns how a ledger is ingested, for its stats: backfill for the ledgers of a
+// bounded run or of a backfill, catchup for the ledgers closed before the ingestion started
+// or more than the catch-up threshold behind the tip, live otherwise.
+func (m *ingestService) ledgerMode(ctx context.Context, ledgerSeq uint32, closedAt time.Time) string {
+	m.mu.Lock()
+	bounded, startedAt := m.endLedger > 0, m.startedAt
+	m.mu.Unlock()
+
+	// Close times are in seconds.
+	startedAt = startedAt.Truncate(time.Second)
+	tip := m.networkTip.Load()
+	switch {
+	case bounded || sink.IsBackfill(ctx):
+		return IngestionModeBackfill
+	case closedAt.Before(startedAt), tip > ledgerSeq && tip-ledgerSeq > m.catchupThreshold:
+		return IngestionModeCatchup
+	default:
+		return IngestionModeLive
+	}
+}
+
+// observeBuffer records the size of the buffer of a ledger.
+func (m *ingestService) observeBuffer(buffer *indexer.IndexerBuffer) {
+	metrics.BufferSize.WithLabelValues(m.network, "transactions").Set(float64(buffer.GetNumberOfTransactions()))
+	metrics.BufferSize.WithLabelValues(m.network, "operations").Set(float64(buffer.GetNumberOfOperations()))
+	metrics.BufferSize.WithLabelValues(m.network, "state_changes").Set(float64(len(buffer.GetStateChanges())))
+	metrics.BufferSize.WithLabelValues(m.network, "escrows").Set(float64(len(buffer.GetEscrows())))
+	metrics.BufferSize.WithLabelValues(m.network, "participants").Set(float64(len(buffer.GetAllParticipants())))
+}
+
 func (m *ingestService) getLedgerTransactions(ctx context.Context, xdrLedgerCloseMeta xdr.LedgerCloseMeta) ([]ingest.LedgerTransaction, error) {
 	ledgerTxReader, err := ingest.NewLedgerTransactionReaderFromLedgerCloseMeta(m.networkPassphrase, xdrLedgerCloseMeta)
 	if err != nil {
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/utils"
+	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+var (
+	// ErrNotLive is returned by the controls that only apply to live ingestion.
+	ErrNotLive = errors.New("ingestion is not running live")
+	// ErrLedgerAhead is returned by Rewind when the ledger would skip ledgers, and by Backfill
+	// when the range reaches the ledgers of live ingestion.
+	ErrLedgerAhead = errors.New("ledger is past the next ledger to ingest")
+)
+
+// LedgerRange is an inclusive range of ledgers.
+type LedgerRange struct {
+	Start uint32 `json:"start"`
+	End   uint32 `json:"end"`
+}
+
+// activeBackfill is the backfill being ingested, and the live ledger it interrupted.
+type activeBackfill struct {
+	LedgerRange
+	resumeAt uint32
+}
+
+// Pause stops the ingestion once the ledger in flight is written to the sink. It returns when
+// the ingestion is parked, or when ctx is done while the ledger in flight is still ingested.
+func (m *ingestService) Pause(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.paused {
+		m.paused = true
+		m.resumed = make(chan struct{})
+		m.parked = make(chan struct{})
+	}
+	parked, running := m.parked, m.running
+	m.mu.Unlock()
+
+	if !running {
+		return nil
+	}
+	select {
+	case <-parked:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the ledger in flight: %w", ctx.Err())
+	}
+}
+
+// Resume resumes a paused ingestion.
+func (m *ingestService) Resume() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.paused {
+		m.paused = false
+		close(m.resumed)
+	}
+}
+
+// Rewind makes the ingestion continue from ledger after the ledger in flight. The sink
+// receives the ledgers from there again, and commits its cursor accordingly. Ledgers can't be
+// skipped: ledger can't be past the next ledger to ingest. A rewind cancels the backfills.
+func (m *ingestService) Rewind(ledger uint32) error {
+	if ledger == 0 {
+		return errors.New("ledger must be positive")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running || m.endLedger > 0 {
+		return ErrNotLive
+	}
+	if next := m.nextLiveLedger(); next > 0 && ledger > next {
+		return fmt.Errorf("%w: ledger %d, next ledger %d", ErrLedgerAhead, ledger, next)
+	}
+	m.rewindTo = ledger
+	m.pendingBackfills = nil
+	return nil
+}
+
+// Backfill queues the ingestion of a range of ledgers. Backfills interrupt live ingestion
+// between two ledgers, one at a time, which then resumes where it stopped. The range must end
+// before the next live ledger: backfills don't move the sink cursor, so the ledgers from there
+// are left to live ingestion.
+func (m *ingestService) Backfill(ledgers LedgerRange) error {
+	if ledgers.Start == 0 || ledgers.End < ledgers.Start {
+		return fmt.Errorf("invalid range [%d, %d]", ledgers.Start, ledgers.End)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running || m.endLedger > 0 {
+		return ErrNotLive
+	}
+	next := m.nextLiveLedger()
+	if next == 0 {
+		return fmt.Errorf("%w: no ledger ingested yet", ErrNotLive)
+	}
+	if m.rewindTo > 0 {
+		// Live ingestion continues from the pending rewind.
+		next = min(next, m.rewindTo)
+	}
+	if ledgers.End >= next {
+		return fmt.Errorf("%w: range [%d, %d], next ledger %d", ErrLedgerAhead, ledgers.Start, ledgers.End, next)
+	}
+	m.pendingBackfills = append(m.pendingBackfills, ledgers)
+	return nil
+}
+
+// Drain pauses the ingestion and flushes the sink, so everything ingested so far reached its
+// destinations. The ingestion stays paused until Resume.
+func (m *ingestService) Drain(ctx context.Context) error {
+	if err := m.Pause(ctx); err != nil {
+		return err
+	}
+	flusher, ok := m.sink.(sink.Flusher)
+	if !ok {
+		return nil
+	}
+	if err := flusher.Flush(ctx); err != nil {
+		return fmt.Errorf("flushing sink: %w", err)
+	}
+	return nil
+}
+
+// nextLiveLedger returns the next ledger of live ingestion, or 0 before the first ledger is
+// ingested. Caller must hold m.mu.
+func (m *ingestService) nextLiveLedger() uint32 {
+	latest := m.latestLedger.Load()
+	if latest == 0 {
+		return 0
+	}
+	next := latest + 1
+	if m.backfill != nil {
+		next = max(next, m.backfill.resumeAt)
+	}
+	return next
+}
+
+// backfilling reports whether ledger is ingested by the active backfill.
+func (m *ingestService) backfilling(ledger uint32) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.backfill != nil && ledger >= m.backfill.Start && ledger <= m.backfill.End
+}
+
+// waitWhilePaused blocks the ingestion loop while the ingestion is paused.
+func (m *ingestService) waitWhilePaused(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.paused {
+		m.mu.Unlock()
+		return nil
+	}
+	select {
+	case <-m.parked:
+	default:
+		close(m.parked)
+	}
+	resumed := m.resumed
+	m.mu.Unlock()
+
+	log.Ctx(ctx).Info("Ingestion paused")
+	select {
+	case <-resumed:
+		log.Ctx(ctx).Info("Ingestion resumed")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("ingestion stopped while paused: %w", context.Cause(ctx))
+	}
+}
+
+// applyControls waits while the ingestion is paused, then applies the pending rewind or
+// backfill by preparing a new ledger backend. It returns the next ledger to ingest. A rewind
+// or backfill whose range can't be prepared is dropped, and ingestion goes on.
+func (m *ingestService) applyControls(ctx context.Context, next uint32) (uint32, error) {
+	if err := m.waitWhilePaused(ctx); err != nil {
+		return 0, err
+	}
+
+	var (
+		ledgerRange     ledgerbackend.Range
+		action          string
+		leavingBackfill bool
+		apply           func()
+	)
+	m.mu.Lock()
+	switch {
+	case m.rewindTo > 0:
+		ledgerRange = ledgerbackend.UnboundedRange(m.rewindTo)
+		action = fmt.Sprintf("rewind to ledger %d", m.rewindTo)
+		m.rewindTo = 0
+		apply = func() { m.backfill = nil }
+	case m.backfill != nil && next > m.backfill.End:
+		ledgerRange = ledgerbackend.UnboundedRange(m.backfill.resumeAt)
+		action = fmt.Sprintf("end of backfill [%d, %d]", m.backfill.Start, m.backfill.End)
+		leavingBackfill = true
+		apply = func() { m.backfill = nil }
+	case m.backfill == nil && len(m.pendingBackfills) > 0:
+		backfill := m.pendingBackfills[0]
+		m.pendingBackfills = m.pendingBackfills[1:]
+		ledgerRange = ledgerbackend.BoundedRange(backfill.Start, backfill.End)
+		action = fmt.Sprintf("backfill [%d, %d]", backfill.Start, backfill.End)
+		apply = func() { m.backfill = &activeBackfill{LedgerRange: backfill, resumeAt: next} }
+	default:
+		m.mu.Unlock()
+		return next, nil
+	}
+	m.mu.Unlock()
+
+	if err := m.switchBackend(ctx, ledgerRange); err != nil {
+		err = fmt.Errorf("preparing %s: %w", action, err)
+		m.recordError(err)
+		if leavingBackfill {
+			// Live ingestion can't go on, stop to resume from the cursor committed by the sink.
+			return 0, err
+		}
+		log.Ctx(ctx).Errorf("Dropping the %s, ingestion goes on from ledger %d: %v", action, next, err)
+		return next, nil
+	}
+
+	m.mu.Lock()
+	apply()
+	backfilling := m.backfill != nil
+	m.mu.Unlock()
+	if backfilling {
+		metrics.Backfilling.WithLabelValues(m.network).Set(1)
+	} else {
+		metrics.Backfilling.WithLabelValues(m.network).Set(0)
+	}
+	log.Ctx(ctx).Infof("Applied %s, ingesting from ledger %d", action, ledgerRange.From())
+	return ledgerRange.From(), nil
+}
+
+// switchBackend replaces the ledger backend with a new one prepared for ledgerRange. The
+// current backend is kept when the new one can't be prepared.
+func (m *ingestService) switchBackend(ctx context.Context, ledgerRange ledgerbackend.Range) error {
+	if m.ledgerBackendFactory == nil {
+		return errors.New("no ledger backend factory")
+	}
+	backend, err := m.ledgerBackendFactory(ctx)
+	if err != nil {
+		return fmt.Errorf("creating ledger backend: %w", err)
+	}
+	if err := backend.PrepareRange(ctx, ledgerRange); err != nil {
+		utils.DeferredClose(ctx, backend, "closing unprepared ledger backend")
+		return fmt.Errorf("preparing ledger backend: %w", err)
+	}
+	utils.DeferredClose(ctx, m.ledgerBackend, "closing ledger backend")
+	m.ledgerBackend = backend
+	return nil
+}
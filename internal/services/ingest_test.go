@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/ingest/ledgerbackend"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// fakeBackend serves empty ledgers, closed when they are fetched, and records the prepared ranges.
+type fakeBackend struct {
+	mu       sync.Mutex
+	prepared []ledgerbackend.Range
+}
+
+func (b *fakeBackend) GetLatestLedgerSequence(ctx context.Context) (uint32, error) {
+	return 0, nil
+}
+
+func (b *fakeBackend) GetLedger(ctx context.Context, sequence uint32) (xdr.LedgerCloseMeta, error) {
+	return testLedger(sequence, time.Now()), nil
+}
+
+func (b *fakeBackend) PrepareRange(ctx context.Context, ledgerRange ledgerbackend.Range) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prepared = append(b.prepared, ledgerRange)
+	return nil
+}
+
+func (b *fakeBackend) IsPrepared(ctx context.Context, ledgerRange ledgerbackend.Range) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.prepared) > 0 && b.prepared[len(b.prepared)-1] == ledgerRange, nil
+}
+
+func (b *fakeBackend) Close() error { return nil }
+
+// testLedger is a ledger without transactions.
+func testLedger(sequence uint32, closedAt time.Time) xdr.LedgerCloseMeta {
+	return xdr.LedgerCloseMeta{V: 1, V1: &xdr.LedgerCloseMetaV1{
+		LedgerHeader: xdr.LedgerHeaderHistoryEntry{Header: xdr.LedgerHeader{
+			LedgerSeq:     xdr.Uint32(sequence),
+			LedgerVersion: 22,
+			ScpValue:      xdr.StellarValue{CloseTime: xdr.TimePoint(closedAt.Unix())},
+		}},
+		TxSet: xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{}},
+	}}
+}
+
+// cursorSink records the written ledgers and commits them as its cursor, except backfills.
+type cursorSink struct {
+	mu         sync.Mutex
+	cursor     uint32
+	written    []uint32
+	backfilled []uint32
+	// onWrite is called with each live ledger before it is written.
+	onWrite func(ledgerSeq uint32)
+}
+
+var _ sink.Cursor = (*cursorSink)(nil)
+
+func (s *cursorSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sink.IsBackfill(ctx) {
+		s.backfilled = append(s.backfilled, ledgerSeq)
+		return nil
+	}
+	if s.onWrite != nil {
+		s.onWrite(ledgerSeq)
+	}
+	s.written = append(s.written, ledgerSeq)
+	s.cursor = ledgerSeq
+	return nil
+}
+
+func (s *cursorSink) LatestLedger(ctx context.Context) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursor, nil
+}
+
+func (s *cursorSink) Close() error { return nil }
+
+func newTestIngestService(t *testing.T, s sink.Sink, backend ledgerbackend.LedgerBackend) *ingestService {
+	t.Helper()
+	service, err := NewIngestService(IngestServiceConfig{
+		Network:           "testnet",
+		NetworkPassphrase: "Test SDF Network ; September 2015",
+		LedgerBackend:     backend,
+		LedgerBackendFactory: func(ctx context.Context) (ledgerbackend.LedgerBackend, error) {
+			return backend, nil
+		},
+		Sink: s,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+func TestRunBoundedRangeBehindSinkCursor(t *testing.T) {
+	backend := &fakeBackend{}
+	s := &cursorSink{cursor: 200}
+	service := newTestIngestService(t, s, backend)
+
+	if err := service.Run(context.Background(), 100, 150); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if want := ledgerbackend.BoundedRange(100, 150); len(backend.prepared) != 1 || backend.prepared[0] != want {
+		t.Fatalf("prepared %v, want %v", backend.prepared, want)
+	}
+	if len(s.written) != 0 {
+		t.Fatalf("wrote live ledgers %v, want none", s.written)
+	}
+	if len(s.backfilled) == 0 || s.backfilled[0] != 100 {
+		t.Fatalf("backfilled ledgers %v, want them to start at 100", s.backfilled)
+	}
+	if s.cursor != 200 {
+		t.Fatalf("cursor = %d, want 200", s.cursor)
+	}
+}
+
+func TestRunBoundedRangeAcrossSinkCursor(t *testing.T) {
+	backend := &fakeBackend{}
+	s := &cursorSink{cursor: 120}
+	service := newTestIngestService(t, s, backend)
+
+	if err := service.Run(context.Background(), 100, 125); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if want := ledgerbackend.BoundedRange(100, 125); len(backend.prepared) != 1 || backend.prepared[0] != want {
+		t.Fatalf("prepared %v, want %v", backend.prepared, want)
+	}
+	if len(s.backfilled) != 21 || s.backfilled[0] != 100 || s.backfilled[20] != 120 {
+		t.Fatalf("backfilled ledgers %v, want 100 to 120", s.backfilled)
+	}
+	if len(s.written) == 0 || s.written[0] != 121 {
+		t.Fatalf("wrote ledgers %v, want them to start at 121", s.written)
+	}
+}
+
+// slowBackend is a fakeBackend that takes a millisecond per ledger, to follow live ingestion.
+type slowBackend struct {
+	fakeBackend
+}
+
+func (b *slowBackend) GetLedger(ctx context.Context, sequence uint32) (xdr.LedgerCloseMeta, error) {
+	time.Sleep(time.Millisecond)
+	return b.fakeBackend.GetLedger(ctx, sequence)
+}
+
+func TestPrepareBeforeRun(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// cursor is the sink cursor once prepared, moved by another replica.
+		cursor uint32
+		want   []ledgerbackend.Range
+	}{
+		{"cursor kept", 120, []ledgerbackend.Range{ledgerbackend.UnboundedRange(121)}},
+		{"cursor moved", 130, []ledgerbackend.Range{ledgerbackend.UnboundedRange(121), ledgerbackend.UnboundedRange(131)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &fakeBackend{}
+			s := &cursorSink{cursor: 120}
+			service := newTestIngestService(t, s, backend)
+			if err := service.Prepare(context.Background(), 100); err != nil {
+				t.Fatalf("Prepare: %v", err)
+			}
+			s.mu.Lock()
+			s.cursor = tc.cursor
+			s.mu.Unlock()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() { done <- service.Run(ctx, 100, 0) }()
+			waitFor(t, func() bool { return service.latestLedger.Load() > tc.cursor+2 })
+			cancel()
+			<-done
+
+			backend.mu.Lock()
+			defer backend.mu.Unlock()
+			if !slices.Equal(backend.prepared, tc.want) {
+				t.Errorf("prepared %v, want %v", backend.prepared, tc.want)
+			}
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.written[0] != tc.cursor+1 {
+				t.Errorf("wrote ledgers from %d, want %d", s.written[0], tc.cursor+1)
+			}
+		})
+	}
+}
+
+func TestBackfillKeepsCursor(t *testing.T) {
+	s := &cursorSink{cursor: 100}
+	service := newTestIngestService(t, s, &slowBackend{})
+	stats := &statsWriter{}
+	service.ledgerStatsWriter = stats
+	var behind []uint32
+	s.onWrite = func(ledgerSeq uint32) {
+		if latest := service.latestLedger.Load(); latest != 0 && latest != ledgerSeq-1 {
+			behind = append(behind, latest)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- service.Run(ctx, 1, 0) }()
+	waitFor(t, func() bool { return service.latestLedger.Load() > 101 })
+	if err := service.Backfill(LedgerRange{Start: 10, End: 12}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.backfilled) == 3 && s.cursor > s.written[0]+5
+	})
+	cancel()
+	<-done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if want := []uint32{10, 11, 12}; !slices.Equal(s.backfilled, want) {
+		t.Errorf("backfilled %v, want %v", s.backfilled, want)
+	}
+	for i := 1; i < len(s.written); i++ {
+		if s.written[i] != s.written[i-1]+1 {
+			t.Fatalf("live ledgers %v are not consecutive", s.written)
+		}
+	}
+	if len(behind) > 0 {
+		t.Errorf("latest ledger moved back to %v during the backfill", behind)
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if len(stats.stats) == 0 {
+		t.Fatal("no ledger stats recorded")
+	}
+	for _, st := range stats.stats {
+		want := IngestionModeLive
+		if st.Ledger <= 12 {
+			want = IngestionModeBackfill
+		}
+		if st.Mode != want {
+			t.Errorf("ledger %d was recorded as %s, want %s", st.Ledger, st.Mode, want)
+		}
+	}
+}
+
+func TestBackfillRejectsLiveLedgers(t *testing.T) {
+	service := newTestIngestService(t, &cursorSink{cursor: 100}, &slowBackend{})
+	if err := service.Backfill(LedgerRange{Start: 10, End: 12}); !errors.Is(err, ErrNotLive) {
+		t.Fatalf("Backfill before Run = %v, want %v", err, ErrNotLive)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- service.Run(ctx, 1, 0) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	waitFor(t, func() bool { return service.latestLedger.Load() > 101 })
+
+	latest := service.latestLedger.Load()
+	for _, ledgers := range []LedgerRange{{Start: 50, End: latest + 1}, {Start: latest + 1, End: latest + 10}, {Start: 50, End: 1_000_000}} {
+		if err := service.Backfill(ledgers); !errors.Is(err, ErrLedgerAhead) {
+			t.Errorf("Backfill(%v) = %v, want %v", ledgers, err, ErrLedgerAhead)
+		}
+	}
+	if err := service.Backfill(LedgerRange{Start: 50, End: latest}); err != nil {
+		t.Errorf("Backfill of ingested ledgers: %v", err)
+	}
+
+	// Live ingestion continues from a pending rewind, which bounds the backfills.
+	if err := service.Pause(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.Rewind(60); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.Backfill(LedgerRange{Start: 50, End: 60}); !errors.Is(err, ErrLedgerAhead) {
+		t.Errorf("Backfill past a pending rewind = %v, want %v", err, ErrLedgerAhead)
+	}
+}
+
+// statsWriter records the ledger stats written.
+type statsWriter struct {
+	mu    sync.Mutex
+	stats []store.LedgerStats
+}
+
+func (w *statsWriter) WriteLedgerStats(ctx context.Context, stats []store.LedgerStats) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stats = append(w.stats, stats...)
+	return nil
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
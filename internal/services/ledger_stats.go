@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/batch"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// ledgerStatsConfig configures the writer of the ledger stats.
+var ledgerStatsConfig = batch.Config{
+	Name:          "ledger stats",
+	QueueSize:     1024,
+	BatchSize:     100,
+	FlushInterval: 5 * time.Second,
+	WriteTimeout:  10 * time.Second,
+}
+
+// LedgerStatsWriter persists the stats of the ingested ledgers.
+type LedgerStatsWriter interface {
+	WriteLedgerStats(ctx context.Context, stats []store.LedgerStats) error
+}
+
+// ledgerStatsRecorder writes the ledger stats in batches, off the ingestion loop. When the
+// store can't keep up and the queue is full, the stats are dropped: they must never slow down
+// the ingestion they measure.
+type ledgerStatsRecorder struct {
+	stats *batch.Writer[store.LedgerStats]
+}
+
+func newLedgerStatsRecorder(writer LedgerStatsWriter) *ledgerStatsRecorder {
+	return &ledgerStatsRecorder{stats: batch.NewWriter(ledgerStatsConfig, writer.WriteLedgerStats)}
+}
+
+func (r *ledgerStatsRecorder) record(ctx context.Context, stats store.LedgerStats) {
+	if err := r.stats.Add(stats); err != nil {
+		log.Ctx(ctx).Warnf("Ledger stats %v, stats of ledger %d not persisted", err, stats.Ledger)
+	}
+}
+
+// close writes the pending stats.
+func (r *ledgerStatsRecorder) close() {
+	r.stats.Close()
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	protocol "github.com/stellar/go-stellar-sdk/protocols/rpc"
+	"github.com/stellar/go-stellar-sdk/support/log"
 	"github.com/stellar/go-stellar-sdk/xdr"
 )
 
@@ -30,6 +31,12 @@ type RPCService interface {
 	GetLedgerEntries(key []string) (entities.RPCGetLedgerEntriesResult, error)
 	GetAccountLedgerSequence(address string) (int64, error)
 	NetworkPassphrase() string
+	// TrackRPCServiceHealth checks the health of the RPC server every tick until ctx is
+	// cancelled, publishing every healthy response on the heartbeat channel.
+	TrackRPCServiceHealth(ctx context.Context)
+	// GetHeartbeatChannel returns the channel of the healthy responses of the RPC server. Only
+	// the latest response is kept, so a slow reader always gets the current state.
+	GetHeartbeatChannel() <-chan entities.RPCGetHealthResult
 }
 
 type rpcService struct {
@@ -124,6 +131,52 @@ func (r *rpcService) GetHealth() (entities.RPCGetHealthResult, error) {
 	return result, nil
 }
 
+func (r *rpcService) GetHeartbeatChannel() <-chan entities.RPCGetHealthResult {
+	return r.heartbeatChannel
+}
+
+func (r *rpcService) TrackRPCServiceHealth(ctx context.Context) {
+	healthCheckTicker := time.NewTicker(r.healthCheckTickInterval)
+	defer healthCheckTicker.Stop()
+	warningTicker := time.NewTicker(r.healthCheckWarningInterval)
+	defer warningTicker.Stop()
+
+	checkHealth := func() {
+		health, err := r.GetHealth()
+		if err != nil {
+			log.Ctx(ctx).Warnf("RPC health check failed: %v", err)
+			return
+		}
+		if health.Status != "healthy" {
+			log.Ctx(ctx).Warnf("RPC server reported status %q", health.Status)
+			return
+		}
+		warningTicker.Reset(r.healthCheckWarningInterval)
+
+		// Replace the heartbeat nobody read yet, if any, with the latest one.
+		select {
+		case <-r.heartbeatChannel:
+		default:
+		}
+		select {
+		case r.heartbeatChannel <- health:
+		default:
+		}
+	}
+
+	checkHealth()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-healthCheckTicker.C:
+			checkHealth()
+		case <-warningTicker.C:
+			log.Ctx(ctx).Warnf("RPC server has not been healthy for %v", r.healthCheckWarningInterval)
+		}
+	}
+}
+
 type GetLedgersResponse protocol.GetLedgersResponse
 
 func (r *rpcService) GetLedgers(startLedger uint32, limit uint32) (GetLedgersResponse, error) {
@@ -0,0 +1,17 @@
+package sink
+
+import "context"
+
+type backfillKey struct{}
+
+// WithBackfill returns a context marking the writes made with it as backfills: the ledger is
+// older than the ingestion cursor, so the sinks deliver it without moving their cursor.
+func WithBackfill(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backfillKey{}, true)
+}
+
+// IsBackfill reports whether the write made with ctx is a backfill, see WithBackfill.
+func IsBackfill(ctx context.Context) bool {
+	backfill, _ := ctx.Value(backfillKey{}).(bool)
+	return backfill
+}
@@ -0,0 +1,211 @@
+package grpcstream
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	indexerv1 "github.com/Trustless-Work/Indexer/gen/indexer/v1"
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransactionToProto converts a transaction. participants may be nil.
+func TransactionToProto(tx types.Transaction, participants []string) *indexerv1.Transaction {
+	return &indexerv1.Transaction{
+		Hash:                 tx.Hash,
+		ToId:                 tx.ToID,
+		EnvelopeXdr:          stringValue(tx.EnvelopeXDR),
+		ResultXdr:            tx.ResultXDR,
+		MetaXdr:              stringValue(tx.MetaXDR),
+		LedgerNumber:         tx.LedgerNumber,
+		LedgerCreatedAt:      timestamp(tx.LedgerCreatedAt),
+		InnerTransactionHash: tx.InnerTransactionHash,
+		Participants:         participants,
+		Network:              tx.Network,
+	}
+}
+
+// OperationToProto converts an operation. participants may be nil.
+func OperationToProto(op types.Operation, participants []string) *indexerv1.Operation {
+	return &indexerv1.Operation{
+		Id:              op.ID,
+		OperationType:   string(op.OperationType),
+		OperationXdr:    op.OperationXDR,
+		LedgerNumber:    op.LedgerNumber,
+		LedgerCreatedAt: timestamp(op.LedgerCreatedAt),
+		TxHash:          op.TxHash,
+		Participants:    participants,
+		Network:         op.Network,
+	}
+}
+
+// StateChangeToProto converts a state change. Null columns are left unset.
+func StateChangeToProto(sc types.StateChange) (*indexerv1.StateChange, error) {
+	out := &indexerv1.StateChange{
+		ToId:               sc.ToID,
+		StateChangeOrder:   sc.StateChangeOrder,
+		Category:           string(sc.StateChangeCategory),
+		LedgerNumber:       sc.LedgerNumber,
+		LedgerCreatedAt:    timestamp(sc.LedgerCreatedAt),
+		AccountId:          sc.AccountID,
+		OperationId:        sc.OperationID,
+		TxHash:             sc.TxHash,
+		Network:            sc.Network,
+		TokenId:            nullString(sc.TokenID),
+		Amount:             nullString(sc.Amount),
+		OfferId:            nullString(sc.OfferID),
+		SignerAccountId:    nullString(sc.SignerAccountID),
+		SpenderAccountId:   nullString(sc.SpenderAccountID),
+		SponsoredAccountId: nullString(sc.SponsoredAccountID),
+		SponsorAccountId:   nullString(sc.SponsorAccountID),
+		DeployerAccountId:  nullString(sc.DeployerAccountID),
+		FunderAccountId:    nullString(sc.FunderAccountID),
+		Flags:              sc.Flags,
+	}
+	if sc.StateChangeReason != nil {
+		out.Reason = string(*sc.StateChangeReason)
+	}
+
+	var err error
+	if out.SignerWeights, err = jsonbToStruct(sc.SignerWeights); err != nil {
+		return nil, fmt.Errorf("converting signer weights: %w", err)
+	}
+	if out.Thresholds, err = jsonbToStruct(sc.Thresholds); err != nil {
+		return nil, fmt.Errorf("converting thresholds: %w", err)
+	}
+	if out.TrustlineLimit, err = jsonbToStruct(sc.TrustlineLimit); err != nil {
+		return nil, fmt.Errorf("converting trustline limit: %w", err)
+	}
+	if out.KeyValue, err = jsonbToStruct(sc.KeyValue); err != nil {
+		return nil, fmt.Errorf("converting key value: %w", err)
+	}
+	return out, nil
+}
+
+// EscrowToProto converts an escrow.
+func EscrowToProto(escrow entities.Escrow) *indexerv1.Escrow {
+	out := &indexerv1.Escrow{
+		ContractId:       escrow.ContractID,
+		EscrowType:       escrowTypeToProto(escrow.EscrowType),
+		Deployer:         escrow.Deployer,
+		FactoryContract:  escrow.FactoryContract,
+		DeployerSalt:     escrow.DeployerSalt,
+		WasmHash:         escrow.WasmHash,
+		InitFunction:     escrow.InitFunction,
+		Amount:           escrow.Amount,
+		Description:      escrow.Description,
+		EngagementId:     escrow.EngagementID,
+		Title:            escrow.Title,
+		PlatformFee:      escrow.PlatformFee,
+		ReceiverMemo:     escrow.ReceiverMemo,
+		Flags:            flagsToProto(&escrow.Flags),
+		TrustlineAddress: escrow.TrustlineAddress,
+		Network:          escrow.Network,
+		Roles: &indexerv1.EscrowRoles{
+			ServiceProvider: escrow.Roles.ServiceProvider,
+			Receiver:        escrow.Roles.Receiver,
+			Approver:        escrow.Roles.Approver,
+			ReleaseSigner:   escrow.Roles.ReleaseSigner,
+			DisputeResolver: escrow.Roles.DisputeResolver,
+			PlatformAddress: escrow.Roles.PlatformAddress,
+		},
+		Milestones: make([]*indexerv1.Milestone, 0, len(escrow.Milestones)),
+	}
+	for _, milestone := range escrow.Milestones {
+		out.Milestones = append(out.Milestones, &indexerv1.Milestone{
+			Description: milestone.Description,
+			Status:      milestone.Status,
+			Approved:    milestone.Approved,
+			Evidence:    milestone.Evidence,
+			Amount:      milestone.Amount,
+			Flags:       flagsToProto(milestone.Flags),
+			Receiver:    milestone.Receiver,
+		})
+	}
+	return out
+}
+
+// EscrowEventToProto converts a detected escrow deployment, or the state of an escrow after a
+// lifecycle invocation, into an escrow event. The ID matches the ID of the equivalent
+// CloudEvents event.
+func EscrowEventToProto(escrow entities.Escrow, ledgerSeq uint32, closedAt time.Time) *indexerv1.EscrowEvent {
+	eventType := indexerv1.EscrowEventType_ESCROW_EVENT_TYPE_CREATED
+	if escrow.IsUpdate() {
+		eventType = indexerv1.EscrowEventType_ESCROW_EVENT_TYPE_UPDATED
+	}
+	return &indexerv1.EscrowEvent{
+		Id:             event.EscrowEventID(escrow),
+		Type:           eventType,
+		LedgerSequence: ledgerSeq,
+		Time:           timestamp(closedAt),
+		Escrow:         EscrowToProto(escrow),
+	}
+}
+
+func escrowTypeToProto(escrowType entities.EscrowType) indexerv1.EscrowType {
+	switch escrowType {
+	case entities.EscrowTypeSingleRelease:
+		return indexerv1.EscrowType_ESCROW_TYPE_SINGLE_RELEASE
+	case entities.EscrowTypeMultiRelease:
+		return indexerv1.EscrowType_ESCROW_TYPE_MULTI_RELEASE
+	default:
+		return indexerv1.EscrowType_ESCROW_TYPE_UNSPECIFIED
+	}
+}
+
+func flagsToProto(flags *entities.EscrowFlags) *indexerv1.EscrowFlags {
+	if flags == nil {
+		return nil
+	}
+	return &indexerv1.EscrowFlags{
+		Approved: flags.Approved,
+		Disputed: flags.Disputed,
+		Released: flags.Released,
+		Resolved: flags.Resolved,
+	}
+}
+
+// jsonbToStruct converts a JSONB column through its JSON encoding, since structpb only
+// accepts plain JSON values and the columns may hold typed values.
+func jsonbToStruct(value types.NullableJSONB) (*structpb.Struct, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return proto.String(s.String)
+}
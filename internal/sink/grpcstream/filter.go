@@ -0,0 +1,75 @@
+package grpcstream
+
+import (
+	"fmt"
+	"time"
+
+	indexerv1 "github.com/Trustless-Work/Indexer/gen/indexer/v1"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/pubsub"
+	set "github.com/deckarep/golang-set/v2"
+)
+
+func newFilter(req *indexerv1.SubscribeRequest) *pubsub.Filter {
+	return pubsub.NewFilter(req.GetAccountIds(), req.GetContractIds(), req.GetEngagementIds())
+}
+
+// ledgerUpdate builds the update of a ledger with the entities matching the filter.
+func ledgerUpdate(f *pubsub.Filter, ledgerSeq uint32, buffer indexer.IndexerBufferInterface) (*indexerv1.LedgerUpdate, error) {
+	closedAt := time.Time{}
+	txs := buffer.GetTransactions()
+	if len(txs) > 0 {
+		closedAt = txs[0].LedgerCreatedAt
+	}
+
+	update := &indexerv1.LedgerUpdate{
+		LedgerSequence: ledgerSeq,
+		ClosedAt:       timestamp(closedAt),
+	}
+
+	txParticipants := buffer.GetTransactionsParticipants()
+	for _, tx := range txs {
+		participants := txParticipants[tx.Hash]
+		if !f.MatchParticipants(participants) {
+			continue
+		}
+		update.Transactions = append(update.Transactions, TransactionToProto(tx, toSlice(participants)))
+	}
+
+	opParticipants := buffer.GetOperationsParticipants()
+	for _, op := range buffer.GetOperations() {
+		participants := opParticipants[op.ID]
+		if !f.MatchParticipants(participants) {
+			continue
+		}
+		update.Operations = append(update.Operations, OperationToProto(op, toSlice(participants)))
+	}
+
+	for _, sc := range buffer.GetStateChanges() {
+		if !f.MatchAddress(sc.AccountID) {
+			continue
+		}
+		converted, err := StateChangeToProto(sc)
+		if err != nil {
+			return nil, fmt.Errorf("converting state change %d-%d: %w", sc.ToID, sc.StateChangeOrder, err)
+		}
+		update.StateChanges = append(update.StateChanges, converted)
+	}
+
+	for _, escrow := range buffer.GetEscrows() {
+		if !f.MatchEscrow(escrow) {
+			continue
+		}
+		update.Escrows = append(update.Escrows, EscrowToProto(escrow))
+		update.EscrowEvents = append(update.EscrowEvents, EscrowEventToProto(escrow, ledgerSeq, closedAt))
+	}
+
+	return update, nil
+}
+
+func toSlice(s set.Set[string]) []string {
+	if s == nil {
+		return nil
+	}
+	return s.ToSlice()
+}
@@ -0,0 +1,107 @@
+// Package grpcstream implements a Sink that serves the processed ledgers over gRPC.
+//
+// Every ledger written to the sink is published to an in-process broker (see
+// internal/pubsub) and streamed to the clients of the IndexerStreamService defined in
+// proto/indexer/v1/indexer.proto. Clients filter the stream by account, contract or
+// escrow engagement ID and can resume from any ledger still in the replay window.
+package grpcstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	indexerv1 "github.com/Trustless-Work/Indexer/gen/indexer/v1"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/pubsub"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const defaultListenAddress = ":9090"
+
+func init() {
+	sink.Register("grpc", func(cfg map[string]any) (sink.Sink, error) {
+		var streamCfg Config
+		if err := sink.DecodeConfig(cfg, &streamCfg); err != nil {
+			return nil, err
+		}
+		return New(streamCfg)
+	})
+}
+
+// Config holds the options accepted by the grpc sink under SinkOptions.
+type Config struct {
+	// ListenAddress is the address of the gRPC server, e.g. ":9090".
+	ListenAddress string `json:"listen_address"`
+	// ReplayLedgers is the number of recent ledgers clients can resume from.
+	ReplayLedgers int `json:"replay_ledgers"`
+	// QueueSize is the number of ledgers buffered per client before it is disconnected.
+	QueueSize int `json:"queue_size"`
+	// Reflection enables the gRPC server reflection service (for grpcurl and similar tools).
+	Reflection bool `json:"reflection"`
+}
+
+// StreamSink publishes every ledger to the gRPC subscribers.
+type StreamSink struct {
+	broker   *pubsub.Broker
+	server   *grpc.Server
+	listener net.Listener
+	done     chan struct{}
+}
+
+var _ sink.Sink = (*StreamSink)(nil)
+
+// New starts the gRPC server and returns the sink feeding it.
+func New(cfg Config) (*StreamSink, error) {
+	if cfg.ListenAddress == "" {
+		cfg.ListenAddress = defaultListenAddress
+	}
+
+	listener, err := net.Listen("tcp", cfg.ListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", cfg.ListenAddress, err)
+	}
+
+	broker := pubsub.NewBroker(pubsub.Config{ReplayLedgers: cfg.ReplayLedgers, QueueSize: cfg.QueueSize})
+	server := grpc.NewServer()
+	indexerv1.RegisterIndexerStreamServiceServer(server, &streamServer{broker: broker})
+	if cfg.Reflection {
+		reflection.Register(server)
+	}
+
+	s := &StreamSink{
+		broker:   broker,
+		server:   server,
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go s.serve()
+
+	log.Infof("Serving gRPC ledger stream on %s", listener.Addr())
+	return s, nil
+}
+
+func (s *StreamSink) serve() {
+	defer close(s.done)
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		log.Errorf("grpc stream: server stopped: %v", err)
+	}
+}
+
+// Write publishes the ledger to the subscribers. It never blocks on slow clients.
+func (s *StreamSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	s.broker.Publish(pubsub.Ledger{Sequence: ledgerSeq, Buffer: buffer})
+	return nil
+}
+
+// Close ends every stream and stops the server.
+func (s *StreamSink) Close() error {
+	s.broker.Close()
+	s.server.GracefulStop()
+	<-s.done
+	return nil
+}
@@ -0,0 +1,58 @@
+package grpcstream
+
+import (
+	"errors"
+
+	indexerv1 "github.com/Trustless-Work/Indexer/gen/indexer/v1"
+	"github.com/Trustless-Work/Indexer/internal/pubsub"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamServer implements the IndexerStreamService on top of the pub/sub broker.
+type streamServer struct {
+	indexerv1.UnimplementedIndexerStreamServiceServer
+	broker *pubsub.Broker
+}
+
+var _ indexerv1.IndexerStreamServiceServer = (*streamServer)(nil)
+
+func (s *streamServer) Subscribe(req *indexerv1.SubscribeRequest, stream indexerv1.IndexerStreamService_SubscribeServer) error {
+	ctx := stream.Context()
+
+	subscription, err := s.broker.Subscribe(req.GetStartLedger())
+	if err != nil {
+		var outOfRange *pubsub.LedgerOutOfRangeError
+		if errors.As(err, &outOfRange) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer subscription.Close()
+
+	f := newFilter(req)
+	log.Ctx(ctx).Debugf("grpc stream: new subscription from ledger %d", req.GetStartLedger())
+
+	for {
+		ledger, err := subscription.Next(ctx)
+		if err != nil {
+			switch {
+			case errors.Is(err, pubsub.ErrSlowSubscriber):
+				return status.Error(codes.ResourceExhausted, err.Error())
+			case errors.Is(err, pubsub.ErrClosed):
+				return status.Error(codes.Unavailable, err.Error())
+			default:
+				return status.FromContextError(err).Err()
+			}
+		}
+
+		update, err := ledgerUpdate(f, ledger.Sequence, ledger.Buffer)
+		if err != nil {
+			return status.Errorf(codes.Internal, "building update for ledger %d: %v", ledger.Sequence, err)
+		}
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+	}
+}
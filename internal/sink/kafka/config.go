@@ -0,0 +1,81 @@
+package kafka
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultTopicPrefix      = "stellar"
+	defaultNetwork          = "testnet"
+	defaultCursorName       = "latest_ledger"
+	defaultTransactionalID  = "trustless-work-indexer"
+	defaultTransactionTTL   = 60 * time.Second
+	defaultCursorReadIdle   = 2 * time.Second
+	defaultReplicationCount = -1
+	defaultPartitionCount   = -1
+)
+
+// Config holds the options accepted by the kafka sink under SinkOptions.
+type Config struct {
+	// Brokers is the list of seed brokers, e.g. ["localhost:9092"].
+	Brokers []string `json:"brokers"`
+	// TopicPrefix prefixes every entity topic: <prefix>.transactions, <prefix>.escrows, ...
+	TopicPrefix string `json:"topic_prefix"`
+	// Network is the network name used in the source of the events, e.g. "testnet".
+	Network string `json:"network"`
+	// CursorTopic is the compacted topic holding the ingestion cursor.
+	// Defaults to <prefix>.cursors.
+	CursorTopic string `json:"cursor_topic"`
+	// CursorName is the record key under which the latest committed ledger is stored.
+	CursorName string `json:"cursor_name"`
+	// TransactionalID must be stable across restarts of the same indexer so a new
+	// instance fences the previous one and aborts its in-flight transaction.
+	TransactionalID string `json:"transactional_id"`
+	// TransactionTimeoutSeconds bounds how long a ledger transaction may stay open.
+	TransactionTimeoutSeconds int `json:"transaction_timeout_seconds"`
+	// CreateTopics creates the entity and cursor topics on startup if they don't exist.
+	CreateTopics bool `json:"create_topics"`
+	// Partitions and ReplicationFactor are used when creating entity topics.
+	// -1 uses the broker defaults.
+	Partitions        int32 `json:"partitions"`
+	ReplicationFactor int16 `json:"replication_factor"`
+}
+
+func (c *Config) setDefaults() {
+	if c.TopicPrefix == "" {
+		c.TopicPrefix = defaultTopicPrefix
+	}
+	if c.Network == "" {
+		c.Network = defaultNetwork
+	}
+	if c.CursorTopic == "" {
+		c.CursorTopic = c.TopicPrefix + ".cursors"
+	}
+	if c.CursorName == "" {
+		c.CursorName = defaultCursorName
+	}
+	if c.TransactionalID == "" {
+		c.TransactionalID = defaultTransactionalID
+	}
+	if c.Partitions == 0 {
+		c.Partitions = defaultPartitionCount
+	}
+	if c.ReplicationFactor == 0 {
+		c.ReplicationFactor = defaultReplicationCount
+	}
+}
+
+func (c *Config) transactionTimeout() time.Duration {
+	if c.TransactionTimeoutSeconds <= 0 {
+		return defaultTransactionTTL
+	}
+	return time.Duration(c.TransactionTimeoutSeconds) * time.Second
+}
+
+func (c *Config) validate() error {
+	if len(c.Brokers) == 0 {
+		return errors.New("at least one broker is required")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// LatestLedger reads the last committed cursor from the compacted cursor topic.
+//
+// The topic is read with read_committed isolation, so a cursor written inside a
+// transaction that was never committed (e.g. the process died mid-ledger) is never
+// observed. The read stops at the partition's last stable offset; since the tail of
+// the log may only contain transaction markers, it also stops once the fetch goes idle.
+func (s *KafkaSink) LatestLedger(ctx context.Context) (uint32, error) {
+	offsets, err := s.admin.ListCommittedOffsets(ctx, s.cfg.CursorTopic)
+	if err != nil {
+		return 0, fmt.Errorf("listing committed offsets of %s: %w", s.cfg.CursorTopic, err)
+	}
+	end, ok := offsets.Lookup(s.cfg.CursorTopic, 0)
+	if !ok || end.Err != nil || end.Offset <= 0 {
+		return 0, nil
+	}
+
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(s.cfg.Brokers...),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{
+			s.cfg.CursorTopic: {0: kgo.NewOffset().AtStart()},
+		}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("creating cursor consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	var latest uint32
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, defaultCursorReadIdle)
+		fetches := consumer.PollFetches(pollCtx)
+		cancel()
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return 0, ctxErr
+		}
+
+		var fetchErr error
+		fetches.EachError(func(_ string, _ int32, err error) {
+			if !errors.Is(err, context.DeadlineExceeded) {
+				fetchErr = err
+			}
+		})
+		if fetchErr != nil {
+			return 0, fmt.Errorf("fetching cursor records: %w", fetchErr)
+		}
+
+		if fetches.NumRecords() == 0 {
+			return latest, nil
+		}
+
+		reachedEnd := false
+		fetches.EachRecord(func(r *kgo.Record) {
+			if string(r.Key) == s.cfg.CursorName {
+				ledger, parseErr := strconv.ParseUint(string(r.Value), 10, 32)
+				if parseErr == nil {
+					latest = uint32(ledger)
+				}
+			}
+			if r.Offset+1 >= end.Offset {
+				reachedEnd = true
+			}
+		})
+		if reachedEnd {
+			return latest, nil
+		}
+	}
+}
+
+// ensureTopics creates the entity topics and the compacted cursor topic if they don't exist yet.
+func (s *KafkaSink) ensureTopics(ctx context.Context) error {
+	resp, err := s.admin.CreateTopics(ctx, s.cfg.Partitions, s.cfg.ReplicationFactor, nil, s.entityTopics()...)
+	if err != nil {
+		return fmt.Errorf("creating entity topics: %w", err)
+	}
+	for _, r := range resp {
+		if r.Err != nil && !isTopicExists(r.Err) {
+			return fmt.Errorf("creating topic %s: %w", r.Topic, r.Err)
+		}
+	}
+
+	_, err = s.admin.CreateTopic(ctx, 1, s.cfg.ReplicationFactor, map[string]*string{
+		"cleanup.policy": kadm.StringPtr("compact"),
+	}, s.cfg.CursorTopic)
+	if err != nil && !isTopicExists(err) {
+		return fmt.Errorf("creating cursor topic %s: %w", s.cfg.CursorTopic, err)
+	}
+	return nil
+}
+
+func cursorValue(ledgerSeq uint32) []byte {
+	return []byte(strconv.FormatUint(uint64(ledgerSeq), 10))
+}
+
+func isTopicExists(err error) bool {
+	return errors.Is(err, kerr.TopicAlreadyExists)
+}
@@ -0,0 +1,282 @@
+// Package kafka implements a Sink that publishes every ledger buffer to Kafka (or any
+// protocol compatible broker such as Redpanda) with exactly-once semantics per ledger.
+//
+// DELIVERY MODEL:
+// Each ledger is written inside a single producer transaction that contains the records of
+// every entity in the buffer plus the ingestion cursor record. Either the whole ledger and
+// its cursor become visible to read_committed consumers, or nothing does.
+//
+// On restart the ingest service asks the sink for LatestLedger and resumes from the next
+// ledger. Because the transactional ID is stable, the new producer fences the previous one
+// and any transaction left open by a crash is aborted, so no ledger is delivered twice.
+//
+// Several networks can publish through the same producer with WithCursorName: each network
+// commits its own cursor record, keyed by its cursor name, in the cursor topic.
+//
+// FORMAT:
+// Every record value is a CloudEvents event in structured mode (see internal/event), with
+// the "content-type: application/cloudevents+json" header. When tracing is enabled, records
+// also carry the W3C "traceparent" header of the ingestion of their ledger.
+//
+// ORDERING:
+// Records are keyed by the account or contract they belong to, so all the events of the
+// same account/contract land in the same partition and keep their ledger order.
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	entityTransactions     = "transactions"
+	entityOperations       = "operations"
+	entityStateChanges     = "state_changes"
+	entityTrustlineChanges = "trustline_changes"
+	entityContractChanges  = "contract_changes"
+	entityEscrows          = "escrows"
+
+	headerLedger      = "ledger_seq"
+	headerEntity      = "entity"
+	headerContentType = "content-type"
+)
+
+// eventEntities maps the entity of an event to the suffix of its topic.
+var eventEntities = map[event.Entity]string{
+	event.EntityTransaction:     entityTransactions,
+	event.EntityOperation:       entityOperations,
+	event.EntityStateChange:     entityStateChanges,
+	event.EntityTrustlineChange: entityTrustlineChanges,
+	event.EntityContractChange:  entityContractChanges,
+	event.EntityEscrow:          entityEscrows,
+}
+
+var entityNames = []string{
+	entityTransactions,
+	entityOperations,
+	entityStateChanges,
+	entityTrustlineChanges,
+	entityContractChanges,
+	entityEscrows,
+}
+
+func init() {
+	sink.Register("kafka", func(cfg map[string]any) (sink.Sink, error) {
+		var kafkaCfg Config
+		if err := sink.DecodeConfig(cfg, &kafkaCfg); err != nil {
+			return nil, err
+		}
+		return New(context.Background(), kafkaCfg)
+	})
+}
+
+// KafkaSink writes each ledger buffer inside a Kafka producer transaction.
+type KafkaSink struct {
+	// mu serializes ledger transactions: a transactional producer can only have one open
+	// transaction at a time. It is shared with the views of the sink (see WithCursorName).
+	mu     *sync.Mutex
+	client *kgo.Client
+	admin  *kadm.Client
+	events *event.Builder
+	cfg    Config
+	// view is set on the sinks returned by WithCursorName, which don't own the client.
+	view bool
+}
+
+var (
+	_ sink.Sink          = (*KafkaSink)(nil)
+	_ sink.Cursor        = (*KafkaSink)(nil)
+	_ sink.CursorScoper  = (*KafkaSink)(nil)
+	_ sink.HealthChecker = (*KafkaSink)(nil)
+)
+
+// New creates a transactional Kafka sink and, if configured, creates its topics.
+func New(ctx context.Context, cfg Config) (*KafkaSink, error) {
+	cfg.setDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("validating kafka sink config: %w", err)
+	}
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.TransactionalID(cfg.TransactionalID),
+		kgo.TransactionTimeout(cfg.transactionTimeout()),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating kafka client: %w", err)
+	}
+
+	s := &KafkaSink{
+		mu:     &sync.Mutex{},
+		client: client,
+		admin:  kadm.NewClient(client),
+		events: event.NewBuilder(cfg.Network),
+		cfg:    cfg,
+	}
+
+	if cfg.CreateTopics {
+		if err := s.ensureTopics(ctx); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+
+	log.Ctx(ctx).Infof("Using Kafka sink with brokers %v, topic prefix %q and cursor topic %q", cfg.Brokers, cfg.TopicPrefix, cfg.CursorTopic)
+	return s, nil
+}
+
+// Write publishes the ledger buffer and the ingestion cursor in a single transaction.
+// Backfills (see sink.WithBackfill) publish no cursor record. If any record fails, the
+// transaction is aborted and none of the ledger records are visible to read_committed
+// consumers.
+func (s *KafkaSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	records, err := s.buildRecords(ctx, buffer, ledgerSeq)
+	if err != nil {
+		return fmt.Errorf("building records for ledger %d: %w", ledgerSeq, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.client.BeginTransaction(); err != nil {
+		return fmt.Errorf("beginning transaction for ledger %d: %w", ledgerSeq, err)
+	}
+
+	if err := s.client.ProduceSync(ctx, records...).FirstErr(); err != nil {
+		s.abort(ctx, ledgerSeq)
+		return fmt.Errorf("producing records for ledger %d: %w", ledgerSeq, err)
+	}
+
+	if err := s.client.EndTransaction(ctx, kgo.TryCommit); err != nil {
+		s.abort(ctx, ledgerSeq)
+		return fmt.Errorf("committing transaction for ledger %d: %w", ledgerSeq, err)
+	}
+
+	log.Ctx(ctx).Debugf("kafka sink: committed %d records for ledger %d", len(records), ledgerSeq)
+	return nil
+}
+
+// abort discards the buffered records and aborts the open transaction, if any.
+// Caller must hold s.mu.
+func (s *KafkaSink) abort(ctx context.Context, ledgerSeq uint32) {
+	if err := s.client.AbortBufferedRecords(ctx); err != nil {
+		log.Ctx(ctx).Errorf("kafka sink: aborting buffered records for ledger %d: %v", ledgerSeq, err)
+	}
+	if err := s.client.EndTransaction(ctx, kgo.TryAbort); err != nil {
+		log.Ctx(ctx).Errorf("kafka sink: aborting transaction for ledger %d: %v", ledgerSeq, err)
+	}
+}
+
+// Ping checks that the brokers are reachable.
+func (s *KafkaSink) Ping(ctx context.Context) error {
+	if err := s.client.Ping(ctx); err != nil {
+		return fmt.Errorf("pinging kafka brokers: %w", err)
+	}
+	return nil
+}
+
+// WithCursorName returns a view of the sink that shares its producer and topics but commits
+// the cursor under name, so several networks can publish through one transactional producer.
+func (s *KafkaSink) WithCursorName(name string) sink.Sink {
+	cfg := s.cfg
+	cfg.CursorName = name
+	return &KafkaSink{
+		mu:     s.mu,
+		client: s.client,
+		admin:  s.admin,
+		events: s.events,
+		cfg:    cfg,
+		view:   true,
+	}
+}
+
+func (s *KafkaSink) Close() error {
+	if s.view {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.client.Close()
+	return nil
+}
+
+// buildRecords converts the buffer into Kafka records, one event per entity, followed by
+// the cursor record except for backfills. The cursor record is produced last so it is
+// committed together with the data.
+func (s *KafkaSink) buildRecords(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) ([]*kgo.Record, error) {
+	events, err := s.events.FromBuffer(buffer, ledgerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	traceContext := propagation.MapCarrier{}
+	tracing.Inject(ctx, traceContext)
+
+	timestamp := time.Now()
+	if txs := buffer.GetTransactions(); len(txs) > 0 {
+		timestamp = txs[0].LedgerCreatedAt
+	}
+
+	records := make([]*kgo.Record, 0, len(events)+1)
+	for _, e := range events {
+		payload, err := json.Marshal(e.Event)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling event %s: %w", e.ID, err)
+		}
+		entity := eventEntities[e.Entity]
+		headers := []kgo.RecordHeader{
+			{Key: headerLedger, Value: cursorValue(ledgerSeq)},
+			{Key: headerEntity, Value: []byte(entity)},
+			{Key: headerContentType, Value: []byte(event.ContentType)},
+		}
+		for key, value := range traceContext {
+			headers = append(headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+		}
+		records = append(records, &kgo.Record{
+			Topic:     s.topic(entity),
+			Key:       []byte(e.Key),
+			Value:     payload,
+			Timestamp: e.Time,
+			Headers:   headers,
+		})
+	}
+
+	if sink.IsBackfill(ctx) {
+		return records, nil
+	}
+	records = append(records, &kgo.Record{
+		Topic:     s.cfg.CursorTopic,
+		Key:       []byte(s.cfg.CursorName),
+		Value:     cursorValue(ledgerSeq),
+		Timestamp: timestamp,
+	})
+
+	return records, nil
+}
+
+func (s *KafkaSink) topic(entity string) string {
+	return s.cfg.TopicPrefix + "." + entity
+}
+
+func (s *KafkaSink) entityTopics() []string {
+	topics := make([]string, 0, len(entityNames))
+	for _, entity := range entityNames {
+		topics = append(topics, s.topic(entity))
+	}
+	return topics
+}
@@ -0,0 +1,162 @@
+//go:build integration
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// The tests below run against a real broker (Kafka or Redpanda), e.g.
+//
+//	docker run -d -p 9092:9092 redpandadata/redpanda redpanda start --mode dev-container
+//	KAFKA_BROKERS=localhost:9092 go test -tags integration ./internal/sink/kafka/
+
+func newTestSink(t *testing.T, prefix string) *KafkaSink {
+	t.Helper()
+	brokers := os.Getenv("KAFKA_BROKERS")
+	if brokers == "" {
+		t.Skip("KAFKA_BROKERS is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	s, err := New(ctx, Config{
+		Brokers:         strings.Split(brokers, ","),
+		TopicPrefix:     prefix,
+		TransactionalID: prefix,
+		CreateTopics:    true,
+	})
+	if err != nil {
+		t.Fatalf("creating sink: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func testBuffer(ledgerSeq uint32) *indexer.IndexerBuffer {
+	buffer := indexer.NewIndexerBuffer()
+	buffer.PushTransaction("GACCOUNT", types.Transaction{
+		Hash:            fmt.Sprintf("hash-%d", ledgerSeq),
+		ToID:            int64(ledgerSeq) << 32,
+		LedgerNumber:    ledgerSeq,
+		LedgerCreatedAt: time.Now(),
+	})
+	return buffer
+}
+
+func latestLedger(t *testing.T, s *KafkaSink) uint32 {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ledger, err := s.LatestLedger(ctx)
+	if err != nil {
+		t.Fatalf("getting latest ledger: %v", err)
+	}
+	return ledger
+}
+
+func TestWriteAndResume(t *testing.T) {
+	prefix := fmt.Sprintf("it-%d", time.Now().UnixNano())
+	s := newTestSink(t, prefix)
+	ctx := context.Background()
+
+	if got := latestLedger(t, s); got != 0 {
+		t.Fatalf("latest ledger of an empty cursor topic = %d, want 0", got)
+	}
+	for seq := uint32(100); seq <= 101; seq++ {
+		if err := s.Write(ctx, testBuffer(seq), seq); err != nil {
+			t.Fatalf("writing ledger %d: %v", seq, err)
+		}
+	}
+	if got := latestLedger(t, s); got != 101 {
+		t.Fatalf("latest ledger = %d, want 101", got)
+	}
+
+	// A transaction aborted mid-ledger leaves neither its records nor its cursor visible.
+	records, err := s.buildRecords(ctx, testBuffer(102), 102)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	if err := s.client.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.client.ProduceSync(ctx, records...).FirstErr(); err != nil {
+		t.Fatal(err)
+	}
+	s.abort(ctx, 102)
+	s.mu.Unlock()
+
+	// A new producer with the same transactional ID resumes from the committed cursor.
+	_ = s.Close()
+	restarted := newTestSink(t, prefix)
+	if got := latestLedger(t, restarted); got != 101 {
+		t.Fatalf("latest ledger after restart = %d, want 101", got)
+	}
+
+	ledgers := committedLedgers(t, restarted.cfg.Brokers, restarted.topic(entityTransactions))
+	if want := []string{"100", "101"}; strings.Join(ledgers, ",") != strings.Join(want, ",") {
+		t.Fatalf("committed transaction records of ledgers %v, want %v", ledgers, want)
+	}
+}
+
+func TestWithCursorName(t *testing.T) {
+	s := newTestSink(t, fmt.Sprintf("it-%d", time.Now().UnixNano()))
+	ctx := context.Background()
+
+	testnet := s.WithCursorName("testnet").(*KafkaSink)
+	pubnet := s.WithCursorName("pubnet").(*KafkaSink)
+	if err := testnet.Write(ctx, testBuffer(10), 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := pubnet.Write(ctx, testBuffer(20), 20); err != nil {
+		t.Fatal(err)
+	}
+	if got := latestLedger(t, testnet); got != 10 {
+		t.Fatalf("testnet cursor = %d, want 10", got)
+	}
+	if got := latestLedger(t, pubnet); got != 20 {
+		t.Fatalf("pubnet cursor = %d, want 20", got)
+	}
+}
+
+// committedLedgers returns the ledger header of the records of topic visible to read_committed
+// consumers.
+func committedLedgers(t *testing.T, brokers []string, topic string) []string {
+	t.Helper()
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close()
+
+	var ledgers []string
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		fetches := consumer.PollFetches(ctx)
+		cancel()
+		if fetches.NumRecords() == 0 {
+			return ledgers
+		}
+		fetches.EachRecord(func(r *kgo.Record) {
+			for _, h := range r.Headers {
+				if h.Key == headerLedger {
+					ledgers = append(ledgers, string(h.Value))
+				}
+			}
+		})
+	}
+}
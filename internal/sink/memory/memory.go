@@ -0,0 +1,39 @@
+// Package memory implements a Sink that feeds an in-memory store (see internal/store/memory),
+// so the APIs can serve the ingested ledgers without a database.
+//
+// The store has to be shared with the APIs reading it, so the sink isn't registered by name:
+// it is created with New by the process serving the store (see the demo mode of serve).
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	memstore "github.com/Trustless-Work/Indexer/internal/store/memory"
+)
+
+// MemorySink writes every ledger to an in-memory store.
+type MemorySink struct {
+	store *memstore.Store
+}
+
+var _ sink.Sink = (*MemorySink)(nil)
+
+func New(store *memstore.Store) (*MemorySink, error) {
+	if store == nil {
+		return nil, errors.New("store is required")
+	}
+	return &MemorySink{store: store}, nil
+}
+
+func (s *MemorySink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	if err := s.store.WriteLedger(ctx, buffer, ledgerSeq); err != nil {
+		return fmt.Errorf("storing ledger %d: %w", ledgerSeq, err)
+	}
+	return nil
+}
+
+func (s *MemorySink) Close() error { return nil }
@@ -0,0 +1,112 @@
+package multi
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailureThreshold = 3
+	defaultOpenSeconds      = 30
+)
+
+// BreakerConfig configures the circuit breaker of a sink.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed writes (after retries) that trips
+	// the breaker and marks the sink as degraded.
+	FailureThreshold int `json:"failure_threshold"`
+	// OpenSeconds is how long the breaker stays open before a probe write is attempted.
+	OpenSeconds int `json:"open_seconds"`
+}
+
+func (c *BreakerConfig) setDefaults() {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaultFailureThreshold
+	}
+	if c.OpenSeconds <= 0 {
+		c.OpenSeconds = defaultOpenSeconds
+	}
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker is a consecutive-failures circuit breaker.
+//
+//	closed --(FailureThreshold failures)--> open --(OpenSeconds elapsed)--> half-open
+//	half-open --(success)--> closed
+//	half-open --(failure)--> open
+//
+// Thread-safe.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	cfg                 BreakerConfig
+	state               breakerState
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+func newCircuitBreaker(cfg BreakerConfig) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg}
+}
+
+// Allow reports whether a write may be attempted. An open breaker moves to half-open once
+// the open period is over, allowing a probe write.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < time.Duration(b.cfg.OpenSeconds)*time.Second {
+			return false
+		}
+		b.state = breakerHalfOpen
+		return true
+	default:
+		return true
+	}
+}
+
+// Success records a successful write and closes the breaker.
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.consecutiveFailures = 0
+}
+
+// Failure records a failed write and returns true if the breaker is (or remains) open.
+func (b *circuitBreaker) Failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	if b.state == breakerHalfOpen || b.consecutiveFailures >= b.cfg.FailureThreshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+	return b.state == breakerOpen
+}
+
+// IsOpen reports whether the breaker is open or half-open, i.e. the sink is not trusted.
+func (b *circuitBreaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state != breakerClosed
+}
+
+// ConsecutiveFailures returns the number of failed writes since the last success.
+func (b *circuitBreaker) ConsecutiveFailures() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.consecutiveFailures
+}
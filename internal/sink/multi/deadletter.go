@@ -0,0 +1,153 @@
+package multi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+)
+
+const (
+	deadLetterFileExt = ".json"
+	backfillFileExt   = ".backfill" + deadLetterFileExt
+)
+
+// SpooledLedger is a ledger held by a dead-letter store.
+type SpooledLedger struct {
+	Sequence uint32
+	// Backfill is set for the ledgers of a backfill (see sink.WithBackfill). They are replayed
+	// as backfills, so they don't move the cursor of the sink.
+	Backfill bool
+}
+
+// DeadLetterStore persists the ledgers a sink could not receive, so they can be replayed
+// in order once the sink recovers.
+type DeadLetterStore interface {
+	// Put stores the buffer of a ledger, as a backfill when ctx is one.
+	Put(ctx context.Context, ledgerSeq uint32, buffer indexer.IndexerBufferInterface) error
+	// Ledgers returns the stored ledgers in ascending order of sequence.
+	Ledgers(ctx context.Context) ([]SpooledLedger, error)
+	// Get returns the buffer stored for a ledger.
+	Get(ctx context.Context, ledger SpooledLedger) (*indexer.IndexerBuffer, error)
+	// Delete removes a ledger from the store once it was delivered.
+	Delete(ctx context.Context, ledger SpooledLedger) error
+}
+
+// fileDeadLetterStore spools each ledger to its own JSON file: <dir>/<ledger>.json, or
+// <dir>/<ledger>.backfill.json for the ledgers of a backfill.
+type fileDeadLetterStore struct {
+	dir string
+}
+
+var _ DeadLetterStore = (*fileDeadLetterStore)(nil)
+
+// NewFileDeadLetterStore creates a dead-letter store backed by the local directory dir.
+func NewFileDeadLetterStore(dir string) (*fileDeadLetterStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating dead-letter directory %s: %w", dir, err)
+	}
+	return &fileDeadLetterStore{dir: dir}, nil
+}
+
+// Put writes the buffer to a temporary file and renames it, so a crash never leaves a
+// partially written ledger behind.
+func (s *fileDeadLetterStore) Put(ctx context.Context, ledgerSeq uint32, buffer indexer.IndexerBufferInterface) error {
+	data, err := json.Marshal(buffer)
+	if err != nil {
+		return fmt.Errorf("marshalling buffer of ledger %d: %w", ledgerSeq, err)
+	}
+
+	tmpFile, err := os.CreateTemp(s.dir, "spool-*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating temporary dead-letter file: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("writing dead-letter file: %w", err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("syncing dead-letter file: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("closing dead-letter file: %w", err)
+	}
+
+	ledger := SpooledLedger{Sequence: ledgerSeq, Backfill: sink.IsBackfill(ctx)}
+	if err := os.Rename(tmpPath, s.path(ledger)); err != nil {
+		return fmt.Errorf("moving dead-letter file of ledger %d: %w", ledgerSeq, err)
+	}
+	return nil
+}
+
+func (s *fileDeadLetterStore) Ledgers(ctx context.Context) ([]SpooledLedger, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading dead-letter directory %s: %w", s.dir, err)
+	}
+
+	ledgers := make([]SpooledLedger, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasSuffix(name, deadLetterFileExt) {
+			continue
+		}
+		ledger := SpooledLedger{Backfill: strings.HasSuffix(name, backfillFileExt)}
+		ext := deadLetterFileExt
+		if ledger.Backfill {
+			ext = backfillFileExt
+		}
+		ledgerSeq, parseErr := strconv.ParseUint(strings.TrimSuffix(name, ext), 10, 32)
+		if parseErr != nil {
+			continue
+		}
+		ledger.Sequence = uint32(ledgerSeq)
+		ledgers = append(ledgers, ledger)
+	}
+
+	sort.Slice(ledgers, func(i, j int) bool {
+		if ledgers[i].Sequence != ledgers[j].Sequence {
+			return ledgers[i].Sequence < ledgers[j].Sequence
+		}
+		return ledgers[i].Backfill && !ledgers[j].Backfill
+	})
+	return ledgers, nil
+}
+
+func (s *fileDeadLetterStore) Get(ctx context.Context, ledger SpooledLedger) (*indexer.IndexerBuffer, error) {
+	data, err := os.ReadFile(s.path(ledger))
+	if err != nil {
+		return nil, fmt.Errorf("reading dead-letter file of ledger %d: %w", ledger.Sequence, err)
+	}
+
+	buffer := indexer.NewIndexerBuffer()
+	if err := json.Unmarshal(data, buffer); err != nil {
+		return nil, fmt.Errorf("unmarshalling buffer of ledger %d: %w", ledger.Sequence, err)
+	}
+	return buffer, nil
+}
+
+func (s *fileDeadLetterStore) Delete(ctx context.Context, ledger SpooledLedger) error {
+	if err := os.Remove(s.path(ledger)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing dead-letter file of ledger %d: %w", ledger.Sequence, err)
+	}
+	return nil
+}
+
+func (s *fileDeadLetterStore) path(ledger SpooledLedger) string {
+	ext := deadLetterFileExt
+	if ledger.Backfill {
+		ext = backfillFileExt
+	}
+	return filepath.Join(s.dir, fmt.Sprintf("%010d%s", ledger.Sequence, ext))
+}
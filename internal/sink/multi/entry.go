@@ -0,0 +1,273 @@
+package multi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/sink/route"
+	"github.com/Trustless-Work/Indexer/internal/tracing"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+// entry wraps one destination of the MultiSink with its retry policy, circuit breaker and
+// dead-letter store.
+type entry struct {
+	name       string
+	sinkType   string
+	sink       sink.Sink
+	required   bool
+	retry      RetryPolicy
+	breaker    *circuitBreaker
+	deadLetter DeadLetterStore
+	// router, when set, narrows every ledger to the entities and fields of this sink before
+	// it is written or spooled.
+	router *route.Router
+
+	// replayMu serializes the replays of the background loop and of Flush.
+	replayMu sync.Mutex
+	// writeMu serializes the writes, so ledgers always reach the sink or the dead-letter
+	// store in order. Unlike mu, it is held while a write is retried.
+	writeMu sync.Mutex
+
+	// mu guards the fields below.
+	mu          sync.Mutex
+	pending     int
+	lastSpooled uint32
+	lastLedger  uint32
+	lastErr     error
+	lastErrAt   time.Time
+}
+
+// write delivers the ledger to the sink, or spools it when the sink is degraded or still
+// has spooled ledgers waiting to be replayed (to keep the ledger order).
+func (e *entry) write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	if e.router != nil {
+		routed, err := e.router.Apply(buffer)
+		if err != nil {
+			return fmt.Errorf("routing ledger %d: %w", ledgerSeq, err)
+		}
+		buffer = routed
+	}
+
+	e.writeMu.Lock()
+	defer e.writeMu.Unlock()
+
+	e.mu.Lock()
+	if e.pending > 0 {
+		defer e.mu.Unlock()
+		return e.spool(ctx, buffer, ledgerSeq)
+	}
+	if !e.breaker.Allow() {
+		defer e.mu.Unlock()
+		if e.deadLetter == nil {
+			return fmt.Errorf("circuit breaker open for ledger %d: %w", ledgerSeq, e.lastErr)
+		}
+		return e.spool(ctx, buffer, ledgerSeq)
+	}
+	e.mu.Unlock()
+
+	// mu is released during the retries, so the health of the sink and the replay loop don't
+	// wait for the backoff.
+	err := e.writeWithRetry(ctx, buffer, ledgerSeq)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err == nil {
+		e.breaker.Success()
+		if !sink.IsBackfill(ctx) {
+			e.lastLedger = ledgerSeq
+		}
+		return nil
+	}
+
+	e.lastErr = err
+	e.lastErrAt = time.Now()
+	if e.breaker.Failure() {
+		log.Ctx(ctx).Errorf("multi sink: circuit breaker tripped for sink %q, marking it as degraded: %v", e.name, err)
+	}
+	if e.deadLetter == nil {
+		return fmt.Errorf("writing ledger %d: %w", ledgerSeq, err)
+	}
+
+	log.Ctx(ctx).Warnf("multi sink: sink %q failed for ledger %d, spooling it to the dead-letter store: %v", e.name, ledgerSeq, err)
+	return e.spool(ctx, buffer, ledgerSeq)
+}
+
+// spool stores the ledger in the dead-letter store. Caller must hold e.mu.
+func (e *entry) spool(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	if err := e.deadLetter.Put(ctx, ledgerSeq, buffer); err != nil {
+		return fmt.Errorf("spooling ledger %d to the dead-letter store: %w", ledgerSeq, err)
+	}
+	e.pending++
+	if !sink.IsBackfill(ctx) {
+		e.lastSpooled = ledgerSeq
+	}
+	metrics.DeadLetterSpooled.WithLabelValues(e.name).Inc()
+	metrics.DeadLetterPending.WithLabelValues(e.name).Set(float64(e.pending))
+	return nil
+}
+
+// writeWithRetry writes the ledger to the sink according to the retry policy.
+func (e *entry) writeWithRetry(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	ctx, span := tracing.Start(ctx, "SinkWrite",
+		tracing.AttrSink.String(e.name),
+		tracing.AttrSinkType.String(e.sinkType),
+		tracing.AttrLedgerSequence.Int64(int64(ledgerSeq)),
+	)
+	attempts := 0
+	err := e.retry.Do(ctx, func() error {
+		attempts++
+		return e.sink.Write(ctx, buffer, ledgerSeq)
+	})
+	span.SetAttributes(tracing.AttrSinkAttempts.Int(attempts))
+	tracing.End(span, err)
+	if attempts > 1 {
+		metrics.SinkWriteRetries.WithLabelValues(e.name).Add(float64(attempts - 1))
+	}
+	if err != nil {
+		metrics.SinkWriteErrors.WithLabelValues(e.name).Inc()
+	}
+	return err
+}
+
+// runReplay replays the dead-letter store every interval until ctx is cancelled.
+func (e *entry) runReplay(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.replay(ctx)
+		}
+	}
+}
+
+// replay writes the spooled ledgers to the sink in ascending order, removing each ledger from
+// the store once delivered. It stops at the first failure and leaves the rest for the next run.
+// The ledgers of a backfill are replayed as backfills and don't move the last ledger.
+func (e *entry) replay(ctx context.Context) {
+	e.replayMu.Lock()
+	defer e.replayMu.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		e.mu.Lock()
+		if e.pending == 0 || !e.breaker.Allow() {
+			e.mu.Unlock()
+			return
+		}
+		e.mu.Unlock()
+
+		ledgers, err := e.deadLetter.Ledgers(ctx)
+		if err != nil {
+			log.Ctx(ctx).Errorf("multi sink: listing dead-letter ledgers of sink %q: %v", e.name, err)
+			return
+		}
+		if len(ledgers) == 0 {
+			e.mu.Lock()
+			e.pending = 0
+			metrics.DeadLetterPending.WithLabelValues(e.name).Set(0)
+			e.mu.Unlock()
+			return
+		}
+
+		ledger := ledgers[0]
+		ledgerSeq := ledger.Sequence
+		buffer, err := e.deadLetter.Get(ctx, ledger)
+		if err != nil {
+			log.Ctx(ctx).Errorf("multi sink: reading dead-letter ledger %d of sink %q: %v", ledgerSeq, e.name, err)
+			return
+		}
+
+		writeCtx := ctx
+		if ledger.Backfill {
+			writeCtx = sink.WithBackfill(ctx)
+		}
+		err = e.writeWithRetry(writeCtx, buffer, ledgerSeq)
+		if err != nil {
+			e.mu.Lock()
+			e.lastErr = err
+			e.lastErrAt = time.Now()
+			e.breaker.Failure()
+			e.mu.Unlock()
+			log.Ctx(ctx).Warnf("multi sink: replaying ledger %d to sink %q failed: %v", ledgerSeq, e.name, err)
+			return
+		}
+		e.breaker.Success()
+
+		if err := e.deadLetter.Delete(ctx, ledger); err != nil {
+			log.Ctx(ctx).Errorf("multi sink: removing replayed ledger %d of sink %q: %v", ledgerSeq, e.name, err)
+			return
+		}
+
+		e.mu.Lock()
+		e.pending--
+		if !ledger.Backfill {
+			e.lastLedger = ledgerSeq
+		}
+		remaining := e.pending
+		metrics.DeadLetterPending.WithLabelValues(e.name).Set(float64(remaining))
+		e.mu.Unlock()
+		metrics.DeadLetterReplayed.WithLabelValues(e.name).Inc()
+
+		if remaining == 0 {
+			log.Ctx(ctx).Infof("multi sink: sink %q recovered, dead-letter store fully replayed up to ledger %d", e.name, ledgerSeq)
+		}
+	}
+}
+
+func (e *entry) lastSpooledLedger() uint32 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.pending == 0 {
+		return 0
+	}
+	return e.lastSpooled
+}
+
+func (e *entry) health() sink.Health {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	state := sink.StateHealthy
+	switch {
+	case e.breaker.IsOpen():
+		state = sink.StateDegraded
+	case e.pending > 0:
+		state = sink.StateRecovering
+	}
+
+	h := sink.Health{
+		Name:                e.name,
+		Type:                e.sinkType,
+		State:               state,
+		Required:            e.required,
+		LastLedger:          e.lastLedger,
+		ConsecutiveFailures: e.breaker.ConsecutiveFailures(),
+		DeadLetterLedgers:   e.pending,
+	}
+	if e.lastErr != nil {
+		h.LastError = e.lastErr.Error()
+		h.LastErrorAt = e.lastErrAt
+	}
+	return h
+}
+
+func (e *entry) pendingLedgers() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.pending
+}
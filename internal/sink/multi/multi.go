@@ -0,0 +1,342 @@
+// Package multi implements a Sink that fans out every ledger to several sinks in parallel.
+//
+// Each destination is isolated from the others:
+//   - Writes are retried according to the destination's RetryPolicy.
+//   - Consecutive failures trip a circuit breaker that marks the destination as degraded.
+//   - While a destination is degraded (or still has a backlog), its ledgers are spooled to a
+//     local dead-letter store instead of stalling ingestion for the healthy destinations.
+//   - A background loop replays the dead-letter store, in ledger order, as soon as the
+//     destination accepts writes again.
+//
+// The status of every destination is exposed through sink.HealthReporter.
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/metrics"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/Trustless-Work/Indexer/internal/sink/route"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const defaultReplayIntervalSeconds = 5
+
+func init() {
+	sink.Register("multi", func(cfg map[string]any) (sink.Sink, error) {
+		var multiCfg Config
+		if err := sink.DecodeConfig(cfg, &multiCfg); err != nil {
+			return nil, err
+		}
+		return Open(multiCfg)
+	})
+}
+
+// Config is the declarative configuration of a MultiSink.
+type Config struct {
+	// DeadLetterDir enables the dead-letter store. Each sink spools to <DeadLetterDir>/<name>.
+	// When empty, failed writes are returned to the caller (required sinks) or dropped (optional sinks).
+	DeadLetterDir string `json:"dead_letter_dir"`
+	// ReplayIntervalSeconds is how often spooled ledgers are retried.
+	ReplayIntervalSeconds int `json:"replay_interval_seconds"`
+	// Sinks are the destinations to fan out to.
+	Sinks []SinkConfig `json:"sinks"`
+}
+
+// SinkConfig configures one destination of a MultiSink.
+type SinkConfig struct {
+	// Name identifies the destination in logs, health reports and the dead-letter store.
+	// Defaults to Type.
+	Name string `json:"name"`
+	// Type is the registered sink name, e.g. "kafka".
+	Type string `json:"type"`
+	// Optional destinations never fail the write, errors are only logged.
+	Optional bool `json:"optional"`
+	// Options is passed to the sink factory.
+	Options        map[string]any `json:"options"`
+	Retry          RetryPolicy    `json:"retry"`
+	CircuitBreaker BreakerConfig  `json:"circuit_breaker"`
+	// Route selects the entities and fields sent to this destination. Nil sends everything.
+	Route *route.Config `json:"route"`
+}
+
+// MultiSink implements sink.Sink and writes to all its sinks in parallel.
+// It is transparent to the pipeline, the caller doesn't know there are several destinations.
+type MultiSink struct {
+	entries        []*entry
+	replayInterval time.Duration
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+var (
+	_ sink.Sink           = (*MultiSink)(nil)
+	_ sink.HealthChecker  = (*MultiSink)(nil)
+	_ sink.HealthReporter = (*MultiSink)(nil)
+	_ sink.Cursor         = (*MultiSink)(nil)
+	_ sink.Flusher        = (*MultiSink)(nil)
+)
+
+// New creates a MultiSink where every sink is required and uses the default retry and
+// circuit breaker policies, without dead-letter store.
+func New(sinks ...sink.Sink) *MultiSink {
+	m := &MultiSink{replayInterval: defaultReplayIntervalSeconds * time.Second}
+	for _, s := range sinks {
+		m.add(s, fmt.Sprintf("%T", s), "", false, RetryPolicy{}, BreakerConfig{}, nil, nil)
+	}
+	return m
+}
+
+// WithOptional adds a sink that is not required, its failures are logged but not propagated.
+func (m *MultiSink) WithOptional(s sink.Sink) *MultiSink {
+	m.add(s, fmt.Sprintf("%T", s), "", true, RetryPolicy{}, BreakerConfig{}, nil, nil)
+	return m
+}
+
+// Open creates every configured sink through the sink registry and starts the dead-letter
+// replay loops.
+func Open(cfg Config) (*MultiSink, error) {
+	if len(cfg.Sinks) == 0 {
+		return nil, errors.New("multi sink requires at least one sink")
+	}
+
+	replayInterval := defaultReplayIntervalSeconds * time.Second
+	if cfg.ReplayIntervalSeconds > 0 {
+		replayInterval = time.Duration(cfg.ReplayIntervalSeconds) * time.Second
+	}
+	m := &MultiSink{replayInterval: replayInterval}
+
+	names := make(map[string]struct{}, len(cfg.Sinks))
+	for _, sinkCfg := range cfg.Sinks {
+		name := sinkCfg.Name
+		if name == "" {
+			name = sinkCfg.Type
+		}
+		if _, exists := names[name]; exists {
+			m.closeSinks()
+			return nil, fmt.Errorf("duplicated sink name %q, set a unique name for each sink", name)
+		}
+		names[name] = struct{}{}
+
+		var router *route.Router
+		if sinkCfg.Route != nil {
+			r, err := route.NewRouter(*sinkCfg.Route)
+			if err != nil {
+				m.closeSinks()
+				return nil, fmt.Errorf("configuring route of sink %q: %w", name, err)
+			}
+			router = r
+		}
+
+		var deadLetter DeadLetterStore
+		if cfg.DeadLetterDir != "" {
+			store, err := NewFileDeadLetterStore(filepath.Join(cfg.DeadLetterDir, name))
+			if err != nil {
+				m.closeSinks()
+				return nil, fmt.Errorf("creating dead-letter store for sink %q: %w", name, err)
+			}
+			deadLetter = store
+		}
+
+		s, err := sink.Open(sinkCfg.Type, sinkCfg.Options)
+		if err != nil {
+			m.closeSinks()
+			return nil, fmt.Errorf("opening sink %q: %w", name, err)
+		}
+
+		if err := m.add(s, name, sinkCfg.Type, sinkCfg.Optional, sinkCfg.Retry, sinkCfg.CircuitBreaker, deadLetter, router); err != nil {
+			m.closeSinks()
+			return nil, err
+		}
+	}
+
+	m.startReplay()
+	return m, nil
+}
+
+func (m *MultiSink) add(s sink.Sink, name, sinkType string, optional bool, retry RetryPolicy, breaker BreakerConfig, deadLetter DeadLetterStore, router *route.Router) error {
+	retry.setDefaults()
+	breaker.setDefaults()
+
+	e := &entry{
+		name:       name,
+		sinkType:   sinkType,
+		sink:       s,
+		required:   !optional,
+		retry:      retry,
+		breaker:    newCircuitBreaker(breaker),
+		deadLetter: deadLetter,
+		router:     router,
+	}
+
+	if deadLetter != nil {
+		ledgers, err := deadLetter.Ledgers(context.Background())
+		if err != nil {
+			return fmt.Errorf("listing dead-letter ledgers of sink %q: %w", name, err)
+		}
+		e.pending = len(ledgers)
+		metrics.DeadLetterPending.WithLabelValues(name).Set(float64(e.pending))
+		for _, ledger := range ledgers {
+			if !ledger.Backfill {
+				e.lastSpooled = ledger.Sequence
+			}
+		}
+		if e.pending > 0 {
+			log.Warnf("multi sink: sink %q has %d ledgers in its dead-letter store, they will be replayed", name, e.pending)
+		}
+	}
+
+	m.entries = append(m.entries, e)
+	return nil
+}
+
+// startReplay starts a replay loop for every sink backed by a dead-letter store.
+func (m *MultiSink) startReplay() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	for _, e := range m.entries {
+		if e.deadLetter == nil {
+			continue
+		}
+		m.wg.Add(1)
+		go func(e *entry) {
+			defer m.wg.Done()
+			e.runReplay(ctx, m.replayInterval)
+		}(e)
+	}
+}
+
+// Write writes the ledger to every sink in parallel and waits for all of them.
+// A degraded sink doesn't slow down the others: its ledger is spooled to the dead-letter store.
+// The duration of each write is recorded in the write timings of ctx, see sink.WithWriteTimings.
+func (m *MultiSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, e := range m.entries {
+		wg.Add(1)
+		go func(e *entry) {
+			defer wg.Done()
+			start := time.Now()
+			err := e.write(ctx, buffer, ledgerSeq)
+			sink.RecordWriteDuration(ctx, e.name, time.Since(start))
+			if err != nil {
+				if !e.required {
+					log.Ctx(ctx).Errorf("multi sink: optional sink %q failed for ledger %d: %v", e.name, ledgerSeq, err)
+					return
+				}
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("sink %q: %w", e.name, err))
+				mu.Unlock()
+			}
+		}(e)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Ping pings every sink that supports it. Only required sinks make the ping fail.
+func (m *MultiSink) Ping(ctx context.Context) error {
+	var errs []error
+	for _, e := range m.entries {
+		checker, ok := e.sink.(sink.HealthChecker)
+		if !ok || !e.required {
+			continue
+		}
+		if err := checker.Ping(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("sink %q: %w", e.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Health reports the status of every sink.
+func (m *MultiSink) Health(ctx context.Context) []sink.Health {
+	health := make([]sink.Health, 0, len(m.entries))
+	for _, e := range m.entries {
+		health = append(health, e.health())
+	}
+	return health
+}
+
+// Flush replays the dead-letter stores right away and flushes the sinks that support it. It
+// fails when a required sink can't be flushed or still has spooled ledgers, e.g. because its
+// circuit breaker is still open.
+func (m *MultiSink) Flush(ctx context.Context) error {
+	var errs []error
+	for _, e := range m.entries {
+		if e.deadLetter != nil {
+			e.replay(ctx)
+		}
+		if flusher, ok := e.sink.(sink.Flusher); ok {
+			if err := flusher.Flush(ctx); err != nil {
+				if !e.required {
+					log.Ctx(ctx).Errorf("multi sink: flushing optional sink %q: %v", e.name, err)
+					continue
+				}
+				errs = append(errs, fmt.Errorf("flushing sink %q: %w", e.name, err))
+				continue
+			}
+		}
+		if pending := e.pendingLedgers(); pending > 0 && e.required {
+			errs = append(errs, fmt.Errorf("sink %q still has %d ledgers in its dead-letter store", e.name, pending))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LatestLedger returns the lowest ledger committed by the sinks that track a cursor.
+// Ledgers spooled to the dead-letter store count as committed since they will be replayed,
+// so restarting ingestion never re-delivers them to the healthy sinks.
+func (m *MultiSink) LatestLedger(ctx context.Context) (uint32, error) {
+	var (
+		latest uint32
+		found  bool
+	)
+	for _, e := range m.entries {
+		cursor, ok := e.sink.(sink.Cursor)
+		if !ok {
+			continue
+		}
+		ledger, err := cursor.LatestLedger(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("getting latest ledger of sink %q: %w", e.name, err)
+		}
+		if spooled := e.lastSpooledLedger(); spooled > ledger {
+			ledger = spooled
+		}
+		if !found || ledger < latest {
+			latest = ledger
+			found = true
+		}
+	}
+	return latest, nil
+}
+
+// Close stops the replay loops and closes every sink.
+func (m *MultiSink) Close() error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+	return m.closeSinks()
+}
+
+func (m *MultiSink) closeSinks() error {
+	var errs []error
+	for _, e := range m.entries {
+		if err := e.sink.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("closing sink %q: %w", e.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,315 @@
+package multi
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+)
+
+var errUnavailable = errors.New("sink unavailable")
+
+// fakeSink records the ledgers written to it, backfills apart, and fails while fail is set.
+// When block is set, writes wait for it to be closed.
+type fakeSink struct {
+	mu         sync.Mutex
+	fail       bool
+	block      chan struct{}
+	calls      int
+	written    []uint32
+	backfilled []uint32
+}
+
+func (s *fakeSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	s.mu.Lock()
+	s.calls++
+	block := s.block
+	s.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return errUnavailable
+	}
+	if sink.IsBackfill(ctx) {
+		s.backfilled = append(s.backfilled, ledgerSeq)
+		return nil
+	}
+	s.written = append(s.written, ledgerSeq)
+	return nil
+}
+
+func (s *fakeSink) Close() error { return nil }
+
+func (s *fakeSink) setFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+func (s *fakeSink) ledgers() []uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.written)
+}
+
+// cursorSink is a fakeSink committing a cursor.
+type cursorSink struct {
+	fakeSink
+	cursor uint32
+}
+
+func (s *cursorSink) LatestLedger(ctx context.Context) (uint32, error) {
+	return s.cursor, nil
+}
+
+// newTestMultiSink creates a MultiSink writing once, without backoff, to sinks whose breaker
+// trips at the first failure. With deadLetter, each sink spools to its own directory.
+func newTestMultiSink(t *testing.T, deadLetter bool, sinks ...sink.Sink) *MultiSink {
+	t.Helper()
+	m := &MultiSink{replayInterval: time.Hour}
+	for i, s := range sinks {
+		var store DeadLetterStore
+		if deadLetter {
+			var err error
+			if store, err = NewFileDeadLetterStore(t.TempDir()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		name := string(rune('a' + i))
+		retry := RetryPolicy{MaxAttempts: 1, InitialBackoffMs: 1}
+		if err := m.add(s, name, "fake", false, retry, BreakerConfig{FailureThreshold: 1}, store, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+// expireBreaker ends the open period of the breaker, so the next write is a probe.
+func expireBreaker(e *entry) {
+	e.breaker.mu.Lock()
+	e.breaker.openedAt = time.Now().Add(-time.Hour)
+	e.breaker.mu.Unlock()
+}
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	b := newCircuitBreaker(BreakerConfig{FailureThreshold: 2, OpenSeconds: 30})
+
+	if !b.Allow() || b.IsOpen() {
+		t.Fatal("a new breaker must be closed")
+	}
+	if b.Failure() {
+		t.Fatal("breaker opened before the failure threshold")
+	}
+	if !b.Failure() {
+		t.Fatal("breaker still closed at the failure threshold")
+	}
+	if b.Allow() {
+		t.Fatal("open breaker allowed a write during the open period")
+	}
+
+	b.openedAt = time.Now().Add(-time.Minute)
+	if !b.Allow() || !b.IsOpen() {
+		t.Fatal("breaker must be half-open after the open period")
+	}
+	if !b.Failure() || b.Allow() {
+		t.Fatal("a failed probe must open the breaker again")
+	}
+
+	b.openedAt = time.Now().Add(-time.Minute)
+	b.Allow()
+	b.Success()
+	if b.IsOpen() || b.ConsecutiveFailures() != 0 {
+		t.Fatal("a successful probe must close the breaker and reset the failures")
+	}
+}
+
+func TestSpoolAndReplayInOrder(t *testing.T) {
+	ctx := context.Background()
+	s := &fakeSink{fail: true}
+	m := newTestMultiSink(t, true, s)
+	e := m.entries[0]
+
+	// Ledger 10 fails and trips the breaker, 11 is spooled while the breaker is open.
+	for seq := uint32(10); seq <= 11; seq++ {
+		if err := m.Write(ctx, indexer.NewIndexerBuffer(), seq); err != nil {
+			t.Fatalf("writing ledger %d: %v", seq, err)
+		}
+	}
+	if h := m.Health(ctx)[0]; h.State != sink.StateDegraded || h.DeadLetterLedgers != 2 {
+		t.Fatalf("health = %+v, want degraded with 2 spooled ledgers", h)
+	}
+
+	// Once the sink recovers, ledgers keep being spooled until the backlog is replayed.
+	s.setFail(false)
+	expireBreaker(e)
+	if err := m.Write(ctx, indexer.NewIndexerBuffer(), 12); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ledgers(); len(got) != 0 {
+		t.Fatalf("sink received %v ahead of the spooled ledgers", got)
+	}
+
+	e.replay(ctx)
+	if err := m.Write(ctx, indexer.NewIndexerBuffer(), 13); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.ledgers(), []uint32{10, 11, 12, 13}; !slices.Equal(got, want) {
+		t.Fatalf("sink received %v, want %v", got, want)
+	}
+	if h := m.Health(ctx)[0]; h.State != sink.StateHealthy || h.DeadLetterLedgers != 0 || h.LastLedger != 13 {
+		t.Fatalf("health = %+v, want healthy at ledger 13", h)
+	}
+}
+
+func TestReplayBackfillLedgers(t *testing.T) {
+	ctx := context.Background()
+	s := &cursorSink{cursor: 9}
+	s.fail = true
+	m := newTestMultiSink(t, true, s)
+	e := m.entries[0]
+
+	// Live ledger 10 trips the breaker, then ledgers 4 and 5 of a backfill are spooled.
+	if err := m.Write(ctx, indexer.NewIndexerBuffer(), 10); err != nil {
+		t.Fatal(err)
+	}
+	for seq := uint32(4); seq <= 5; seq++ {
+		if err := m.Write(sink.WithBackfill(ctx), indexer.NewIndexerBuffer(), seq); err != nil {
+			t.Fatalf("writing backfill ledger %d: %v", seq, err)
+		}
+	}
+	if got, err := m.LatestLedger(ctx); err != nil || got != 10 {
+		t.Fatalf("LatestLedger = %d, %v, want the last spooled live ledger 10", got, err)
+	}
+
+	// The store remembers the backfills, e.g. across a restart.
+	ledgers, err := e.deadLetter.Ledgers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []SpooledLedger{{Sequence: 4, Backfill: true}, {Sequence: 5, Backfill: true}, {Sequence: 10}}
+	if !slices.Equal(ledgers, want) {
+		t.Fatalf("spooled ledgers = %v, want %v", ledgers, want)
+	}
+
+	s.setFail(false)
+	expireBreaker(e)
+	e.replay(ctx)
+	s.mu.Lock()
+	backfilled, written := slices.Clone(s.backfilled), slices.Clone(s.written)
+	s.mu.Unlock()
+	if !slices.Equal(backfilled, []uint32{4, 5}) || !slices.Equal(written, []uint32{10}) {
+		t.Fatalf("sink received backfills %v and live ledgers %v, want [4 5] and [10]", backfilled, written)
+	}
+	if h := m.Health(ctx)[0]; h.DeadLetterLedgers != 0 || h.LastLedger != 10 {
+		t.Fatalf("health = %+v, want no spooled ledger and last ledger 10", h)
+	}
+}
+
+func TestReplayStopsAtFirstFailure(t *testing.T) {
+	ctx := context.Background()
+	s := &fakeSink{fail: true}
+	m := newTestMultiSink(t, true, s)
+	e := m.entries[0]
+	for seq := uint32(10); seq <= 12; seq++ {
+		if err := m.Write(ctx, indexer.NewIndexerBuffer(), seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expireBreaker(e)
+	e.replay(ctx)
+	if e.pendingLedgers() != 3 || !e.breaker.IsOpen() {
+		t.Fatalf("failed replay left %d pending ledgers, want 3 and an open breaker", e.pendingLedgers())
+	}
+	if err := m.Flush(ctx); err == nil {
+		t.Fatal("Flush must fail while a required sink has spooled ledgers")
+	}
+}
+
+func TestWriteWithoutDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	required := &fakeSink{fail: true}
+	optional := &fakeSink{fail: true}
+	m := newTestMultiSink(t, false, required)
+	m.WithOptional(optional)
+
+	err := m.Write(ctx, indexer.NewIndexerBuffer(), 10)
+	if !errors.Is(err, errUnavailable) {
+		t.Fatalf("Write = %v, want the error of the required sink", err)
+	}
+
+	required.setFail(false)
+	if err := m.Write(ctx, indexer.NewIndexerBuffer(), 11); err == nil {
+		t.Fatal("Write must fail while the breaker of a required sink without dead-letter store is open")
+	}
+}
+
+func TestLatestLedger(t *testing.T) {
+	ctx := context.Background()
+	ahead := &cursorSink{cursor: 100}
+	behind := &cursorSink{cursor: 90}
+	behind.fail = true
+	m := newTestMultiSink(t, true, ahead, behind, &fakeSink{})
+
+	if got, err := m.LatestLedger(ctx); err != nil || got != 90 {
+		t.Fatalf("LatestLedger = %d, %v, want the lowest cursor 90", got, err)
+	}
+
+	// The ledgers spooled for a sink count as committed, as they will be replayed.
+	for seq := uint32(91); seq <= 95; seq++ {
+		if err := m.Write(ctx, indexer.NewIndexerBuffer(), seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, err := m.LatestLedger(ctx); err != nil || got != 95 {
+		t.Fatalf("LatestLedger = %d, %v, want the last spooled ledger 95", got, err)
+	}
+
+	ahead.cursor = 93
+	if got, err := m.LatestLedger(ctx); err != nil || got != 93 {
+		t.Fatalf("LatestLedger = %d, %v, want the lowest cursor 93", got, err)
+	}
+}
+
+func TestHealthDuringRetriedWrite(t *testing.T) {
+	ctx := context.Background()
+	s := &fakeSink{block: make(chan struct{})}
+	m := newTestMultiSink(t, true, s)
+
+	written := make(chan error, 1)
+	go func() { written <- m.Write(ctx, indexer.NewIndexerBuffer(), 10) }()
+	for deadline := time.Now().Add(time.Second); ; {
+		s.mu.Lock()
+		calls := s.calls
+		s.mu.Unlock()
+		if calls > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the write never reached the sink")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	health := make(chan []sink.Health, 1)
+	go func() { health <- m.Health(ctx) }()
+	select {
+	case <-health:
+	case <-time.After(time.Second):
+		t.Fatal("Health blocked on a write in progress")
+	}
+
+	close(s.block)
+	if err := <-written; err != nil {
+		t.Fatal(err)
+	}
+}
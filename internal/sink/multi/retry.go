@@ -0,0 +1,55 @@
+package multi
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const (
+	defaultMaxAttempts       = 3
+	defaultInitialBackoffMs  = 500
+	defaultMaxBackoffMs      = 10_000
+	defaultBackoffMultiplier = 2.0
+)
+
+// RetryPolicy controls how many times a failed write is retried before it counts as a
+// failure for the circuit breaker.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int `json:"max_attempts"`
+	// InitialBackoffMs is the wait before the first retry.
+	InitialBackoffMs int `json:"initial_backoff_ms"`
+	// MaxBackoffMs caps the exponential backoff between attempts.
+	MaxBackoffMs int `json:"max_backoff_ms"`
+	// Multiplier grows the backoff after each attempt.
+	Multiplier float64 `json:"multiplier"`
+}
+
+func (p *RetryPolicy) setDefaults() {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+	if p.InitialBackoffMs <= 0 {
+		p.InitialBackoffMs = defaultInitialBackoffMs
+	}
+	if p.MaxBackoffMs <= 0 {
+		p.MaxBackoffMs = defaultMaxBackoffMs
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = defaultBackoffMultiplier
+	}
+}
+
+// Do runs op until it succeeds, the attempts are exhausted or ctx is cancelled.
+// The returned error is the last error returned by op.
+func (p RetryPolicy) Do(ctx context.Context, op func() error) error {
+	bo := backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(time.Duration(p.InitialBackoffMs)*time.Millisecond),
+		backoff.WithMaxInterval(time.Duration(p.MaxBackoffMs)*time.Millisecond),
+		backoff.WithMultiplier(p.Multiplier),
+		backoff.WithMaxElapsedTime(0),
+	)
+	return backoff.Retry(op, backoff.WithContext(backoff.WithMaxRetries(bo, uint64(p.MaxAttempts-1)), ctx))
+}
@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+func init() {
+	sink.Register("noop", func(cfg map[string]any) (sink.Sink, error) {
+		return &NoopSink{}, nil
+	})
+}
+
+// NoopSink discards every buffer. Used for development and as the default sink.
+type NoopSink struct{}
+
+var (
+	_ sink.Sink         = (*NoopSink)(nil)
+	_ sink.CursorScoper = (*NoopSink)(nil)
+)
+
+func (n *NoopSink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	log.Ctx(ctx).Debugf("noop sink: discarding buffer for ledger %d", ledgerSeq)
+	return nil
+}
+
+func (n *NoopSink) Close() error { return nil }
+
+// WithCursorName returns the sink itself: it keeps no cursor.
+func (n *NoopSink) WithCursorName(string) sink.Sink { return n }
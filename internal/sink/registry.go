@@ -0,0 +1,69 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Factory creates a Sink from its generic configuration.
+// Each implementation decodes the fields it needs from cfg.
+type Factory func(cfg map[string]any) (Sink, error)
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]Factory)
+)
+
+// Register makes a sink factory available under the provided name.
+// It is typically called from the init() function of each implementation package.
+// It panics if the name is registered twice, the same way database/sql does.
+func Register(name string, f Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if f == nil {
+		panic("sink: Register factory is nil")
+	}
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("sink: Register called twice for driver %q", name))
+	}
+	registry[name] = f
+}
+
+// Open creates a Sink by name using the registered factory.
+func Open(name string, cfg map[string]any) (Sink, error) {
+	mu.RLock()
+	f, ok := registry[name]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("sink %q not registered; did you import the package?", name)
+	}
+	return f(cfg)
+}
+
+// Drivers returns a sorted list of the names of the registered sinks.
+func Drivers() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DecodeConfig decodes the generic sink configuration into the implementation specific
+// config struct, using the struct's json tags as keys.
+func DecodeConfig(cfg map[string]any, out any) error {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("marshalling sink config: %w", err)
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("decoding sink config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package route
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+// RedactedValue replaces the value of redacted string fields.
+const RedactedValue = "REDACTED"
+
+var entityTypes = map[Entity]reflect.Type{
+	EntityTransactions:     reflect.TypeOf(types.Transaction{}),
+	EntityOperations:       reflect.TypeOf(types.Operation{}),
+	EntityStateChanges:     reflect.TypeOf(types.StateChange{}),
+	EntityTrustlineChanges: reflect.TypeOf(types.TrustlineChange{}),
+	EntityContractChanges:  reflect.TypeOf(types.ContractChange{}),
+	EntityEscrows:          reflect.TypeOf(entities.Escrow{}),
+}
+
+// projector clears and redacts top-level struct fields. Field indices are resolved once
+// when the router is built, so apply only walks the precomputed lists.
+//
+// Internal fields, tagged json:"-", are never projected nor redacted: they aren't part of the
+// emitted entity but the sinks and the derivation of the contract and trustline changes rely
+// on them.
+type projector struct {
+	clear  []int
+	redact []int
+}
+
+func newProjector(t reflect.Type, keep []string, hasProjection bool, redact []string) (*projector, error) {
+	p := &projector{}
+
+	if hasProjection {
+		kept := make(map[int]bool, len(keep))
+		for _, name := range keep {
+			index, err := fieldIndex(t, name)
+			if err != nil {
+				return nil, err
+			}
+			kept[index] = true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if !kept[i] && t.Field(i).IsExported() && !isInternal(t.Field(i)) {
+				p.clear = append(p.clear, i)
+			}
+		}
+	}
+
+	for _, name := range redact {
+		index, err := fieldIndex(t, name)
+		if err != nil {
+			return nil, err
+		}
+		p.redact = append(p.redact, index)
+	}
+
+	return p, nil
+}
+
+// fieldIndex finds an exported field by JSON name or Go name, case-insensitively. Only
+// top-level fields can be projected: nested paths such as "operations.id" are rejected.
+func fieldIndex(t reflect.Type, name string) (int, error) {
+	if strings.Contains(name, ".") {
+		return 0, fmt.Errorf("nested field %q on %s: only top-level fields can be projected or redacted", name, t.Name())
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || isInternal(field) {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if strings.EqualFold(field.Name, name) || (jsonName != "" && strings.EqualFold(jsonName, name)) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown field %q on %s", name, t.Name())
+}
+
+// isInternal reports whether the field is left out of the JSON of the entity.
+func isInternal(field reflect.StructField) bool {
+	return field.Tag.Get("json") == "-"
+}
+
+// apply projects the value in place. value must be a pointer to the projector's struct type.
+func (p *projector) apply(value any) {
+	v := reflect.ValueOf(value).Elem()
+	for _, i := range p.clear {
+		field := v.Field(i)
+		field.Set(reflect.Zero(field.Type()))
+	}
+	for _, i := range p.redact {
+		redactField(v.Field(i))
+	}
+}
+
+func redactField(field reflect.Value) {
+	switch {
+	case field.Kind() == reflect.String:
+		field.SetString(RedactedValue)
+	case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.String:
+		if field.IsNil() {
+			return
+		}
+		redacted := reflect.New(field.Type().Elem())
+		redacted.Elem().SetString(RedactedValue)
+		field.Set(redacted)
+	default:
+		field.Set(reflect.Zero(field.Type()))
+	}
+}
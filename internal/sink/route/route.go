@@ -0,0 +1,163 @@
+// Package route selects which buffer entities reach a sink and which of their fields are
+// sent. It sits between the IndexerBuffer and Sink.Write:
+//
+//	IndexerBuffer --> Router (entities, state change categories, fields, redaction) --> Sink.Write
+//
+// Routing is declarative: a Config lists the entities the sink wants, optionally narrows
+// state changes to some categories, projects each entity to a subset of its fields and
+// redacts sensitive fields.
+package route
+
+import (
+	"fmt"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+// Entity is a kind of entity held by the IndexerBuffer.
+type Entity string
+
+const (
+	EntityTransactions     Entity = "transactions"
+	EntityOperations       Entity = "operations"
+	EntityStateChanges     Entity = "state_changes"
+	EntityTrustlineChanges Entity = "trustline_changes"
+	EntityContractChanges  Entity = "contract_changes"
+	EntityEscrows          Entity = "escrows"
+)
+
+// AllEntities lists every routable entity.
+var AllEntities = []Entity{
+	EntityTransactions,
+	EntityOperations,
+	EntityStateChanges,
+	EntityTrustlineChanges,
+	EntityContractChanges,
+	EntityEscrows,
+}
+
+// Config is the declarative routing configuration of a sink.
+//
+// Field names in Fields and Redact are matched against the JSON name of the field
+// (e.g. "metaXdr") or its Go name (e.g. "MetaXDR"), case-insensitively. They name top-level
+// fields of the entities emitted by the sinks: nested paths and internal fields, left out of
+// the JSON of the entity, are rejected.
+type Config struct {
+	// Entities selects the entities sent to the sink. Empty means all entities.
+	Entities []Entity `json:"entities"`
+	// StateChangeCategories restricts state changes to these categories. Empty means all categories.
+	StateChangeCategories []types.StateChangeCategory `json:"state_change_categories"`
+	// Fields projects each entity to the listed fields, every other field is cleared.
+	// Entities without an entry are sent with all their fields.
+	Fields map[Entity][]string `json:"fields"`
+	// Redact masks the listed fields of each entity: strings are replaced by RedactedValue,
+	// any other type is cleared.
+	Redact map[Entity][]string `json:"redact"`
+}
+
+// Router applies a routing Config to ledger buffers.
+type Router struct {
+	entities   map[Entity]bool
+	categories map[types.StateChangeCategory]bool
+	projectors map[Entity]*projector
+}
+
+var _ indexer.BufferTransformer = (*Router)(nil)
+
+// NewRouter validates the config and precomputes the field projections.
+func NewRouter(cfg Config) (*Router, error) {
+	r := &Router{
+		entities:   make(map[Entity]bool),
+		categories: make(map[types.StateChangeCategory]bool),
+		projectors: make(map[Entity]*projector),
+	}
+
+	selected := cfg.Entities
+	if len(selected) == 0 {
+		selected = AllEntities
+	}
+	for _, entity := range selected {
+		if _, ok := entityTypes[entity]; !ok {
+			return nil, fmt.Errorf("unknown entity %q", entity)
+		}
+		r.entities[entity] = true
+	}
+
+	for _, category := range cfg.StateChangeCategories {
+		r.categories[category] = true
+	}
+
+	for _, entity := range AllEntities {
+		keep, hasProjection := cfg.Fields[entity]
+		redact := cfg.Redact[entity]
+		if !hasProjection && len(redact) == 0 {
+			continue
+		}
+		p, err := newProjector(entityTypes[entity], keep, hasProjection, redact)
+		if err != nil {
+			return nil, fmt.Errorf("configuring fields of %s: %w", entity, err)
+		}
+		r.projectors[entity] = p
+	}
+	for entity := range cfg.Fields {
+		if _, ok := entityTypes[entity]; !ok {
+			return nil, fmt.Errorf("unknown entity %q in fields", entity)
+		}
+	}
+	for entity := range cfg.Redact {
+		if _, ok := entityTypes[entity]; !ok {
+			return nil, fmt.Errorf("unknown entity %q in redact", entity)
+		}
+	}
+
+	return r, nil
+}
+
+// Apply returns a new buffer with only the routed entities and fields.
+func (r *Router) Apply(buffer indexer.IndexerBufferInterface) (*indexer.IndexerBuffer, error) {
+	concrete, ok := buffer.(*indexer.IndexerBuffer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported buffer type %T", buffer)
+	}
+	return concrete.Transform(r), nil
+}
+
+func (r *Router) Transaction(tx *types.Transaction) bool {
+	return r.route(EntityTransactions, tx)
+}
+
+func (r *Router) Operation(op *types.Operation) bool {
+	return r.route(EntityOperations, op)
+}
+
+func (r *Router) StateChange(sc *types.StateChange) bool {
+	if len(r.categories) > 0 && !r.categories[sc.StateChangeCategory] {
+		return false
+	}
+	return r.route(EntityStateChanges, sc)
+}
+
+func (r *Router) TrustlineChange(tc *types.TrustlineChange) bool {
+	return r.route(EntityTrustlineChanges, tc)
+}
+
+func (r *Router) ContractChange(cc *types.ContractChange) bool {
+	return r.route(EntityContractChanges, cc)
+}
+
+func (r *Router) Escrow(escrow *entities.Escrow) bool {
+	return r.route(EntityEscrows, escrow)
+}
+
+// route reports whether the entity is selected and applies its projection in place.
+func (r *Router) route(entity Entity, value any) bool {
+	if !r.entities[entity] {
+		return false
+	}
+	if p, ok := r.projectors[entity]; ok {
+		p.apply(value)
+	}
+	return true
+}
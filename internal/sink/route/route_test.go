@@ -0,0 +1,92 @@
+package route
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+func TestProjectionKeepsInternalFields(t *testing.T) {
+	r, err := NewRouter(Config{Fields: map[Entity][]string{EntityStateChanges: {"toId", "accountId"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sc := types.StateChange{
+		ToID:           1,
+		AccountID:      "GACCOUNT",
+		TxHash:         "hash",
+		SortKey:        "sort",
+		TxID:           2,
+		TrustlineAsset: "USDC:GISSUER",
+		ContractType:   types.ContractTypeSAC,
+	}
+	if !r.StateChange(&sc) {
+		t.Fatal("state change not routed")
+	}
+
+	if sc.ToID != 1 || sc.AccountID != "GACCOUNT" {
+		t.Errorf("kept fields were cleared: %+v", sc)
+	}
+	if sc.TxHash != "" {
+		t.Errorf("TxHash = %q, want it cleared", sc.TxHash)
+	}
+	if sc.SortKey != "sort" || sc.TxID != 2 || sc.TrustlineAsset != "USDC:GISSUER" || sc.ContractType != types.ContractTypeSAC {
+		t.Errorf("internal fields were cleared: %+v", sc)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	r, err := NewRouter(Config{Redact: map[Entity][]string{EntityTransactions: {"MetaXDR", "resultXdr"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := "meta"
+	tx := types.Transaction{Hash: "hash", MetaXDR: &meta, ResultXDR: "result"}
+	r.Transaction(&tx)
+	if tx.Hash != "hash" || *tx.MetaXDR != RedactedValue || tx.ResultXDR != RedactedValue {
+		t.Errorf("redacted transaction = %+v", tx)
+	}
+	if meta != "meta" {
+		t.Error("redaction modified the original meta")
+	}
+}
+
+func TestRejectedFields(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{
+			name: "nested projection",
+			cfg:  Config{Fields: map[Entity][]string{EntityEscrows: {"milestones.amount"}}},
+			want: "nested field",
+		},
+		{
+			name: "nested redaction",
+			cfg:  Config{Redact: map[Entity][]string{EntityTransactions: {"operations.id"}}},
+			want: "nested field",
+		},
+		{
+			name: "internal field",
+			cfg:  Config{Redact: map[Entity][]string{EntityStateChanges: {"SortKey"}}},
+			want: "unknown field",
+		},
+		{
+			name: "unknown field",
+			cfg:  Config{Fields: map[Entity][]string{EntityTransactions: {"nope"}}},
+			want: "unknown field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRouter(tt.cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("NewRouter = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Package sink abstracts the output layer of the indexer. Every ledger processed by the
+// ingest service is handed to a Sink, which forwards the buffer contents to its destination
+// (message broker, database, HTTP endpoint, ...).
+//
+// The contract is intentionally thin: Write and Close are mandatory, everything else is an
+// optional capability checked with a type assertion by the caller.
+package sink
+
+import (
+	"context"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+)
+
+// Sink is the output abstraction. Backend specific semantics (transactions, publisher
+// confirms, write concerns) are implementation details, the caller only sees error/nil.
+type Sink interface {
+	// Write receives the full buffer of an already processed ledger.
+	// It must be safe for concurrent calls when used through a MultiSink.
+	Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error
+
+	// Close releases connections, channels and goroutines held by the sink.
+	// Write must not be called after Close.
+	Close() error
+}
+
+// HealthChecker is optional. Implement it if the sink supports a health/readiness probe.
+type HealthChecker interface {
+	Ping(ctx context.Context) error
+}
+
+// Flusher is optional. Sinks that accumulate an internal buffer and need an explicit flush
+// implement it.
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// Cursor is optional. Sinks that commit the ingestion cursor in the same atomic unit as the
+// ledger data implement it, so ingestion can resume right after the last committed ledger
+// and no ledger is delivered twice across restarts.
+type Cursor interface {
+	// LatestLedger returns the last ledger committed by the sink, or 0 if none was committed yet.
+	LatestLedger(ctx context.Context) (uint32, error)
+}
+
+// CursorScoper is optional. Sinks that can be shared by the ingestion of several networks
+// implement it: each network writes through its own view of the sink, which commits its cursor
+// under the given name. Views share the connections of the sink, so closing a view is a no-op
+// and the sink itself must be closed once every view is done.
+type CursorScoper interface {
+	WithCursorName(name string) Sink
+}
+
+// State is the health state of a sink as seen by its caller.
+type State string
+
+const (
+	// StateHealthy means writes are delivered to the sink directly.
+	StateHealthy State = "healthy"
+	// StateDegraded means the circuit breaker tripped and ledgers are being spooled to the
+	// dead-letter store instead of being written to the sink.
+	StateDegraded State = "degraded"
+	// StateRecovering means the sink accepts writes again and spooled ledgers are being replayed.
+	StateRecovering State = "recovering"
+)
+
+// Health describes the status of a single sink.
+type Health struct {
+	Name                string    `json:"name"`
+	Type                string    `json:"type"`
+	State               State     `json:"state"`
+	Required            bool      `json:"required"`
+	LastLedger          uint32    `json:"lastLedger,omitempty"`
+	LastError           string    `json:"lastError,omitempty"`
+	LastErrorAt         time.Time `json:"lastErrorAt,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	DeadLetterLedgers   int       `json:"deadLetterLedgers"`
+}
+
+// HealthReporter is optional. Composite sinks implement it to expose the status of every
+// destination they write to.
+type HealthReporter interface {
+	Health(ctx context.Context) []Health
+}
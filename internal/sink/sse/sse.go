@@ -0,0 +1,141 @@
+// Package sse implements a Sink that pushes state changes and escrow events to HTTP clients
+// with Server-Sent Events as soon as a ledger is processed.
+//
+// Every ledger written to the sink is published to an in-process broker (see
+// internal/pubsub). Clients subscribe with
+//
+//	GET /v1/subscribe?account=G...&contract=C...&engagement=...
+//
+// and receive one SSE event per entity, whose data is the CloudEvent of the entity (see
+// internal/event). The SSE id is the position of the event in the stream, encoded as the state
+// change cursors of the query APIs, so a client that reconnects with the Last-Event-ID header
+// (or the cursor parameter) resumes right after the last event it received, as long as its
+// ledger is still in the replay window.
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/pubsub"
+	"github.com/Trustless-Work/Indexer/internal/sink"
+	"github.com/stellar/go-stellar-sdk/support/log"
+)
+
+const (
+	defaultListenAddress    = ":8001"
+	defaultPath             = "/v1/subscribe"
+	defaultNetwork          = "testnet"
+	defaultKeepaliveSeconds = 15
+	shutdownTimeout         = 5 * time.Second
+)
+
+func init() {
+	sink.Register("sse", func(cfg map[string]any) (sink.Sink, error) {
+		var sseCfg Config
+		if err := sink.DecodeConfig(cfg, &sseCfg); err != nil {
+			return nil, err
+		}
+		return New(sseCfg)
+	})
+}
+
+// Config holds the options accepted by the sse sink under SinkOptions.
+type Config struct {
+	// ListenAddress is the address of the HTTP server, e.g. ":8001".
+	ListenAddress string `json:"listen_address"`
+	// Path is the subscription endpoint. Defaults to "/v1/subscribe".
+	Path string `json:"path"`
+	// Network is the network name used in the event sources ("pubnet", "testnet", ...).
+	Network string `json:"network"`
+	// ReplayLedgers is the number of recent ledgers clients can resume from.
+	ReplayLedgers int `json:"replay_ledgers"`
+	// QueueSize is the number of ledgers buffered per client before it is disconnected.
+	QueueSize int `json:"queue_size"`
+	// KeepaliveSeconds is the interval of the comments sent to idle clients so proxies
+	// don't close the connection.
+	KeepaliveSeconds int `json:"keepalive_seconds"`
+}
+
+// SSESink publishes every ledger to the SSE subscribers.
+type SSESink struct {
+	broker   *pubsub.Broker
+	server   *http.Server
+	listener net.Listener
+	done     chan struct{}
+}
+
+var _ sink.Sink = (*SSESink)(nil)
+
+// New starts the HTTP server and returns the sink feeding it.
+func New(cfg Config) (*SSESink, error) {
+	if cfg.ListenAddress == "" {
+		cfg.ListenAddress = defaultListenAddress
+	}
+	if cfg.Path == "" {
+		cfg.Path = defaultPath
+	}
+	if cfg.Network == "" {
+		cfg.Network = defaultNetwork
+	}
+	if cfg.KeepaliveSeconds <= 0 {
+		cfg.KeepaliveSeconds = defaultKeepaliveSeconds
+	}
+
+	listener, err := net.Listen("tcp", cfg.ListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", cfg.ListenAddress, err)
+	}
+
+	broker := pubsub.NewBroker(pubsub.Config{ReplayLedgers: cfg.ReplayLedgers, QueueSize: cfg.QueueSize})
+	mux := http.NewServeMux()
+	mux.Handle("GET "+cfg.Path, &streamHandler{
+		broker:    broker,
+		events:    event.NewBuilder(cfg.Network),
+		keepalive: time.Duration(cfg.KeepaliveSeconds) * time.Second,
+	})
+
+	s := &SSESink{
+		broker:   broker,
+		server:   &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go s.serve()
+
+	log.Infof("Serving SSE subscriptions on %s%s", listener.Addr(), cfg.Path)
+	return s, nil
+}
+
+func (s *SSESink) serve() {
+	defer close(s.done)
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Errorf("sse: server stopped: %v", err)
+	}
+}
+
+// Write publishes the ledger to the subscribers. It never blocks on slow clients.
+func (s *SSESink) Write(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	s.broker.Publish(pubsub.Ledger{Sequence: ledgerSeq, Buffer: buffer})
+	return nil
+}
+
+// Close ends every stream and stops the server.
+func (s *SSESink) Close() error {
+	// Closing the broker ends the streams, which lets Shutdown complete.
+	s.broker.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := s.server.Shutdown(ctx)
+	<-s.done
+	if err != nil {
+		return fmt.Errorf("shutting down sse server: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/event"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/pubsub"
+	"github.com/stellar/go-stellar-sdk/support/log"
+	"github.com/stellar/go-stellar-sdk/toid"
+)
+
+// SSE event names.
+const (
+	eventStateChange = "state_change"
+	eventEscrow      = "escrow"
+	eventError       = "error"
+)
+
+// streamHandler serves the subscriptions.
+type streamHandler struct {
+	broker    *pubsub.Broker
+	events    *event.Builder
+	keepalive time.Duration
+}
+
+// streamEvent is an event of the stream at its position.
+type streamEvent struct {
+	cursor types.StateChangeCursor
+	name   string
+	event  event.Event
+}
+
+func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	params := r.URL.Query()
+	filter := pubsub.NewFilter(params["account"], params["contract"], params["engagement"])
+
+	cursorParam := params.Get("cursor")
+	if cursorParam == "" {
+		cursorParam = r.Header.Get("Last-Event-ID")
+	}
+	var after *types.StateChangeCursor
+	fromLedger := uint32(0)
+	if cursorParam != "" {
+		cursor, err := parseCursor(cursorParam)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		after = &cursor
+		fromLedger = uint32(toid.Parse(cursor.ToID).LedgerSequence)
+	}
+
+	subscription, err := h.broker.Subscribe(fromLedger)
+	if err != nil {
+		var outOfRange *pubsub.LedgerOutOfRangeError
+		if errors.As(err, &outOfRange) {
+			// The client must catch up with the query APIs before subscribing again.
+			http.Error(w, err.Error(), http.StatusGone)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	log.Ctx(ctx).Debugf("sse: new subscription from ledger %d", fromLedger)
+
+	for {
+		ledger, err := h.next(ctx, subscription, w, flusher)
+		if err != nil {
+			if ctx.Err() == nil {
+				writeError(w, err)
+				flusher.Flush()
+			}
+			return
+		}
+
+		events, err := h.ledgerEvents(filter, ledger, after)
+		if err != nil {
+			log.Ctx(ctx).Errorf("sse: building events of ledger %d: %v", ledger.Sequence, err)
+			writeError(w, err)
+			flusher.Flush()
+			return
+		}
+		for _, e := range events {
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+		after = nil
+	}
+}
+
+// next waits for the next ledger, sending keepalive comments while the stream is idle.
+func (h *streamHandler) next(ctx context.Context, subscription *pubsub.Subscription, w http.ResponseWriter, flusher http.Flusher) (pubsub.Ledger, error) {
+	for {
+		waitCtx, cancel := context.WithTimeout(ctx, h.keepalive)
+		ledger, err := subscription.Next(waitCtx)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil {
+			return ledger, err
+		}
+		if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+			return pubsub.Ledger{}, err
+		}
+		flusher.Flush()
+	}
+}
+
+// ledgerEvents returns the events of the ledger matching the filter, in stream order, skipping
+// the events up to the after cursor. Escrows come first, positioned before the first
+// transaction of the ledger, followed by the state changes in cursor order.
+func (h *streamHandler) ledgerEvents(filter *pubsub.Filter, ledger pubsub.Ledger, after *types.StateChangeCursor) ([]streamEvent, error) {
+	closeTime := time.Now().UTC()
+	if txs := ledger.Buffer.GetTransactions(); len(txs) > 0 {
+		closeTime = txs[0].LedgerCreatedAt
+	}
+	ledgerToID := toid.New(int32(ledger.Sequence), 0, 0).ToInt64()
+
+	events := make([]streamEvent, 0)
+	for i, escrow := range ledger.Buffer.GetEscrows() {
+		if !filter.MatchEscrow(escrow) {
+			continue
+		}
+		e, err := h.events.Escrow(escrow, ledger.Sequence, closeTime)
+		if err != nil {
+			return nil, err
+		}
+		cursor := types.StateChangeCursor{ToID: ledgerToID, StateChangeOrder: int64(i + 1)}
+		events = append(events, streamEvent{cursor: cursor, name: eventEscrow, event: e})
+	}
+
+	// The buffer is shared with the other subscribers, sort a copy.
+	stateChanges := slices.Clone(ledger.Buffer.GetStateChanges())
+	sort.Slice(stateChanges, func(i, j int) bool {
+		return cursorLess(stateChanges[i].GetCursor(), stateChanges[j].GetCursor())
+	})
+	for _, sc := range stateChanges {
+		if !filter.MatchAddress(sc.AccountID) {
+			continue
+		}
+		e, err := h.events.StateChange(sc, ledger.Sequence)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, streamEvent{cursor: sc.GetCursor(), name: eventStateChange, event: e})
+	}
+
+	if after != nil {
+		start := sort.Search(len(events), func(i int) bool { return cursorLess(*after, events[i].cursor) })
+		events = events[start:]
+	}
+	return events, nil
+}
+
+func writeEvent(w http.ResponseWriter, e streamEvent) error {
+	data, err := json.Marshal(e.event)
+	if err != nil {
+		return fmt.Errorf("marshalling event %s: %w", e.event.ID, err)
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", formatCursor(e.cursor), e.name, data)
+	return err
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventError, data)
+}
+
+// formatCursor formats a stream position as the state change cursor of the query APIs, so a
+// client can resume the stream from the last state change it paged.
+func formatCursor(cursor types.StateChangeCursor) string {
+	return cursor.Encode()
+}
+
+// parseCursor parses a state change cursor of the query APIs, or the "<to_id>-<state_change_order>"
+// IDs sent by earlier versions of the stream.
+func parseCursor(value string) (types.StateChangeCursor, error) {
+	if toID, order, found := strings.Cut(value, "-"); found {
+		cursor := types.StateChangeCursor{}
+		var err error
+		if cursor.ToID, err = strconv.ParseInt(toID, 10, 64); err != nil || cursor.ToID <= 0 {
+			return types.StateChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+		}
+		if cursor.StateChangeOrder, err = strconv.ParseInt(order, 10, 64); err != nil {
+			return types.StateChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+		}
+		return cursor, nil
+	}
+	cursor, err := types.DecodeStateChangeCursor(value)
+	if err != nil || cursor.ToID <= 0 {
+		return types.StateChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+	}
+	return cursor, nil
+}
+
+func cursorLess(a, b types.StateChangeCursor) bool {
+	if a.ToID != b.ToID {
+		return a.ToID < b.ToID
+	}
+	return a.StateChangeOrder < b.StateChangeOrder
+}
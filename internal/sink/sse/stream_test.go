@@ -0,0 +1,25 @@
+package sse
+
+import (
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+func TestParseCursor(t *testing.T) {
+	want := types.StateChangeCursor{ToID: 4294967296, StateChangeOrder: 3}
+	for _, value := range []string{formatCursor(want), want.Encode(), "4294967296-3"} {
+		cursor, err := parseCursor(value)
+		if err != nil {
+			t.Fatalf("parseCursor(%q): %v", value, err)
+		}
+		if cursor != want {
+			t.Errorf("parseCursor(%q) = %+v, want %+v", value, cursor, want)
+		}
+	}
+	for _, value := range []string{"nope", "0-1", types.StateChangeCursor{StateChangeOrder: 1}.Encode()} {
+		if _, err := parseCursor(value); err == nil {
+			t.Errorf("parseCursor(%q) succeeded, want an error", value)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package sink
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+)
+
+// WriteTimings collects the write durations of the sinks a composite sink writes to, by name,
+// for the caller of Write.
+type WriteTimings struct {
+	mu        sync.Mutex
+	durations map[string]time.Duration
+}
+
+type writeTimingsKey struct{}
+
+// WithWriteTimings returns a context collecting the durations recorded with
+// RecordWriteDuration during a Write, and the timings they are collected into.
+func WithWriteTimings(ctx context.Context) (context.Context, *WriteTimings) {
+	timings := &WriteTimings{durations: make(map[string]time.Duration)}
+	return context.WithValue(ctx, writeTimingsKey{}, timings), timings
+}
+
+// RecordWriteDuration records how long the write to the sink named name took. It is a no-op
+// when ctx doesn't collect timings.
+func RecordWriteDuration(ctx context.Context, name string, d time.Duration) {
+	timings, ok := ctx.Value(writeTimingsKey{}).(*WriteTimings)
+	if !ok {
+		return
+	}
+	timings.mu.Lock()
+	timings.durations[name] += d
+	timings.mu.Unlock()
+}
+
+// Durations returns a copy of the durations recorded so far.
+func (t *WriteTimings) Durations() map[string]time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.durations)
+}
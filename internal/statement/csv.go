@@ -0,0 +1,52 @@
+package statement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/timeline"
+)
+
+var csvHeader = []string{
+	"contract_id", "engagement_id", "title", "date", "ledger", "tx_hash", "type", "function",
+	"counterparty", "token_id", "amount", "amount_units",
+}
+
+// WriteCSV writes the entries of a statement, one row per entry. Amounts are written both
+// with 7 decimals and in units.
+func WriteCSV(w io.Writer, statement *Statement) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+	for _, escrow := range statement.Escrows {
+		for _, entry := range escrow.Entries {
+			var formatted string
+			if entry.Amount != "" {
+				formatted = timeline.FormatAmount(entry.Amount)
+			}
+			record := []string{
+				escrow.ContractID,
+				escrow.EngagementID,
+				escrow.Title,
+				entry.Date.UTC().Format(time.RFC3339),
+				strconv.FormatUint(uint64(entry.LedgerNumber), 10),
+				entry.TxHash,
+				string(entry.Type),
+				entry.Function,
+				entry.Counterparty,
+				entry.TokenID,
+				formatted,
+				entry.Amount,
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("writing CSV row: %w", err)
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
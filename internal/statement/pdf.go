@@ -0,0 +1,173 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/timeline"
+)
+
+// The PDF is a plain text report in Courier on landscape A4 pages, written by hand as it only
+// needs a handful of PDF objects.
+const (
+	pdfPageWidth    = 842
+	pdfPageHeight   = 595
+	pdfMargin       = 36
+	pdfFontSize     = 8
+	pdfLeading      = 10
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+)
+
+// WritePDF writes a statement as a PDF report: the entries and totals of each escrow, then
+// the totals of the statement.
+func WritePDF(w io.Writer, statement *Statement) error {
+	_, err := w.Write(renderPDF(reportLines(statement)))
+	return err
+}
+
+func reportLines(statement *Statement) []string {
+	period := "all time"
+	switch {
+	case !statement.From.IsZero() && !statement.To.IsZero():
+		period = fmt.Sprintf("%s to %s", formatDate(statement.From), formatDate(statement.To))
+	case !statement.From.IsZero():
+		period = "from " + formatDate(statement.From)
+	case !statement.To.IsZero():
+		period = "until " + formatDate(statement.To)
+	}
+	lines := []string{
+		"ESCROW STATEMENT",
+		fmt.Sprintf("Period: %s (UTC)   Generated: %s   Escrows: %d", period, formatDate(statement.GeneratedAt), len(statement.Escrows)),
+		"",
+	}
+
+	entryRow := "%-16s  %-18s  %22s  %-11s  %-11s  %-26s  %-11s"
+	for _, escrow := range statement.Escrows {
+		lines = append(lines,
+			strings.Repeat("=", 130),
+			fmt.Sprintf("Escrow %s   Engagement %s", escrow.ContractID, escrow.EngagementID),
+			fmt.Sprintf("Title: %s", escrow.Title),
+			fmt.Sprintf("Platform %s   Platform fee %d   Deployed %s", escrow.PlatformAddress, escrow.PlatformFee, formatDate(escrow.DeployedAt)),
+			"",
+			fmt.Sprintf(entryRow, "DATE", "TYPE", "AMOUNT", "TOKEN", "COUNTERPARTY", "FUNCTION", "TX"),
+		)
+		for _, entry := range escrow.Entries {
+			var amount string
+			if entry.Amount != "" {
+				amount = timeline.FormatAmount(entry.Amount)
+			}
+			lines = append(lines, fmt.Sprintf(entryRow, formatDate(entry.Date), entry.Type, amount,
+				shorten(entry.TokenID), shorten(entry.Counterparty), entry.Function, shorten(entry.TxHash)))
+		}
+		if len(escrow.Entries) == 0 {
+			lines = append(lines, "No activity over the period.")
+		}
+		lines = append(lines, "", fmt.Sprintf("Disputes: %d", escrow.Disputes))
+		lines = append(lines, totalsLines(escrow.Totals)...)
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, strings.Repeat("=", 130), "TOTALS")
+	lines = append(lines, totalsLines(statement.Totals)...)
+	return lines
+}
+
+func totalsLines(totals []Totals) []string {
+	if len(totals) == 0 {
+		return nil
+	}
+	row := "%-11s  %22s  %22s  %22s  %22s  %22s"
+	lines := []string{fmt.Sprintf(row, "TOKEN", "FUNDED", "RELEASED", "PLATFORM FEES", "DISPUTE RESOLUTIONS", "WITHDRAWN")}
+	for _, t := range totals {
+		lines = append(lines, fmt.Sprintf(row, shorten(t.TokenID), timeline.FormatAmount(t.Funded),
+			timeline.FormatAmount(t.Released), timeline.FormatAmount(t.PlatformFees),
+			timeline.FormatAmount(t.DisputeResolutions), timeline.FormatAmount(t.Withdrawn)))
+	}
+	return lines
+}
+
+// renderPDF lays the lines out on as many pages as needed.
+func renderPDF(lines []string) []byte {
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// Objects 1 to 3 are the catalog, the page tree and the font, followed by a page and its
+	// content stream for every page.
+	objects := make([]string, 3, 3+2*len(pages))
+	kids := make([]string, len(pages))
+	for i, page := range pages {
+		pageID, contentID := 4+2*i, 5+2*i
+		kids[i] = fmt.Sprintf("%d 0 R", pageID)
+
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", escapePDFString(line))
+		}
+		content.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, contentID),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.Bytes()),
+		)
+	}
+	objects[0] = "<< /Type /Catalog /Pages 2 0 R >>"
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+	objects[2] = "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>"
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// escapePDFString escapes a line for a PDF literal string. Characters outside of printable
+// ASCII, which the standard Courier font may not map, are replaced by '?'.
+func escapePDFString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format("2006-01-02 15:04")
+}
+
+// shorten abbreviates addresses and hashes, e.g. "CDLZ...CYSC".
+func shorten(s string) string {
+	if len(s) <= 11 {
+		return s
+	}
+	return s[:4] + "..." + s[len(s)-4:]
+}
@@ -0,0 +1,441 @@
+// Package statement builds the statements of the escrows of an engagement or a platform over
+// a date range, for accounting: the funding, releases, platform fees, disputes and withdrawals
+// of each escrow, computed from the indexed escrows, the operations invoking them and the
+// token transfers (BALANCE state changes) of their contracts. Statements are rendered as CSV
+// (see WriteCSV) or PDF (see WritePDF).
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/Trustless-Work/Indexer/internal/timeline"
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// MaxEscrows bounds the number of escrows of a statement.
+const MaxEscrows = 1000
+
+// ErrTooManyEscrows is returned when more than MaxEscrows escrows match the request.
+var ErrTooManyEscrows = fmt.Errorf("statement is limited to %d escrows, narrow the request", MaxEscrows)
+
+// EntryType is the kind of a statement entry.
+type EntryType string
+
+const (
+	EntryDeployment EntryType = "deployment"
+	// EntryFunding is a deposit of tokens into the escrow.
+	EntryFunding  EntryType = "funding"
+	EntryApproval EntryType = "approval"
+	// EntryRelease is a payout of a release, to the receiver or any other address but the
+	// platform.
+	EntryRelease EntryType = "release"
+	// EntryPlatformFee is a payout to the platform address of the escrow.
+	EntryPlatformFee EntryType = "platform_fee"
+	EntryDispute     EntryType = "dispute"
+	// EntryDisputeResolution is a payout of a dispute resolution.
+	EntryDisputeResolution EntryType = "dispute_resolution"
+	// EntryWithdrawal is any other payout of the escrow, e.g. a withdrawal of remaining funds.
+	EntryWithdrawal EntryType = "withdrawal"
+)
+
+// Request selects the escrows and the period of a statement.
+type Request struct {
+	// Filter selects the escrows, usually by EngagementID or Roles.PlatformAddress.
+	Filter store.EscrowFilter
+	// From and To bound the period, From inclusively and To exclusively. Zero values don't
+	// bound it.
+	From time.Time
+	To   time.Time
+}
+
+// Statement is the statement of the escrows matching a Request.
+type Statement struct {
+	From        time.Time          `json:"from,omitzero"`
+	To          time.Time          `json:"to,omitzero"`
+	GeneratedAt time.Time          `json:"generatedAt"`
+	Escrows     []*EscrowStatement `json:"escrows"`
+	// Totals are the totals of every escrow, per token.
+	Totals []Totals `json:"totals"`
+}
+
+// EscrowStatement is the activity of an escrow over the period of the statement.
+type EscrowStatement struct {
+	ContractID      string `json:"contractId"`
+	EngagementID    string `json:"engagementId"`
+	Title           string `json:"title"`
+	PlatformAddress string `json:"platformAddress"`
+	// PlatformFee is the fee of the platform, as configured on the escrow.
+	PlatformFee uint32    `json:"platformFee"`
+	DeployedAt  time.Time `json:"deployedAt"`
+	Entries     []Entry   `json:"entries"`
+	Disputes    int       `json:"disputes"`
+	Totals      []Totals  `json:"totals"`
+}
+
+// Entry is a line of a statement. Amount is in units of the token, i.e. 10^-7 of the amounts
+// shown to users, and is empty for entries without a transfer.
+type Entry struct {
+	Date         time.Time `json:"date"`
+	LedgerNumber uint32    `json:"ledgerNumber"`
+	TxHash       string    `json:"txHash,omitempty"`
+	OperationID  int64     `json:"operationId,omitempty"`
+	Type         EntryType `json:"type"`
+	// Function is the escrow function invoked by the operation of the entry.
+	Function     string `json:"function,omitempty"`
+	Counterparty string `json:"counterparty,omitempty"`
+	TokenID      string `json:"tokenId,omitempty"`
+	Amount       string `json:"amount,omitempty"`
+}
+
+// Totals sums the transfers of a token, in units.
+type Totals struct {
+	TokenID            string `json:"tokenId"`
+	Funded             string `json:"funded"`
+	Released           string `json:"released"`
+	PlatformFees       string `json:"platformFees"`
+	DisputeResolutions string `json:"disputeResolutions"`
+	Withdrawn          string `json:"withdrawn"`
+}
+
+// Build builds the statement of a request from the data of reader.
+func Build(ctx context.Context, reader store.Reader, req Request) (*Statement, error) {
+	escrows, err := escrowsOf(ctx, reader, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &Statement{From: req.From, To: req.To, GeneratedAt: time.Now().UTC(), Escrows: []*EscrowStatement{}}
+	all := newTotals()
+	for _, escrow := range escrows {
+		escrowStatement, err := buildEscrow(ctx, reader, escrow, req)
+		if err != nil {
+			return nil, fmt.Errorf("building statement of escrow %s: %w", escrow.ContractID, err)
+		}
+		for _, entry := range escrowStatement.Entries {
+			all.add(entry)
+		}
+		statement.Escrows = append(statement.Escrows, escrowStatement)
+	}
+	statement.Totals = all.list()
+	return statement, nil
+}
+
+func escrowsOf(ctx context.Context, reader store.Reader, filter store.EscrowFilter) ([]*store.EscrowWithCursor, error) {
+	var escrows []*store.EscrowWithCursor
+	page := store.Page[int64]{Limit: store.MaxPageLimit, Order: store.SortAsc}
+	for {
+		batch, err := reader.GetEscrows(ctx, filter, page)
+		if err != nil {
+			return nil, fmt.Errorf("getting escrows: %w", err)
+		}
+		escrows = append(escrows, batch...)
+		if len(escrows) > MaxEscrows {
+			return nil, ErrTooManyEscrows
+		}
+		if len(batch) < page.Limit {
+			return escrows, nil
+		}
+		page.Cursor = &batch[len(batch)-1].Cursor
+	}
+}
+
+func buildEscrow(ctx context.Context, reader store.Reader, escrow *store.EscrowWithCursor, req Request) (*EscrowStatement, error) {
+	statement := &EscrowStatement{
+		ContractID:      escrow.ContractID,
+		EngagementID:    escrow.EngagementID,
+		Title:           escrow.Title,
+		PlatformAddress: escrow.Roles.PlatformAddress,
+		PlatformFee:     escrow.PlatformFee,
+		DeployedAt:      escrow.LedgerCreatedAt,
+		Entries:         []Entry{},
+	}
+	if req.contains(escrow.LedgerCreatedAt) {
+		statement.Entries = append(statement.Entries, Entry{
+			Date:         escrow.LedgerCreatedAt,
+			LedgerNumber: escrow.LedgerNumber,
+			Type:         EntryDeployment,
+		})
+	}
+
+	functions, err := invokedFunctions(ctx, reader, escrow.ContractID, req, statement)
+	if err != nil {
+		return nil, err
+	}
+	if err := addTransfers(ctx, reader, escrow, functions, req, statement); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(statement.Entries, func(i, j int) bool {
+		a, b := statement.Entries[i], statement.Entries[j]
+		if a.LedgerNumber != b.LedgerNumber {
+			return a.LedgerNumber < b.LedgerNumber
+		}
+		return a.OperationID < b.OperationID
+	})
+	totals := newTotals()
+	for _, entry := range statement.Entries {
+		totals.add(entry)
+		if entry.Type == EntryDispute {
+			statement.Disputes++
+		}
+	}
+	statement.Totals = totals.list()
+	return statement, nil
+}
+
+// invokedFunctions returns the escrow function invoked by each operation invoking the
+// escrow, and adds the approvals and disputes in the period to the statement.
+func invokedFunctions(ctx context.Context, reader store.Reader, contractID string, req Request, statement *EscrowStatement) (map[int64]string, error) {
+	functions := make(map[int64]string)
+	page := store.Page[int64]{Limit: store.MaxPageLimit, Order: store.SortAsc}
+	for {
+		operations, err := reader.GetOperations(ctx, store.OperationFilter{AccountID: contractID}, page)
+		if err != nil {
+			return nil, fmt.Errorf("getting operations: %w", err)
+		}
+		for _, op := range operations {
+			function, ok := invokedFunction(&op.Operation, contractID)
+			if !ok {
+				continue
+			}
+			functions[op.ID] = function
+
+			var entryType EntryType
+			switch {
+			case strings.HasPrefix(function, "dispute_"):
+				entryType = EntryDispute
+			case strings.HasPrefix(function, "approve_"):
+				entryType = EntryApproval
+			default:
+				continue
+			}
+			if req.contains(op.LedgerCreatedAt) {
+				statement.Entries = append(statement.Entries, Entry{
+					Date:         op.LedgerCreatedAt,
+					LedgerNumber: op.LedgerNumber,
+					TxHash:       op.TxHash,
+					OperationID:  op.ID,
+					Type:         entryType,
+					Function:     function,
+				})
+			}
+		}
+		if len(operations) < page.Limit {
+			return functions, nil
+		}
+		page.Cursor = &operations[len(operations)-1].Cursor
+	}
+}
+
+// invokedFunction returns the function of contractID invoked by an operation.
+func invokedFunction(op *types.Operation, contractID string) (string, bool) {
+	var decoded xdr.Operation
+	if err := xdr.SafeUnmarshalBase64(op.OperationXDR, &decoded); err != nil {
+		return "", false
+	}
+	invokeHostFunction, ok := decoded.Body.GetInvokeHostFunctionOp()
+	if !ok {
+		return "", false
+	}
+	invoke, ok := invokeHostFunction.HostFunction.GetInvokeContract()
+	if !ok || invoke.ContractAddress.Type != xdr.ScAddressTypeScAddressTypeContract {
+		return "", false
+	}
+	id := invoke.ContractAddress.MustContractId()
+	address, err := strkey.Encode(strkey.VersionByteContract, id[:])
+	if err != nil || address != contractID {
+		return "", false
+	}
+	return string(invoke.FunctionName), true
+}
+
+// addTransfers adds the token transfers of the escrow contract in the period to the statement.
+func addTransfers(ctx context.Context, reader store.Reader, escrow *store.EscrowWithCursor, functions map[int64]string, req Request, statement *EscrowStatement) error {
+	var transfers []types.StateChange
+	filter := store.StateChangeFilter{AccountID: escrow.ContractID, Category: types.StateChangeCategoryBalance}
+	page := store.Page[types.StateChangeCursor]{Limit: store.MaxPageLimit, Order: store.SortAsc}
+	for {
+		stateChanges, err := reader.GetStateChanges(ctx, filter, page)
+		if err != nil {
+			return fmt.Errorf("getting state changes: %w", err)
+		}
+		for _, sc := range stateChanges {
+			if req.contains(sc.LedgerCreatedAt) && sc.StateChangeReason != nil && sc.Amount.Valid {
+				transfers = append(transfers, sc.StateChange)
+			}
+		}
+		if len(stateChanges) < page.Limit {
+			break
+		}
+		page.Cursor = &stateChanges[len(stateChanges)-1].Cursor
+	}
+
+	related, err := relatedStateChanges(ctx, reader, transfers)
+	if err != nil {
+		return err
+	}
+	for _, sc := range transfers {
+		entry := Entry{
+			Date:         sc.LedgerCreatedAt,
+			LedgerNumber: sc.LedgerNumber,
+			TxHash:       sc.TxHash,
+			OperationID:  sc.OperationID,
+			Function:     functions[sc.OperationID],
+			Counterparty: timeline.Counterparty(escrow.ContractID, &sc, related[sc.OperationID]),
+			TokenID:      sc.TokenID.String,
+			Amount:       sc.Amount.String,
+		}
+		switch *sc.StateChangeReason {
+		case types.StateChangeReasonCredit, types.StateChangeReasonMint:
+			entry.Type = EntryFunding
+		case types.StateChangeReasonDebit, types.StateChangeReasonBurn:
+			entry.Type = payoutType(entry, escrow.Roles.PlatformAddress)
+		default:
+			continue
+		}
+		statement.Entries = append(statement.Entries, entry)
+	}
+	return nil
+}
+
+// payoutType classifies a transfer out of the escrow, by recipient then by invoked function.
+func payoutType(entry Entry, platformAddress string) EntryType {
+	switch {
+	case entry.Counterparty != "" && entry.Counterparty == platformAddress:
+		return EntryPlatformFee
+	case strings.HasPrefix(entry.Function, "release_"):
+		return EntryRelease
+	case strings.HasPrefix(entry.Function, "resolve_"):
+		return EntryDisputeResolution
+	default:
+		return EntryWithdrawal
+	}
+}
+
+// relatedStateChanges returns the state changes of the operations of the transfers, by
+// operation, to find their counterparties.
+func relatedStateChanges(ctx context.Context, reader store.Reader, transfers []types.StateChange) (map[int64][]types.StateChange, error) {
+	var operationIDs []int64
+	seen := make(map[int64]bool)
+	for _, sc := range transfers {
+		if !seen[sc.OperationID] {
+			seen[sc.OperationID] = true
+			operationIDs = append(operationIDs, sc.OperationID)
+		}
+	}
+
+	related := make(map[int64][]types.StateChange)
+	for start := 0; start < len(operationIDs); start += store.MaxPageLimit {
+		batch := operationIDs[start:min(start+store.MaxPageLimit, len(operationIDs))]
+		stateChanges, err := reader.GetStateChangesByOperationIDs(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("getting state changes of operations: %w", err)
+		}
+		for _, sc := range stateChanges {
+			related[sc.OperationID] = append(related[sc.OperationID], sc)
+		}
+	}
+	return related, nil
+}
+
+// ParsePeriod parses the bounds of a period, as RFC 3339 timestamps or dates. Dates are days
+// in UTC, and an end date includes its whole day. Empty bounds are zero.
+func ParsePeriod(from, to string) (time.Time, time.Time, error) {
+	start, _, err := parseBound(from)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start %q: %w", from, err)
+	}
+	end, isDate, err := parseBound(to)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end %q: %w", to, err)
+	}
+	if isDate {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end, nil
+}
+
+func parseBound(value string) (time.Time, bool, error) {
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
+
+func (r Request) contains(t time.Time) bool {
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || t.Before(r.To))
+}
+
+// Validate checks that the request selects escrows by engagement or platform, and that its
+// period is valid.
+func (r Request) Validate() error {
+	if r.Filter.EngagementID == "" && r.Filter.Roles.PlatformAddress == "" {
+		return errors.New("an engagement ID or a platform address is required")
+	}
+	if !r.From.IsZero() && !r.To.IsZero() && !r.From.Before(r.To) {
+		return errors.New("the start of the period must be before its end")
+	}
+	return nil
+}
+
+// totals sums the entries per token, keeping the tokens in order of appearance.
+type totals struct {
+	tokens []string
+	sums   map[string]map[EntryType]*big.Int
+}
+
+func newTotals() *totals {
+	return &totals{sums: make(map[string]map[EntryType]*big.Int)}
+}
+
+func (t *totals) add(entry Entry) {
+	units, ok := new(big.Int).SetString(entry.Amount, 10)
+	if !ok || entry.TokenID == "" {
+		return
+	}
+	sums, ok := t.sums[entry.TokenID]
+	if !ok {
+		sums = make(map[EntryType]*big.Int)
+		t.sums[entry.TokenID] = sums
+		t.tokens = append(t.tokens, entry.TokenID)
+	}
+	if sums[entry.Type] == nil {
+		sums[entry.Type] = new(big.Int)
+	}
+	sums[entry.Type].Add(sums[entry.Type], units)
+}
+
+func (t *totals) list() []Totals {
+	list := make([]Totals, 0, len(t.tokens))
+	for _, token := range t.tokens {
+		sums := t.sums[token]
+		sum := func(entryType EntryType) string {
+			if sums[entryType] == nil {
+				return "0"
+			}
+			return sums[entryType].String()
+		}
+		list = append(list, Totals{
+			TokenID:            token,
+			Funded:             sum(EntryFunding),
+			Released:           sum(EntryRelease),
+			PlatformFees:       sum(EntryPlatformFee),
+			DisputeResolutions: sum(EntryDisputeResolution),
+			Withdrawn:          sum(EntryWithdrawal),
+		})
+	}
+	return list
+}
@@ -0,0 +1,172 @@
+// Package memory keeps the indexed data in memory and serves the queries of the APIs from it.
+// It is fed the ledger buffers directly, without a database, which makes it suitable for tests
+// and for the demo mode of the serve command.
+//
+// Nothing is persisted or evicted, and queries scan the entities linearly: the store holds as
+// much as a demo or a test ingests, not a network history.
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	set "github.com/deckarep/golang-set/v2"
+)
+
+// Store is the in-memory store of indexed data. It is safe for concurrent use.
+type Store struct {
+	mu sync.RWMutex
+
+	accounts map[string]*types.Account
+
+	// Entities are kept in maps for lookups and in slices, in ascending cursor order, for pages.
+	transactions     map[string]*types.Transaction
+	transactionOrder []*types.Transaction
+	txParticipants   map[string]set.Set[string]
+
+	operations     map[int64]*types.Operation
+	operationOrder []*types.Operation
+	opParticipants map[int64]set.Set[string]
+
+	stateChanges     map[types.StateChangeCursor]*types.StateChange
+	stateChangeOrder []*types.StateChange
+
+	escrows     map[string]*store.EscrowWithCursor
+	escrowOrder []*store.EscrowWithCursor
+
+	latestLedger uint32
+}
+
+var _ store.Reader = (*Store)(nil)
+
+// New creates an empty store.
+func New() *Store {
+	return &Store{
+		accounts:       make(map[string]*types.Account),
+		transactions:   make(map[string]*types.Transaction),
+		txParticipants: make(map[string]set.Set[string]),
+		operations:     make(map[int64]*types.Operation),
+		opParticipants: make(map[int64]set.Set[string]),
+		stateChanges:   make(map[types.StateChangeCursor]*types.StateChange),
+		escrows:        make(map[string]*store.EscrowWithCursor),
+	}
+}
+
+// WriteLedger adds the content of a ledger buffer. Like the Postgres store, entities that
+// already exist are left untouched, so re-writing a ledger is harmless.
+func (s *Store) WriteLedger(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	closedAt := time.Time{}
+	if txs := buffer.GetTransactions(); len(txs) > 0 {
+		closedAt = txs[0].LedgerCreatedAt
+	}
+
+	for _, address := range buffer.GetAllParticipants() {
+		if _, ok := s.accounts[address]; !ok && address != "" {
+			s.accounts[address] = &types.Account{StellarAddress: address, CreatedAt: now}
+		}
+	}
+
+	for _, tx := range buffer.GetTransactions() {
+		if _, ok := s.transactions[tx.Hash]; ok {
+			continue
+		}
+		tx.IngestedAt = now
+		s.transactions[tx.Hash] = &tx
+		s.transactionOrder = insertSorted(s.transactionOrder, &tx, func(t *types.Transaction) int64 { return t.ToID })
+	}
+	for hash, participants := range buffer.GetTransactionsParticipants() {
+		s.txParticipants[hash] = addParticipants(s.txParticipants[hash], participants)
+	}
+
+	for _, op := range buffer.GetOperations() {
+		if _, ok := s.operations[op.ID]; ok {
+			continue
+		}
+		op.IngestedAt = now
+		s.operations[op.ID] = &op
+		s.operationOrder = insertSorted(s.operationOrder, &op, func(o *types.Operation) int64 { return o.ID })
+	}
+	for id, participants := range buffer.GetOperationsParticipants() {
+		s.opParticipants[id] = addParticipants(s.opParticipants[id], participants)
+	}
+
+	for _, sc := range buffer.GetStateChanges() {
+		if _, ok := s.stateChanges[sc.GetCursor()]; ok {
+			continue
+		}
+		sc.IngestedAt = now
+		s.stateChanges[sc.GetCursor()] = &sc
+		s.stateChangeOrder = append(s.stateChangeOrder, &sc)
+	}
+	sort.SliceStable(s.stateChangeOrder, func(i, j int) bool {
+		return stateChangeCursorLess(s.stateChangeOrder[i].GetCursor(), s.stateChangeOrder[j].GetCursor())
+	})
+
+	for _, escrow := range buffer.GetEscrows() {
+		if stored, ok := s.escrows[escrow.ContractID]; ok {
+			// Like the Postgres store, never replace a state with an older one.
+			if stored.UpdatedLedgerNumber <= ledgerSeq {
+				stored.Escrow = stored.Escrow.Apply(escrow)
+				stored.UpdatedLedgerNumber = ledgerSeq
+			}
+			continue
+		}
+		stored := &store.EscrowWithCursor{
+			Escrow:              escrow,
+			LedgerNumber:        ledgerSeq,
+			LedgerCreatedAt:     closedAt,
+			UpdatedLedgerNumber: ledgerSeq,
+			Cursor:              int64(len(s.escrowOrder) + 1),
+		}
+		s.escrows[escrow.ContractID] = stored
+		s.escrowOrder = append(s.escrowOrder, stored)
+	}
+
+	s.latestLedger = max(s.latestLedger, ledgerSeq)
+	return nil
+}
+
+// LatestLedger returns the highest ledger written, or 0 if none was written yet.
+func (s *Store) LatestLedger(ctx context.Context) (uint32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latestLedger, nil
+}
+
+func addParticipants(existing, participants set.Set[string]) set.Set[string] {
+	if existing == nil {
+		existing = set.NewThreadUnsafeSet[string]()
+	}
+	for participant := range participants.Iter() {
+		if participant != "" {
+			existing.Add(participant)
+		}
+	}
+	return existing
+}
+
+// insertSorted inserts item in a slice sorted by ascending key. Ledgers are mostly written in
+// order, so the item usually goes at the end.
+func insertSorted[T any](items []T, item T, key func(T) int64) []T {
+	i := sort.Search(len(items), func(i int) bool { return key(items[i]) > key(item) })
+	items = append(items, item)
+	copy(items[i+1:], items[i:])
+	items[i] = item
+	return items
+}
+
+func stateChangeCursorLess(a, b types.StateChangeCursor) bool {
+	if a.ToID != b.ToID {
+		return a.ToID < b.ToID
+	}
+	return a.StateChangeOrder < b.StateChangeOrder
+}
@@ -0,0 +1,12 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/Trustless-Work/Indexer/internal/store/storetest"
+)
+
+func TestReader(t *testing.T) {
+	s := New()
+	storetest.TestReader(t, s, s.WriteLedger)
+}
@@ -0,0 +1,353 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	set "github.com/deckarep/golang-set/v2"
+)
+
+// pageOf returns a page of items sorted by ascending cursor, with the same semantics as the
+// Postgres store: the cursor is an exclusive bound in the order of the page.
+func pageOf[T, C any](items []T, page store.Page[C], cursor func(T) C, less func(a, b C) bool) []T {
+	page = page.Normalize()
+	result := make([]T, 0, min(page.Limit, len(items)))
+	for i := range items {
+		item := items[i]
+		if page.Order == store.SortDesc {
+			item = items[len(items)-1-i]
+		}
+		if page.Cursor != nil {
+			c := cursor(item)
+			if page.Order == store.SortAsc && !less(*page.Cursor, c) ||
+				page.Order == store.SortDesc && !less(c, *page.Cursor) {
+				continue
+			}
+		}
+		result = append(result, item)
+		if len(result) == page.Limit {
+			break
+		}
+	}
+	return result
+}
+
+func int64Less(a, b int64) bool {
+	return a < b
+}
+
+func notFound(entity, key string) error {
+	return fmt.Errorf("%s %s: %w", entity, key, store.ErrNotFound)
+}
+
+// GetAccount returns an account that participated in at least one indexed transaction.
+func (s *Store) GetAccount(ctx context.Context, address string) (*types.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	account, ok := s.accounts[address]
+	if !ok {
+		return nil, notFound("account", address)
+	}
+	a := *account
+	return &a, nil
+}
+
+// GetTransactionByHash returns a transaction.
+func (s *Store) GetTransactionByHash(ctx context.Context, hash string) (*types.Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tx, ok := s.transactions[hash]
+	if !ok {
+		return nil, notFound("transaction", hash)
+	}
+	t := *tx
+	return &t, nil
+}
+
+// GetTransactions returns a page of transactions, using the transaction TOID as cursor.
+func (s *Store) GetTransactions(ctx context.Context, filter store.TransactionFilter, page store.Page[int64]) ([]*types.TransactionWithCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	txs := s.transactionOrder
+	if filter.AccountID != "" {
+		txs = make([]*types.Transaction, 0)
+		for _, tx := range s.transactionOrder {
+			if participants := s.txParticipants[tx.Hash]; participants != nil && participants.Contains(filter.AccountID) {
+				txs = append(txs, tx)
+			}
+		}
+	}
+
+	txs = pageOf(txs, page, func(tx *types.Transaction) int64 { return tx.ToID }, int64Less)
+	result := make([]*types.TransactionWithCursor, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, &types.TransactionWithCursor{Transaction: *tx, Cursor: tx.ToID})
+	}
+	return result, nil
+}
+
+// GetOperationByID returns an operation.
+func (s *Store) GetOperationByID(ctx context.Context, id int64) (*types.Operation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	op, ok := s.operations[id]
+	if !ok {
+		return nil, notFound("operation", fmt.Sprint(id))
+	}
+	o := *op
+	return &o, nil
+}
+
+// GetOperations returns a page of operations, using the operation ID as cursor.
+func (s *Store) GetOperations(ctx context.Context, filter store.OperationFilter, page store.Page[int64]) ([]*types.OperationWithCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ops := make([]*types.Operation, 0)
+	for _, op := range s.operationOrder {
+		if filter.TxHash != "" && op.TxHash != filter.TxHash {
+			continue
+		}
+		if filter.AccountID != "" {
+			if participants := s.opParticipants[op.ID]; participants == nil || !participants.Contains(filter.AccountID) {
+				continue
+			}
+		}
+		ops = append(ops, op)
+	}
+
+	ops = pageOf(ops, page, func(op *types.Operation) int64 { return op.ID }, int64Less)
+	result := make([]*types.OperationWithCursor, 0, len(ops))
+	for _, op := range ops {
+		result = append(result, &types.OperationWithCursor{Operation: *op, Cursor: op.ID})
+	}
+	return result, nil
+}
+
+// GetStateChanges returns a page of state changes, using (to_id, state_change_order) as cursor.
+func (s *Store) GetStateChanges(ctx context.Context, filter store.StateChangeFilter, page store.Page[types.StateChangeCursor]) ([]*types.StateChangeWithCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stateChanges := make([]*types.StateChange, 0)
+	for _, sc := range s.stateChangeOrder {
+		if filter.AccountID != "" && sc.AccountID != filter.AccountID ||
+			filter.TxHash != "" && sc.TxHash != filter.TxHash ||
+			filter.OperationID != 0 && sc.OperationID != filter.OperationID ||
+			filter.Category != "" && sc.StateChangeCategory != filter.Category {
+			continue
+		}
+		stateChanges = append(stateChanges, sc)
+	}
+
+	stateChanges = pageOf(stateChanges, page, func(sc *types.StateChange) types.StateChangeCursor { return sc.GetCursor() }, stateChangeCursorLess)
+	result := make([]*types.StateChangeWithCursor, 0, len(stateChanges))
+	for _, sc := range stateChanges {
+		result = append(result, &types.StateChangeWithCursor{StateChange: *sc, Cursor: sc.GetCursor()})
+	}
+	return result, nil
+}
+
+// GetStateChangesByOperationIDs returns every state change of the operations, in cursor order.
+func (s *Store) GetStateChangesByOperationIDs(ctx context.Context, operationIDs []int64) ([]types.StateChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make(map[int64]bool, len(operationIDs))
+	for _, id := range operationIDs {
+		ids[id] = true
+	}
+	stateChanges := make([]types.StateChange, 0)
+	for _, sc := range s.stateChangeOrder {
+		if ids[sc.OperationID] {
+			stateChanges = append(stateChanges, *sc)
+		}
+	}
+	return stateChanges, nil
+}
+
+// GetAccountsByTxHash returns the participants of a transaction.
+func (s *Store) GetAccountsByTxHash(ctx context.Context, txHash string) ([]*types.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.accountsOf(s.txParticipants[txHash]), nil
+}
+
+// GetAccountsByOperationID returns the participants of an operation.
+func (s *Store) GetAccountsByOperationID(ctx context.Context, operationID int64) ([]*types.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.accountsOf(s.opParticipants[operationID]), nil
+}
+
+func (s *Store) accountsOf(participants set.Set[string]) []*types.Account {
+	accounts := make([]*types.Account, 0)
+	if participants == nil {
+		return accounts
+	}
+	addresses := participants.ToSlice()
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		if account, ok := s.accounts[address]; ok {
+			a := *account
+			accounts = append(accounts, &a)
+		}
+	}
+	return accounts
+}
+
+// GetAccountTimeline returns a page of the transactions, operations and state changes of an
+// account, interleaved in TOID order.
+func (s *Store) GetAccountTimeline(ctx context.Context, accountID string, page store.Page[store.TimelineCursor]) ([]*store.TimelineEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*store.TimelineEntry, 0)
+	for _, tx := range s.transactionOrder {
+		if participants := s.txParticipants[tx.Hash]; participants != nil && participants.Contains(accountID) {
+			t := *tx
+			entries = append(entries, &store.TimelineEntry{
+				Type:        store.TimelineTransaction,
+				Cursor:      store.TimelineCursor{ToID: tx.ToID, Rank: store.TimelineRankTransaction},
+				Transaction: &t,
+			})
+		}
+	}
+	for _, op := range s.operationOrder {
+		if participants := s.opParticipants[op.ID]; participants != nil && participants.Contains(accountID) {
+			o := *op
+			entries = append(entries, &store.TimelineEntry{
+				Type:      store.TimelineOperation,
+				Cursor:    store.TimelineCursor{ToID: op.ID, Rank: store.TimelineRankOperation},
+				Operation: &o,
+			})
+		}
+	}
+	for _, sc := range s.stateChangeOrder {
+		if sc.AccountID == accountID {
+			c := *sc
+			entries = append(entries, &store.TimelineEntry{
+				Type:        store.TimelineStateChange,
+				Cursor:      store.TimelineCursor{ToID: sc.ToID, Rank: store.TimelineRankStateChange, Order: sc.StateChangeOrder},
+				StateChange: &c,
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Cursor.Less(entries[j].Cursor) })
+
+	return pageOf(entries, page, func(entry *store.TimelineEntry) store.TimelineCursor { return entry.Cursor },
+		store.TimelineCursor.Less), nil
+}
+
+// GetEscrow returns an escrow by contract ID.
+func (s *Store) GetEscrow(ctx context.Context, contractID string) (*store.EscrowWithCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	escrow, ok := s.escrows[contractID]
+	if !ok {
+		return nil, notFound("escrow", contractID)
+	}
+	e := *escrow
+	return &e, nil
+}
+
+// GetEscrows returns a page of escrows, in deployment order.
+func (s *Store) GetEscrows(ctx context.Context, filter store.EscrowFilter, page store.Page[int64]) ([]*store.EscrowWithCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	escrows := make([]*store.EscrowWithCursor, 0)
+	for _, escrow := range s.escrowOrder {
+		if matchEscrow(escrow, filter) {
+			escrows = append(escrows, escrow)
+		}
+	}
+
+	escrows = pageOf(escrows, page, func(escrow *store.EscrowWithCursor) int64 { return escrow.Cursor }, int64Less)
+	result := make([]*store.EscrowWithCursor, 0, len(escrows))
+	for _, escrow := range escrows {
+		e := *escrow
+		result = append(result, &e)
+	}
+	return result, nil
+}
+
+func matchEscrow(escrow *store.EscrowWithCursor, filter store.EscrowFilter) bool {
+	if filter.EngagementID != "" && escrow.EngagementID != filter.EngagementID ||
+		filter.EscrowType != "" && escrow.EscrowType != filter.EscrowType ||
+		filter.FromLedger != 0 && escrow.LedgerNumber < filter.FromLedger ||
+		filter.ToLedger != 0 && escrow.LedgerNumber > filter.ToLedger {
+		return false
+	}
+
+	roles := []struct{ held, filter string }{
+		{escrow.Roles.ServiceProvider, filter.Roles.ServiceProvider},
+		{escrow.Roles.Approver, filter.Roles.Approver},
+		{escrow.Roles.ReleaseSigner, filter.Roles.ReleaseSigner},
+		{escrow.Roles.DisputeResolver, filter.Roles.DisputeResolver},
+		{escrow.Roles.PlatformAddress, filter.Roles.PlatformAddress},
+	}
+	for _, role := range roles {
+		if role.filter != "" && role.held != role.filter {
+			return false
+		}
+	}
+	if filter.Roles.Receiver != "" && !hasReceiver(escrow.Escrow, filter.Roles.Receiver) {
+		return false
+	}
+	if len(filter.PlatformAddresses) > 0 && !slices.Contains(filter.PlatformAddresses, escrow.Roles.PlatformAddress) {
+		return false
+	}
+
+	flags := []struct {
+		filter *bool
+		flag   func(entities.EscrowFlags) bool
+	}{
+		{filter.Flags.Approved, func(f entities.EscrowFlags) bool { return f.Approved }},
+		{filter.Flags.Disputed, func(f entities.EscrowFlags) bool { return f.Disputed }},
+		{filter.Flags.Released, func(f entities.EscrowFlags) bool { return f.Released }},
+		{filter.Flags.Resolved, func(f entities.EscrowFlags) bool { return f.Resolved }},
+	}
+	for _, f := range flags {
+		if f.filter != nil && hasFlag(escrow.Escrow, f.flag) != *f.filter {
+			return false
+		}
+	}
+	return true
+}
+
+// hasReceiver reports whether address is the receiver of the escrow or of one of its milestones.
+func hasReceiver(escrow entities.Escrow, address string) bool {
+	if escrow.Roles.Receiver == address {
+		return true
+	}
+	for _, milestone := range escrow.Milestones {
+		if milestone.Receiver == address {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFlag reports whether a flag is set on the escrow or on one of its milestones.
+func hasFlag(escrow entities.Escrow, flag func(entities.EscrowFlags) bool) bool {
+	if flag(escrow.Flags) {
+		return true
+	}
+	for _, milestone := range escrow.Milestones {
+		if milestone.Flags != nil && flag(*milestone.Flags) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+// SearchEscrows approximates the full-text search of the Postgres store: every word of the
+// query must appear in the escrow, except words prefixed by "-" which must not, and each
+// occurrence ranks by the field it appears in, using the default weights of Postgres. Phrases
+// and "or" are not supported, their words are matched separately.
+func (s *Store) SearchEscrows(ctx context.Context, query string, filter store.EscrowFilter, page store.Page[store.SearchCursor]) ([]*store.EscrowSearchResult, error) {
+	include, exclude := parseSearch(query)
+
+	s.mu.RLock()
+	results := make([]*store.EscrowSearchResult, 0)
+	for _, escrow := range s.escrowOrder {
+		if len(include) == 0 || !matchEscrow(escrow, filter) {
+			continue
+		}
+		rank, ok := rankEscrow(escrow.Escrow, include, exclude)
+		if ok {
+			results = append(results, &store.EscrowSearchResult{EscrowWithCursor: *escrow, Rank: rank})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		return searchCursorLess(results[i].SearchCursor(), results[j].SearchCursor())
+	})
+	page.Order = store.SortDesc
+	return pageOf(results, page, (*store.EscrowSearchResult).SearchCursor, searchCursorLess), nil
+}
+
+func searchCursorLess(a, b store.SearchCursor) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+	return a.EscrowID < b.EscrowID
+}
+
+func parseSearch(query string) (include, exclude []string) {
+	for _, field := range strings.Fields(query) {
+		negated := strings.HasPrefix(field, "-")
+		for _, word := range words(field) {
+			if negated {
+				exclude = append(exclude, word)
+			} else {
+				include = append(include, word)
+			}
+		}
+	}
+	return include, exclude
+}
+
+// weightedText is a field of an escrow and the weight of its words in the rank.
+type weightedText struct {
+	text   string
+	weight float32
+}
+
+// rankEscrow ranks an escrow against the words of a query, or returns false if it doesn't match.
+func rankEscrow(escrow entities.Escrow, include, exclude []string) (float32, bool) {
+	fields := []weightedText{
+		{escrow.Title, 1},
+		{escrow.Description, 0.4},
+		{escrow.ReceiverMemo, 0.1},
+	}
+	for _, milestone := range escrow.Milestones {
+		fields = append(fields, weightedText{milestone.Description, 0.2}, weightedText{milestone.Evidence, 0.2})
+	}
+
+	counts := make(map[string]float32)
+	for _, field := range fields {
+		for _, word := range words(field.text) {
+			counts[word] += field.weight
+		}
+	}
+	for _, word := range exclude {
+		if counts[word] > 0 {
+			return 0, false
+		}
+	}
+	var rank float32
+	for _, word := range include {
+		if counts[word] == 0 {
+			return 0, false
+		}
+		rank += counts[word]
+	}
+	return rank, true
+}
+
+// words splits text into lower case words, like the 'simple' text search configuration.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/lib/pq"
+)
+
+// apiKeyRow is the database representation of an API key.
+type apiKeyRow struct {
+	ID                string         `db:"id"`
+	Name              string         `db:"name"`
+	SecretHash        string         `db:"secret_hash"`
+	PlatformAddresses pq.StringArray `db:"platform_addresses"`
+	RateLimit         float64        `db:"rate_limit"`
+	Burst             int            `db:"burst"`
+	CreatedAt         time.Time      `db:"created_at"`
+	RevokedAt         *time.Time     `db:"revoked_at"`
+}
+
+const apiKeyColumns = `id, name, secret_hash, platform_addresses, rate_limit, burst, created_at, revoked_at`
+
+func (r apiKeyRow) toAPIKey() *store.APIKey {
+	return &store.APIKey{
+		ID:                r.ID,
+		Name:              r.Name,
+		SecretHash:        r.SecretHash,
+		PlatformAddresses: []string(r.PlatformAddresses),
+		RateLimit:         r.RateLimit,
+		Burst:             r.Burst,
+		CreatedAt:         r.CreatedAt,
+		RevokedAt:         r.RevokedAt,
+	}
+}
+
+// CreateAPIKey stores a new API key and sets its creation time.
+func (s *Store) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	platforms := key.PlatformAddresses
+	if platforms == nil {
+		platforms = []string{}
+	}
+	err := s.db.GetContext(ctx, &key.CreatedAt, `
+		INSERT INTO api_keys (id, name, secret_hash, platform_addresses, rate_limit, burst)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING created_at`,
+		key.ID, key.Name, key.SecretHash, pq.Array(platforms), key.RateLimit, key.Burst)
+	if err != nil {
+		return fmt.Errorf("creating API key %s: %w", key.ID, err)
+	}
+	return nil
+}
+
+// GetAPIKey returns an API key, revoked or not.
+func (s *Store) GetAPIKey(ctx context.Context, id string) (*store.APIKey, error) {
+	var row apiKeyRow
+	err := s.db.GetContext(ctx, &row, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, id)
+	if err != nil {
+		return nil, notFound(err, "API key", id)
+	}
+	return row.toAPIKey(), nil
+}
+
+// ListAPIKeys returns every API key, oldest first.
+func (s *Store) ListAPIKeys(ctx context.Context) ([]*store.APIKey, error) {
+	rows := make([]apiKeyRow, 0)
+	if err := s.db.SelectContext(ctx, &rows, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at, id`); err != nil {
+		return nil, fmt.Errorf("listing API keys: %w", err)
+	}
+	keys := make([]*store.APIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, row.toAPIKey())
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key. Revoking a revoked key is an error wrapping ErrNotFound.
+func (s *Store) RevokeAPIKey(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("revoking API key %s: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("active API key %s: %w", id, store.ErrNotFound)
+	}
+	return nil
+}
+
+// WriteAuditLog appends requests to the audit log.
+func (s *Store) WriteAuditLog(ctx context.Context, entries []store.AuditEntry) error {
+	rows := make([][]any, 0, len(entries))
+	for _, e := range entries {
+		keyID := &e.KeyID
+		if e.KeyID == "" {
+			keyID = nil
+		}
+		rows = append(rows, []any{keyID, e.Method, e.Path, e.Query, e.Status, e.Duration.Milliseconds(), e.RemoteAddr, e.At})
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+	if err := insertRows(ctx, tx, "api_audit_log",
+		[]string{"key_id", "method", "path", "query", "status", "duration_ms", "remote_addr", "created_at"}, rows); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing audit log: %w", err)
+	}
+	return nil
+}
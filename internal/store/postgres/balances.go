@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/jmoiron/sqlx"
+)
+
+// balanceDelta is the signed amount of a BALANCE state change row.
+const balanceDelta = `CASE WHEN sc.state_change_reason IN ('CREDIT', 'MINT') THEN sc.amount::numeric ELSE -sc.amount::numeric END`
+
+// balanceChanges matches the BALANCE state change rows of an account ($1) and token ($2),
+// including those that were not applied to the balance (balance_applied).
+const balanceChanges = `sc.account_id = $1 AND sc.token_id = $2 AND sc.state_change_category = 'BALANCE'
+	AND sc.state_change_reason IN ('CREDIT', 'DEBIT', 'MINT', 'BURN')`
+
+const balanceColumns = `account_id, token_id, amount::text AS amount, ledger_number,
+	reconciled_amount::text AS reconciled_amount, reconciled_ledger, reconciled_at`
+
+type balanceKey struct {
+	account string
+	token   string
+}
+
+// writeBalances adds the balance changes of the ledger to the balances. Balances already at
+// ledgerSeq or later are left untouched, so re-writing a ledger doesn't count it twice, and
+// returned: their balance changes must be stored as not applied.
+func writeBalances(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) (map[balanceKey]bool, error) {
+	deltas := make(map[balanceKey]*big.Int)
+	for _, sc := range buffer.GetStateChanges() {
+		if sc.StateChangeCategory != types.StateChangeCategoryBalance || sc.StateChangeReason == nil ||
+			!sc.TokenID.Valid || !sc.Amount.Valid {
+			continue
+		}
+		amount, ok := new(big.Int).SetString(sc.Amount.String, 10)
+		if !ok {
+			return nil, fmt.Errorf("parsing amount %q of state change %d-%d", sc.Amount.String, sc.ToID, sc.StateChangeOrder)
+		}
+		switch *sc.StateChangeReason {
+		case types.StateChangeReasonCredit, types.StateChangeReasonMint:
+		case types.StateChangeReasonDebit, types.StateChangeReasonBurn:
+			amount.Neg(amount)
+		default:
+			continue
+		}
+		key := balanceKey{account: sc.AccountID, token: sc.TokenID.String}
+		if deltas[key] == nil {
+			deltas[key] = new(big.Int)
+		}
+		deltas[key].Add(deltas[key], amount)
+	}
+
+	// Upsert in a stable order so concurrent writers lock the rows in the same order.
+	keys := make([]balanceKey, 0, len(deltas))
+	for key := range deltas {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].account != keys[j].account {
+			return keys[i].account < keys[j].account
+		}
+		return keys[i].token < keys[j].token
+	})
+	ahead := make(map[balanceKey]bool)
+	for _, key := range keys {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO balances (account_id, token_id, amount, ledger_number) VALUES ($1, $2, $3::numeric, $4)
+			ON CONFLICT (account_id, token_id) DO UPDATE
+			SET amount = balances.amount + EXCLUDED.amount, ledger_number = EXCLUDED.ledger_number, updated_at = NOW()
+			WHERE balances.ledger_number < EXCLUDED.ledger_number`,
+			key.account, key.token, deltas[key].String(), ledgerSeq)
+		if err != nil {
+			return nil, fmt.Errorf("updating balance of %s for %s: %w", key.account, key.token, err)
+		}
+		if updated, err := result.RowsAffected(); err != nil {
+			return nil, fmt.Errorf("updating balance of %s for %s: %w", key.account, key.token, err)
+		} else if updated == 0 {
+			ahead[key] = true
+		}
+	}
+	return ahead, nil
+}
+
+// writeTrustlines applies the trustline changes of the ledger, in order. A trustline changed
+// in a later ledger is left untouched, and removed trustlines are kept as removed, so writing
+// an older ledger can't bring back a previous state.
+func writeTrustlines(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+	for _, change := range buffer.GetTrustlineChanges() {
+		var removed bool
+		switch change.Operation {
+		case types.TrustlineOpAdd:
+		case types.TrustlineOpRemove:
+			removed = true
+		default:
+			continue
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO trustlines (account_id, asset, ledger_number, removed) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (account_id, asset) DO UPDATE SET ledger_number = EXCLUDED.ledger_number, removed = EXCLUDED.removed
+			WHERE trustlines.ledger_number <= EXCLUDED.ledger_number`,
+			change.AccountID, change.Asset, change.LedgerNumber, removed)
+		if err != nil {
+			return fmt.Errorf("applying trustline change of %s for %s: %w", change.AccountID, change.Asset, err)
+		}
+	}
+	return nil
+}
+
+func writeContractChanges(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+	rows := make([][]any, 0)
+	for _, change := range buffer.GetContractChanges() {
+		rows = append(rows, []any{change.AccountID, change.ContractID, change.ContractType, change.LedgerNumber})
+	}
+	return insertRows(ctx, tx, "account_contracts", []string{"account_id", "contract_id", "contract_type", "ledger_number"}, rows)
+}
+
+// GetBalances returns the current balances of an account, ordered by token.
+func (s *Store) GetBalances(ctx context.Context, accountID string) ([]*store.Balance, error) {
+	balances := make([]*store.Balance, 0)
+	err := s.db.SelectContext(ctx, &balances, `SELECT `+balanceColumns+` FROM balances WHERE account_id = $1 ORDER BY token_id`, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting balances of %s: %w", accountID, err)
+	}
+	return balances, nil
+}
+
+// GetBalance returns the balance of a token held by an account. A non-zero atLedger returns
+// the balance at the end of that ledger, by reverting the balance changes that came after
+// it and were applied to the balance. Balances before the first ingested ledger only account
+// for the ingested changes.
+func (s *Store) GetBalance(ctx context.Context, accountID, tokenID string, atLedger uint32) (*store.Balance, error) {
+	var balance store.Balance
+	err := s.db.GetContext(ctx, &balance, `SELECT `+balanceColumns+` FROM balances WHERE account_id = $1 AND token_id = $2`, accountID, tokenID)
+	if err != nil {
+		return nil, notFound(err, "balance", accountID+"/"+tokenID)
+	}
+	if atLedger == 0 || atLedger >= balance.LedgerNumber {
+		return &balance, nil
+	}
+
+	err = s.db.GetContext(ctx, &balance.Amount, `
+		SELECT ($4::numeric - COALESCE(SUM(`+balanceDelta+`), 0))::text FROM state_changes sc
+		WHERE `+balanceChanges+` AND sc.balance_applied AND sc.ledger_number > $3 AND sc.ledger_number <= $5`,
+		accountID, tokenID, atLedger, balance.Amount, balance.LedgerNumber)
+	if err != nil {
+		return nil, fmt.Errorf("getting balance of %s for %s at ledger %d: %w", accountID, tokenID, atLedger, err)
+	}
+	balance.LedgerNumber = atLedger
+	return &balance, nil
+}
+
+// SetReconciledBalance records the on-chain balance of a token at ledgerSeq and resets the
+// balance to it, plus the balance changes already ingested after ledgerSeq, which are then
+// all applied to the balance. Later ledgers keep being added by the writer.
+func (s *Store) SetReconciledBalance(ctx context.Context, accountID, tokenID, amount string, ledgerSeq uint32) (*store.Balance, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE state_changes sc SET balance_applied = TRUE
+		WHERE `+balanceChanges+` AND sc.ledger_number > $3 AND NOT sc.balance_applied`,
+		accountID, tokenID, ledgerSeq)
+	if err != nil {
+		return nil, fmt.Errorf("applying balance changes of %s for %s: %w", accountID, tokenID, err)
+	}
+
+	var balance store.Balance
+	err = tx.GetContext(ctx, &balance, `
+		INSERT INTO balances AS b (account_id, token_id, amount, ledger_number, reconciled_amount, reconciled_ledger, reconciled_at)
+		SELECT $1, $2, $3::numeric + COALESCE(SUM(`+balanceDelta+`), 0),
+			GREATEST($4, COALESCE(MAX(sc.ledger_number), 0)), $3::numeric, $4, NOW()
+		FROM state_changes sc WHERE `+balanceChanges+` AND sc.ledger_number > $4
+		ON CONFLICT (account_id, token_id) DO UPDATE
+		SET amount = EXCLUDED.amount, ledger_number = GREATEST(b.ledger_number, EXCLUDED.ledger_number),
+			reconciled_amount = EXCLUDED.reconciled_amount, reconciled_ledger = EXCLUDED.reconciled_ledger,
+			reconciled_at = EXCLUDED.reconciled_at, updated_at = NOW()
+		RETURNING `+balanceColumns,
+		accountID, tokenID, amount, ledgerSeq)
+	if err != nil {
+		return nil, fmt.Errorf("reconciling balance of %s for %s: %w", accountID, tokenID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing reconciliation of %s for %s: %w", accountID, tokenID, err)
+	}
+	return &balance, nil
+}
+
+// GetTrustlineAssets returns the classic assets, as CODE:ISSUER, an account has a trustline to.
+func (s *Store) GetTrustlineAssets(ctx context.Context, accountID string) ([]string, error) {
+	assets := make([]string, 0)
+	err := s.db.SelectContext(ctx, &assets, `SELECT asset FROM trustlines WHERE account_id = $1 AND NOT removed ORDER BY asset`, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting trustlines of %s: %w", accountID, err)
+	}
+	return assets, nil
+}
@@ -0,0 +1,155 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/stellar/go-stellar-sdk/toid"
+)
+
+const (
+	balanceAccount = "GHOLDER"
+	balanceToken   = "CTOKEN"
+	trustlineAsset = "USDC:GISSUER"
+)
+
+// balanceLedger is a ledger crediting amount of balanceToken to balanceAccount, and applying
+// the trustline changes.
+func balanceLedger(seq uint32, amount string, trustlines ...types.TrustlineOpType) *indexer.IndexerBuffer {
+	closedAt := time.Date(2026, 1, 1, 0, 0, int(seq), 0, time.UTC)
+	tx := types.Transaction{
+		Hash:            fmt.Sprintf("balance-%d", seq),
+		ToID:            toid.New(int32(seq), 1, 0).ToInt64(),
+		ResultXDR:       "AAAA",
+		LedgerNumber:    seq,
+		LedgerCreatedAt: closedAt,
+	}
+	op := types.Operation{
+		ID:              toid.New(int32(seq), 1, 1).ToInt64(),
+		OperationType:   types.OperationTypePayment,
+		TxHash:          tx.Hash,
+		LedgerNumber:    seq,
+		LedgerCreatedAt: closedAt,
+	}
+
+	buffer := indexer.NewIndexerBuffer()
+	buffer.PushTransaction(balanceAccount, tx)
+	buffer.PushOperation(balanceAccount, op, tx)
+	if amount != "" {
+		reason := types.StateChangeReasonCredit
+		buffer.PushStateChange(tx, op, types.StateChange{
+			ToID:                op.ID,
+			StateChangeOrder:    1,
+			StateChangeCategory: types.StateChangeCategoryBalance,
+			StateChangeReason:   &reason,
+			LedgerNumber:        seq,
+			LedgerCreatedAt:     closedAt,
+			AccountID:           balanceAccount,
+			OperationID:         op.ID,
+			TxHash:              tx.Hash,
+			TokenID:             sql.NullString{String: balanceToken, Valid: true},
+			Amount:              sql.NullString{String: amount, Valid: true},
+		})
+	}
+	for _, operation := range trustlines {
+		buffer.PushTrustlineChange(types.TrustlineChange{
+			AccountID:    balanceAccount,
+			Asset:        trustlineAsset,
+			OperationID:  op.ID,
+			LedgerNumber: seq,
+			Operation:    operation,
+		})
+	}
+	return buffer
+}
+
+func TestBalanceHistoryAfterBackfillAndRewind(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+	write := func(seq uint32, amount string, cursor string) {
+		t.Helper()
+		if err := s.WriteLedger(ctx, balanceLedger(seq, amount), seq, cursor); err != nil {
+			t.Fatalf("writing ledger %d: %v", seq, err)
+		}
+	}
+	checkBalance := func(atLedger uint32, want string) {
+		t.Helper()
+		balance, err := s.GetBalance(ctx, balanceAccount, balanceToken, atLedger)
+		if err != nil {
+			t.Fatalf("GetBalance at %d: %v", atLedger, err)
+		}
+		if balance.Amount != want {
+			t.Errorf("balance at ledger %d = %s, want %s", atLedger, balance.Amount, want)
+		}
+	}
+
+	// Live ingestion starts at ledger 100: the balance doesn't hold the earlier ledgers.
+	write(100, "50", "live")
+	write(102, "20", "live")
+	write(104, "10", "live")
+
+	// A backfill writes ledgers 101 and 103, which the balance doesn't hold.
+	write(101, "5", "")
+	write(103, "7", "")
+	checkBalance(0, "80")
+	checkBalance(100, "50")
+	checkBalance(101, "50")
+	checkBalance(102, "70")
+	checkBalance(103, "70")
+
+	// A rewind writes ledgers 102 to 104 again.
+	for seq, amount := range map[uint32]string{102: "20", 103: "7", 104: "10"} {
+		write(seq, amount, "live")
+	}
+	checkBalance(0, "80")
+	checkBalance(100, "50")
+	checkBalance(102, "70")
+
+	// A reconciliation at ledger 100 applies every later change: 55 + 5 + 20 + 7 + 10.
+	if _, err := s.SetReconciledBalance(ctx, balanceAccount, balanceToken, "55", 100); err != nil {
+		t.Fatal(err)
+	}
+	checkBalance(0, "97")
+	checkBalance(101, "60")
+	checkBalance(103, "87")
+}
+
+func TestTrustlinesAfterBackfill(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+	write := func(seq uint32, operation types.TrustlineOpType, cursor string) {
+		t.Helper()
+		if err := s.WriteLedger(ctx, balanceLedger(seq, "", operation), seq, cursor); err != nil {
+			t.Fatalf("writing ledger %d: %v", seq, err)
+		}
+	}
+	checkAssets := func(want ...string) {
+		t.Helper()
+		assets, err := s.GetTrustlineAssets(ctx, balanceAccount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(assets, want) {
+			t.Errorf("trustline assets = %v, want %v", assets, want)
+		}
+	}
+
+	write(105, types.TrustlineOpAdd, "live")
+	write(101, types.TrustlineOpRemove, "")
+	checkAssets(trustlineAsset)
+
+	write(110, types.TrustlineOpRemove, "live")
+	write(103, types.TrustlineOpAdd, "")
+	checkAssets()
+
+	write(112, types.TrustlineOpAdd, "live")
+	checkAssets(trustlineAsset)
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+)
+
+var ledgerStatsColumns = []string{
+	"network", "ledger_seq", "mode", "closed_at", "ingested_at",
+	"transactions", "operations", "participants", "state_changes", "escrows",
+	"fetch_ms", "process_ms", "sink_ms", "sink_durations_ms",
+}
+
+// WriteLedgerStats appends the stats of ingested ledgers. The stats of a ledger already
+// recorded are kept.
+func (s *Store) WriteLedgerStats(ctx context.Context, stats []store.LedgerStats) error {
+	rows := make([][]any, 0, len(stats))
+	for _, st := range stats {
+		sinkDurations := make(map[string]float64, len(st.SinkDurations))
+		for name, d := range st.SinkDurations {
+			sinkDurations[name] = milliseconds(d)
+		}
+		sinkDurationsJSON, err := json.Marshal(sinkDurations)
+		if err != nil {
+			return fmt.Errorf("encoding sink durations of ledger %d: %w", st.Ledger, err)
+		}
+		rows = append(rows, []any{
+			st.Network, int64(st.Ledger), st.Mode, st.ClosedAt, st.IngestedAt,
+			st.Transactions, st.Operations, st.Participants, st.StateChanges, st.Escrows,
+			milliseconds(st.FetchDuration), milliseconds(st.ProcessDuration), milliseconds(st.SinkDuration), string(sinkDurationsJSON),
+		})
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+	if err := insertRows(ctx, tx, "ingest_ledger_stats", ledgerStatsColumns, rows); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing ledger stats: %w", err)
+	}
+	return nil
+}
+
+// GetIngestSLO summarizes the stats of the live ledgers selected by the filter against the
+// visibility target.
+func (s *Store) GetIngestSLO(ctx context.Context, filter store.LedgerStatsFilter, target time.Duration) (*store.IngestSLO, error) {
+	var row struct {
+		Ledgers             int      `db:"ledgers"`
+		Escrows             int      `db:"escrows"`
+		LedgersWithinTarget int      `db:"ledgers_within_target"`
+		EscrowsWithinTarget int      `db:"escrows_within_target"`
+		DelayP50            *float64 `db:"delay_p50_ms"`
+		DelayP99            *float64 `db:"delay_p99_ms"`
+		DelayMax            *float64 `db:"delay_max_ms"`
+		ProcessP99          *float64 `db:"process_p99_ms"`
+		SinkP99             *float64 `db:"sink_p99_ms"`
+	}
+	err := s.db.GetContext(ctx, &row, `
+		WITH delays AS (
+			SELECT escrows, process_ms, sink_ms,
+				(EXTRACT(EPOCH FROM ingested_at - closed_at) * 1000)::double precision AS delay_ms
+			FROM ingest_ledger_stats
+			WHERE network = $1 AND mode = 'live'
+				AND ($2::timestamptz IS NULL OR closed_at >= $2)
+				AND ($3::timestamptz IS NULL OR closed_at < $3)
+		)
+		SELECT
+			COUNT(*) AS ledgers,
+			COALESCE(SUM(escrows), 0) AS escrows,
+			COUNT(*) FILTER (WHERE delay_ms <= $4) AS ledgers_within_target,
+			COALESCE(SUM(escrows) FILTER (WHERE delay_ms <= $4), 0) AS escrows_within_target,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY delay_ms) AS delay_p50_ms,
+			percentile_cont(0.99) WITHIN GROUP (ORDER BY delay_ms) AS delay_p99_ms,
+			MAX(delay_ms) AS delay_max_ms,
+			percentile_cont(0.99) WITHIN GROUP (ORDER BY process_ms) AS process_p99_ms,
+			percentile_cont(0.99) WITHIN GROUP (ORDER BY sink_ms) AS sink_p99_ms
+		FROM delays`,
+		filter.Network, nullTime(filter.From), nullTime(filter.To), milliseconds(target))
+	if err != nil {
+		return nil, fmt.Errorf("querying ingest SLO: %w", err)
+	}
+
+	return &store.IngestSLO{
+		Target:              target,
+		Ledgers:             row.Ledgers,
+		Escrows:             row.Escrows,
+		LedgersWithinTarget: row.LedgersWithinTarget,
+		EscrowsWithinTarget: row.EscrowsWithinTarget,
+		DelayP50:            fromMilliseconds(row.DelayP50),
+		DelayP99:            fromMilliseconds(row.DelayP99),
+		DelayMax:            fromMilliseconds(row.DelayMax),
+		ProcessP99:          fromMilliseconds(row.ProcessP99),
+		SinkP99:             fromMilliseconds(row.SinkP99),
+	}, nil
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// fromMilliseconds converts an aggregate in milliseconds, NULL without rows.
+func fromMilliseconds(ms *float64) time.Duration {
+	if ms == nil {
+		return 0
+	}
+	return time.Duration(*ms * float64(time.Millisecond))
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
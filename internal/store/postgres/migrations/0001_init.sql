@@ -0,0 +1,115 @@
+-- Ingestion cursors, one per writer (see sink/postgres).
+CREATE TABLE ingest_cursors (
+    name          TEXT PRIMARY KEY,
+    ledger_number BIGINT NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE accounts (
+    stellar_address TEXT PRIMARY KEY,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE transactions (
+    hash              TEXT PRIMARY KEY,
+    to_id             BIGINT NOT NULL UNIQUE,
+    envelope_xdr      TEXT,
+    result_xdr        TEXT NOT NULL,
+    meta_xdr          TEXT,
+    ledger_number     BIGINT NOT NULL,
+    ledger_created_at TIMESTAMPTZ NOT NULL,
+    ingested_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_transactions_ledger_number ON transactions (ledger_number);
+
+CREATE TABLE transactions_accounts (
+    tx_hash    TEXT NOT NULL,
+    account_id TEXT NOT NULL,
+    PRIMARY KEY (account_id, tx_hash)
+);
+CREATE INDEX idx_transactions_accounts_tx_hash ON transactions_accounts (tx_hash);
+
+CREATE TABLE operations (
+    id                BIGINT PRIMARY KEY,
+    tx_hash           TEXT NOT NULL,
+    operation_type    TEXT NOT NULL,
+    operation_xdr     TEXT,
+    ledger_number     BIGINT NOT NULL,
+    ledger_created_at TIMESTAMPTZ NOT NULL,
+    ingested_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_operations_tx_hash ON operations (tx_hash);
+
+CREATE TABLE operations_accounts (
+    operation_id BIGINT NOT NULL,
+    account_id   TEXT NOT NULL,
+    PRIMARY KEY (account_id, operation_id)
+);
+CREATE INDEX idx_operations_accounts_operation_id ON operations_accounts (operation_id);
+
+CREATE TABLE state_changes (
+    to_id                 BIGINT NOT NULL,
+    state_change_order    BIGINT NOT NULL,
+    state_change_category TEXT NOT NULL,
+    state_change_reason   TEXT,
+    ledger_number         BIGINT NOT NULL,
+    ledger_created_at     TIMESTAMPTZ NOT NULL,
+    ingested_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    account_id            TEXT NOT NULL,
+    operation_id          BIGINT NOT NULL,
+    tx_hash               TEXT NOT NULL,
+    token_id              TEXT,
+    amount                TEXT,
+    offer_id              TEXT,
+    signer_account_id     TEXT,
+    spender_account_id    TEXT,
+    sponsored_account_id  TEXT,
+    sponsor_account_id    TEXT,
+    deployer_account_id   TEXT,
+    funder_account_id     TEXT,
+    signer_weights        JSONB,
+    thresholds            JSONB,
+    trustline_limit       JSONB,
+    flags                 JSONB,
+    key_value             JSONB,
+    PRIMARY KEY (to_id, state_change_order)
+);
+CREATE INDEX idx_state_changes_account_id ON state_changes (account_id, to_id, state_change_order);
+CREATE INDEX idx_state_changes_tx_hash ON state_changes (tx_hash);
+CREATE INDEX idx_state_changes_operation_id ON state_changes (operation_id);
+
+CREATE TABLE escrows (
+    -- id is an insertion sequence used as pagination cursor.
+    id                        BIGSERIAL UNIQUE,
+    contract_id               TEXT PRIMARY KEY,
+    escrow_type               TEXT NOT NULL,
+    deployer                  TEXT NOT NULL,
+    factory_contract          TEXT NOT NULL,
+    deployer_salt             TEXT NOT NULL,
+    wasm_hash                 TEXT NOT NULL,
+    init_function             TEXT NOT NULL,
+    amount                    NUMERIC(20, 0) NOT NULL,
+    description               TEXT NOT NULL,
+    engagement_id             TEXT NOT NULL,
+    title                     TEXT NOT NULL,
+    platform_fee              BIGINT NOT NULL,
+    receiver_memo             TEXT NOT NULL,
+    flag_approved             BOOLEAN NOT NULL,
+    flag_disputed             BOOLEAN NOT NULL,
+    flag_released             BOOLEAN NOT NULL,
+    flag_resolved             BOOLEAN NOT NULL,
+    role_service_provider     TEXT NOT NULL,
+    role_receiver             TEXT NOT NULL,
+    role_approver             TEXT NOT NULL,
+    role_release_signer       TEXT NOT NULL,
+    role_dispute_resolver     TEXT NOT NULL,
+    role_platform_address     TEXT NOT NULL,
+    milestones                JSONB NOT NULL,
+    trustline_address         TEXT NOT NULL,
+    ledger_number             BIGINT NOT NULL,
+    ledger_created_at         TIMESTAMPTZ,
+    ingested_at               TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_escrows_engagement_id ON escrows (engagement_id);
+CREATE INDEX idx_escrows_platform_address ON escrows (role_platform_address);
+CREATE INDEX idx_escrows_ledger_number ON escrows (ledger_number);
@@ -0,0 +1,8 @@
+-- Indexes backing the escrow filters of the query APIs.
+CREATE INDEX idx_escrows_service_provider ON escrows (role_service_provider);
+CREATE INDEX idx_escrows_receiver ON escrows (role_receiver);
+CREATE INDEX idx_escrows_approver ON escrows (role_approver);
+CREATE INDEX idx_escrows_release_signer ON escrows (role_release_signer);
+CREATE INDEX idx_escrows_dispute_resolver ON escrows (role_dispute_resolver);
+CREATE INDEX idx_escrows_escrow_type ON escrows (escrow_type);
+CREATE INDEX idx_escrows_milestones ON escrows USING GIN (milestones jsonb_path_ops);
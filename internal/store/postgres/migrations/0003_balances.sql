@@ -0,0 +1,36 @@
+-- Token balances derived from the BALANCE state changes (see balances.go). amount is the
+-- balance as of ledger_number, the last ledger applied to it. A reconciliation against the
+-- ledger entries of the network resets amount and records the on-chain value.
+CREATE TABLE balances (
+    account_id        TEXT NOT NULL,
+    token_id          TEXT NOT NULL,
+    amount            NUMERIC NOT NULL,
+    ledger_number     BIGINT NOT NULL,
+    reconciled_amount NUMERIC,
+    reconciled_ledger BIGINT,
+    reconciled_at     TIMESTAMPTZ,
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, token_id)
+);
+
+-- Classic assets an account has a trustline to, from the TrustlineChange of the buffer.
+CREATE TABLE trustlines (
+    account_id    TEXT NOT NULL,
+    asset         TEXT NOT NULL,
+    ledger_number BIGINT NOT NULL,
+    PRIMARY KEY (account_id, asset)
+);
+
+-- Tokens whose balance is stored in the contract data of the token, from the ContractChange
+-- of the buffer.
+CREATE TABLE account_contracts (
+    account_id    TEXT NOT NULL,
+    contract_id   TEXT NOT NULL,
+    contract_type TEXT NOT NULL,
+    ledger_number BIGINT NOT NULL,
+    PRIMARY KEY (account_id, contract_id)
+);
+
+-- Historical balances sum the balance changes of an account and token after a ledger.
+CREATE INDEX idx_state_changes_balance ON state_changes (account_id, token_id, ledger_number)
+    WHERE state_change_category = 'BALANCE';
@@ -0,0 +1,28 @@
+-- API keys of the query server (see internal/auth). Only the SHA-256 of the secret part of a
+-- key is stored; the key is shown once, when it is created. An empty platform_addresses
+-- grants access to every escrow.
+CREATE TABLE api_keys (
+    id                 TEXT PRIMARY KEY,
+    name               TEXT NOT NULL,
+    secret_hash        TEXT NOT NULL,
+    platform_addresses TEXT[] NOT NULL DEFAULT '{}',
+    rate_limit         DOUBLE PRECISION NOT NULL,
+    burst              INTEGER NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at         TIMESTAMPTZ
+);
+
+-- One row per request served by the query server, authenticated or not.
+CREATE TABLE api_audit_log (
+    id          BIGSERIAL PRIMARY KEY,
+    key_id      TEXT,
+    method      TEXT NOT NULL,
+    path        TEXT NOT NULL,
+    query       TEXT NOT NULL,
+    status      INTEGER NOT NULL,
+    duration_ms BIGINT NOT NULL,
+    remote_addr TEXT NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_api_audit_log_key ON api_audit_log (key_id, created_at);
@@ -0,0 +1,11 @@
+-- Full-text search over the free text of the escrows (see SearchEscrows). The 'simple'
+-- configuration doesn't stem, as escrows are written in any language. Weights rank the title
+-- (A) above the description (B), the milestones (C) and the receiver memo (D).
+ALTER TABLE escrows ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', title), 'A') ||
+    setweight(to_tsvector('simple', description), 'B') ||
+    setweight(jsonb_to_tsvector('simple', jsonb_path_query_array(milestones, '$[*].Description'), '["string"]'), 'C') ||
+    setweight(jsonb_to_tsvector('simple', jsonb_path_query_array(milestones, '$[*].Evidence'), '["string"]'), 'C') ||
+    setweight(to_tsvector('simple', receiver_memo), 'D')
+) STORED;
+CREATE INDEX idx_escrows_search_vector ON escrows USING GIN (search_vector);
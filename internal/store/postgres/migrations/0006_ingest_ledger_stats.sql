@@ -0,0 +1,22 @@
+-- Stats of the ingestion of every ledger, for the visibility SLOs and the investigation of
+-- regressions (see store.LedgerStats). A rewound ledger keeps the stats of its first
+-- ingestion, when its entities became visible. Durations are in milliseconds, and
+-- sink_durations_ms maps the sinks of a multi sink to their write duration.
+CREATE TABLE ingest_ledger_stats (
+    network           TEXT NOT NULL,
+    ledger_seq        BIGINT NOT NULL,
+    closed_at         TIMESTAMPTZ NOT NULL,
+    ingested_at       TIMESTAMPTZ NOT NULL,
+    transactions      INTEGER NOT NULL,
+    operations        INTEGER NOT NULL,
+    participants      INTEGER NOT NULL,
+    state_changes     INTEGER NOT NULL,
+    escrows           INTEGER NOT NULL,
+    fetch_ms          DOUBLE PRECISION NOT NULL,
+    process_ms        DOUBLE PRECISION NOT NULL,
+    sink_ms           DOUBLE PRECISION NOT NULL,
+    sink_durations_ms JSONB NOT NULL DEFAULT '{}',
+    PRIMARY KEY (network, ledger_seq)
+);
+
+CREATE INDEX idx_ingest_ledger_stats_closed_at ON ingest_ledger_stats (network, closed_at);
@@ -0,0 +1,6 @@
+-- Escrows are updated by their lifecycle invocations (approve, dispute, resolve, release, fund).
+-- updated_ledger_number is the ledger of the state stored, so replays and backfills never
+-- overwrite a newer state. ledger_number stays the ledger the escrow was deployed in.
+ALTER TABLE escrows ADD COLUMN updated_ledger_number BIGINT;
+UPDATE escrows SET updated_ledger_number = ledger_number;
+ALTER TABLE escrows ALTER COLUMN updated_ledger_number SET NOT NULL;
@@ -0,0 +1,32 @@
+-- Milestones are stored with the json names of entities.Milestone (description, status, approved,
+-- evidence, amount, flags, receiver) instead of its Go field names, so the filters and the search
+-- no longer depend on how the Go type is spelled. Existing rows are rewritten to the new names.
+ALTER TABLE escrows DROP COLUMN search_vector;
+
+UPDATE escrows SET milestones = COALESCE((
+    SELECT jsonb_agg(jsonb_strip_nulls(jsonb_build_object(
+        'description', m->'Description',
+        'status', m->'Status',
+        'approved', m->'Approved',
+        'evidence', m->'Evidence',
+        'amount', m->'Amount',
+        'flags', CASE WHEN jsonb_typeof(m->'Flags') = 'object' THEN jsonb_build_object(
+            'approved', m->'Flags'->'Approved',
+            'disputed', m->'Flags'->'Disputed',
+            'released', m->'Flags'->'Released',
+            'resolved', m->'Flags'->'Resolved'
+        ) END,
+        'receiver', m->'Receiver'
+    )) ORDER BY i)
+    FROM jsonb_array_elements(milestones) WITH ORDINALITY AS t(m, i)
+), '[]'::jsonb)
+WHERE jsonb_path_exists(milestones, '$[*].Description');
+
+ALTER TABLE escrows ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', title), 'A') ||
+    setweight(to_tsvector('simple', description), 'B') ||
+    setweight(jsonb_to_tsvector('simple', jsonb_path_query_array(milestones, '$[*].description'), '["string"]'), 'C') ||
+    setweight(jsonb_to_tsvector('simple', jsonb_path_query_array(milestones, '$[*].evidence'), '["string"]'), 'C') ||
+    setweight(to_tsvector('simple', receiver_memo), 'D')
+) STORED;
+CREATE INDEX idx_escrows_search_vector ON escrows USING GIN (search_vector);
@@ -0,0 +1,5 @@
+-- How each ledger was ingested: live, catchup (behind the tip, e.g. after a restart) or
+-- backfill. The visibility SLO only counts the live ledgers. The mode of the stats recorded
+-- before is unknown, they are counted as live.
+ALTER TABLE ingest_ledger_stats ADD COLUMN mode TEXT NOT NULL DEFAULT 'live';
+ALTER TABLE ingest_ledger_stats ALTER COLUMN mode DROP DEFAULT;
@@ -0,0 +1,8 @@
+-- Backfills and rewinds write ledgers older than the balances. balance_applied records whether
+-- the amount of a BALANCE state change was added to its balance when it was written, so the
+-- historical balances only revert the changes the balance holds.
+ALTER TABLE state_changes ADD COLUMN balance_applied BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- A removed trustline is kept, with the ledger of its removal, so an older ledger written
+-- afterwards can't add it back, nor remove a trustline added since.
+ALTER TABLE trustlines ADD COLUMN removed BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,415 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/lib/pq"
+)
+
+const (
+	transactionColumns = `t.hash, t.to_id, t.envelope_xdr, t.result_xdr, t.meta_xdr, t.ledger_number, t.ledger_created_at, t.ingested_at`
+	operationColumns   = `o.id, o.tx_hash, o.operation_type, o.operation_xdr, o.ledger_number, o.ledger_created_at, o.ingested_at`
+)
+
+var stateChangeSelectColumns = "sc." + strings.Join(stateChangeColumns, ", sc.") + ", sc.ingested_at"
+
+// query accumulates the conditions and arguments of a SELECT.
+type query struct {
+	conditions []string
+	args       []any
+}
+
+// where adds a condition. The condition references the new argument as "?".
+func (q *query) where(condition string, arg any) {
+	q.args = append(q.args, arg)
+	q.conditions = append(q.conditions, strings.Replace(condition, "?", fmt.Sprintf("$%d", len(q.args)), 1))
+}
+
+func (q *query) whereClause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// limit adds the LIMIT argument and returns the clause.
+func (q *query) limit(limit int) string {
+	q.args = append(q.args, limit)
+	return fmt.Sprintf(" LIMIT $%d", len(q.args))
+}
+
+func cursorComparison(order store.SortOrder) string {
+	if order == store.SortAsc {
+		return ">"
+	}
+	return "<"
+}
+
+func notFound(err error, entity, key string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s %s: %w", entity, key, store.ErrNotFound)
+	}
+	return fmt.Errorf("getting %s %s: %w", entity, key, err)
+}
+
+// GetAccount returns an account that participated in at least one indexed transaction.
+func (s *Store) GetAccount(ctx context.Context, address string) (*types.Account, error) {
+	var account types.Account
+	err := s.db.GetContext(ctx, &account, `SELECT stellar_address, created_at FROM accounts WHERE stellar_address = $1`, address)
+	if err != nil {
+		return nil, notFound(err, "account", address)
+	}
+	return &account, nil
+}
+
+// GetTransactionByHash returns a transaction.
+func (s *Store) GetTransactionByHash(ctx context.Context, hash string) (*types.Transaction, error) {
+	var tx types.Transaction
+	err := s.db.GetContext(ctx, &tx, `SELECT `+transactionColumns+` FROM transactions t WHERE t.hash = $1`, hash)
+	if err != nil {
+		return nil, notFound(err, "transaction", hash)
+	}
+	return &tx, nil
+}
+
+// GetTransactions returns a page of transactions, using the transaction TOID as cursor.
+func (s *Store) GetTransactions(ctx context.Context, filter store.TransactionFilter, page store.Page[int64]) ([]*types.TransactionWithCursor, error) {
+	page = page.Normalize()
+	q := &query{}
+	from := `transactions t`
+	if filter.AccountID != "" {
+		from += ` JOIN transactions_accounts ta ON ta.tx_hash = t.hash`
+		q.where(`ta.account_id = ?`, filter.AccountID)
+	}
+	if page.Cursor != nil {
+		q.where(`t.to_id `+cursorComparison(page.Order)+` ?`, *page.Cursor)
+	}
+
+	sqlQuery := `SELECT ` + transactionColumns + `, t.to_id AS cursor FROM ` + from + q.whereClause() +
+		` ORDER BY t.to_id ` + string(page.Order) + q.limit(page.Limit)
+
+	txs := make([]*types.TransactionWithCursor, 0)
+	if err := s.db.SelectContext(ctx, &txs, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("getting transactions: %w", err)
+	}
+	return txs, nil
+}
+
+// GetOperationByID returns an operation.
+func (s *Store) GetOperationByID(ctx context.Context, id int64) (*types.Operation, error) {
+	var op types.Operation
+	err := s.db.GetContext(ctx, &op, `SELECT `+operationColumns+` FROM operations o WHERE o.id = $1`, id)
+	if err != nil {
+		return nil, notFound(err, "operation", fmt.Sprint(id))
+	}
+	return &op, nil
+}
+
+// GetOperations returns a page of operations, using the operation ID as cursor.
+func (s *Store) GetOperations(ctx context.Context, filter store.OperationFilter, page store.Page[int64]) ([]*types.OperationWithCursor, error) {
+	page = page.Normalize()
+	q := &query{}
+	from := `operations o`
+	if filter.AccountID != "" {
+		from += ` JOIN operations_accounts oa ON oa.operation_id = o.id`
+		q.where(`oa.account_id = ?`, filter.AccountID)
+	}
+	if filter.TxHash != "" {
+		q.where(`o.tx_hash = ?`, filter.TxHash)
+	}
+	if page.Cursor != nil {
+		q.where(`o.id `+cursorComparison(page.Order)+` ?`, *page.Cursor)
+	}
+
+	sqlQuery := `SELECT ` + operationColumns + `, o.id AS cursor FROM ` + from + q.whereClause() +
+		` ORDER BY o.id ` + string(page.Order) + q.limit(page.Limit)
+
+	ops := make([]*types.OperationWithCursor, 0)
+	if err := s.db.SelectContext(ctx, &ops, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("getting operations: %w", err)
+	}
+	return ops, nil
+}
+
+// GetStateChanges returns a page of state changes, using (to_id, state_change_order) as cursor.
+func (s *Store) GetStateChanges(ctx context.Context, filter store.StateChangeFilter, page store.Page[types.StateChangeCursor]) ([]*types.StateChangeWithCursor, error) {
+	page = page.Normalize()
+	q := &query{}
+	if filter.AccountID != "" {
+		q.where(`sc.account_id = ?`, filter.AccountID)
+	}
+	if filter.TxHash != "" {
+		q.where(`sc.tx_hash = ?`, filter.TxHash)
+	}
+	if filter.OperationID != 0 {
+		q.where(`sc.operation_id = ?`, filter.OperationID)
+	}
+	if filter.Category != "" {
+		q.where(`sc.state_change_category = ?`, filter.Category)
+	}
+	if page.Cursor != nil {
+		q.args = append(q.args, page.Cursor.ToID, page.Cursor.StateChangeOrder)
+		q.conditions = append(q.conditions, fmt.Sprintf(`(sc.to_id, sc.state_change_order) %s ($%d, $%d)`,
+			cursorComparison(page.Order), len(q.args)-1, len(q.args)))
+	}
+
+	sqlQuery := `SELECT ` + stateChangeSelectColumns +
+		`, sc.to_id AS "cursor.cursor_to_id", sc.state_change_order AS "cursor.cursor_state_change_order"` +
+		` FROM state_changes sc` + q.whereClause() +
+		fmt.Sprintf(` ORDER BY sc.to_id %[1]s, sc.state_change_order %[1]s`, page.Order) + q.limit(page.Limit)
+
+	stateChanges := make([]*types.StateChangeWithCursor, 0)
+	if err := s.db.SelectContext(ctx, &stateChanges, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("getting state changes: %w", err)
+	}
+	return stateChanges, nil
+}
+
+// GetAccountsByTxHash returns the participants of a transaction.
+func (s *Store) GetAccountsByTxHash(ctx context.Context, txHash string) ([]*types.Account, error) {
+	accounts := make([]*types.Account, 0)
+	err := s.db.SelectContext(ctx, &accounts, `
+		SELECT a.stellar_address, a.created_at FROM accounts a
+		JOIN transactions_accounts ta ON ta.account_id = a.stellar_address
+		WHERE ta.tx_hash = $1 ORDER BY a.stellar_address`, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("getting accounts of transaction %s: %w", txHash, err)
+	}
+	return accounts, nil
+}
+
+// GetAccountsByOperationID returns the participants of an operation.
+func (s *Store) GetAccountsByOperationID(ctx context.Context, operationID int64) ([]*types.Account, error) {
+	accounts := make([]*types.Account, 0)
+	err := s.db.SelectContext(ctx, &accounts, `
+		SELECT a.stellar_address, a.created_at FROM accounts a
+		JOIN operations_accounts oa ON oa.account_id = a.stellar_address
+		WHERE oa.operation_id = $1 ORDER BY a.stellar_address`, operationID)
+	if err != nil {
+		return nil, fmt.Errorf("getting accounts of operation %d: %w", operationID, err)
+	}
+	return accounts, nil
+}
+
+// escrowRow is the database representation of an escrow.
+type escrowRow struct {
+	ID                  int64        `db:"id"`
+	ContractID          string       `db:"contract_id"`
+	EscrowType          string       `db:"escrow_type"`
+	Deployer            string       `db:"deployer"`
+	FactoryContract     string       `db:"factory_contract"`
+	DeployerSalt        string       `db:"deployer_salt"`
+	WasmHash            string       `db:"wasm_hash"`
+	InitFunction        string       `db:"init_function"`
+	Amount              uint64       `db:"amount"`
+	Description         string       `db:"description"`
+	EngagementID        string       `db:"engagement_id"`
+	Title               string       `db:"title"`
+	PlatformFee         uint32       `db:"platform_fee"`
+	ReceiverMemo        string       `db:"receiver_memo"`
+	FlagApproved        bool         `db:"flag_approved"`
+	FlagDisputed        bool         `db:"flag_disputed"`
+	FlagReleased        bool         `db:"flag_released"`
+	FlagResolved        bool         `db:"flag_resolved"`
+	RoleServiceProvider string       `db:"role_service_provider"`
+	RoleReceiver        string       `db:"role_receiver"`
+	RoleApprover        string       `db:"role_approver"`
+	RoleReleaseSigner   string       `db:"role_release_signer"`
+	RoleDisputeResolver string       `db:"role_dispute_resolver"`
+	RolePlatformAddress string       `db:"role_platform_address"`
+	Milestones          []byte       `db:"milestones"`
+	TrustlineAddress    string       `db:"trustline_address"`
+	LedgerNumber        uint32       `db:"ledger_number"`
+	LedgerCreatedAt     sql.NullTime `db:"ledger_created_at"`
+	UpdatedLedgerNumber uint32       `db:"updated_ledger_number"`
+	IngestedAt          time.Time    `db:"ingested_at"`
+}
+
+var escrowSelectColumns = "e.id, e." + strings.Join(escrowColumns, ", e.") + ", e.ingested_at"
+
+func (r escrowRow) toEscrow() (*store.EscrowWithCursor, error) {
+	escrow := &store.EscrowWithCursor{
+		Escrow: entities.Escrow{
+			ContractID:      r.ContractID,
+			EscrowType:      entities.EscrowType(r.EscrowType),
+			Deployer:        r.Deployer,
+			FactoryContract: r.FactoryContract,
+			DeployerSalt:    r.DeployerSalt,
+			WasmHash:        r.WasmHash,
+			InitFunction:    r.InitFunction,
+			Amount:          r.Amount,
+			Description:     r.Description,
+			EngagementID:    r.EngagementID,
+			Title:           r.Title,
+			PlatformFee:     r.PlatformFee,
+			ReceiverMemo:    r.ReceiverMemo,
+			Flags: entities.EscrowFlags{
+				Approved: r.FlagApproved,
+				Disputed: r.FlagDisputed,
+				Released: r.FlagReleased,
+				Resolved: r.FlagResolved,
+			},
+			Roles: entities.EscrowRoles{
+				ServiceProvider: r.RoleServiceProvider,
+				Receiver:        r.RoleReceiver,
+				Approver:        r.RoleApprover,
+				ReleaseSigner:   r.RoleReleaseSigner,
+				DisputeResolver: r.RoleDisputeResolver,
+				PlatformAddress: r.RolePlatformAddress,
+			},
+			TrustlineAddress: r.TrustlineAddress,
+		},
+		LedgerNumber:        r.LedgerNumber,
+		LedgerCreatedAt:     r.LedgerCreatedAt.Time,
+		UpdatedLedgerNumber: r.UpdatedLedgerNumber,
+		Cursor:              r.ID,
+	}
+	if err := json.Unmarshal(r.Milestones, &escrow.Milestones); err != nil {
+		return nil, fmt.Errorf("unmarshalling milestones of escrow %s: %w", r.ContractID, err)
+	}
+	return escrow, nil
+}
+
+// GetEscrow returns an escrow by contract ID.
+func (s *Store) GetEscrow(ctx context.Context, contractID string) (*store.EscrowWithCursor, error) {
+	var row escrowRow
+	err := s.db.GetContext(ctx, &row, `SELECT `+escrowSelectColumns+` FROM escrows e WHERE e.contract_id = $1`, contractID)
+	if err != nil {
+		return nil, notFound(err, "escrow", contractID)
+	}
+	return row.toEscrow()
+}
+
+// GetEscrows returns a page of escrows, in deployment order.
+func (s *Store) GetEscrows(ctx context.Context, filter store.EscrowFilter, page store.Page[int64]) ([]*store.EscrowWithCursor, error) {
+	page = page.Normalize()
+	q := &query{}
+	whereEscrow(q, filter)
+	if page.Cursor != nil {
+		q.where(`e.id `+cursorComparison(page.Order)+` ?`, *page.Cursor)
+	}
+
+	sqlQuery := `SELECT ` + escrowSelectColumns + ` FROM escrows e` + q.whereClause() +
+		` ORDER BY e.id ` + string(page.Order) + q.limit(page.Limit)
+
+	rows := make([]escrowRow, 0)
+	if err := s.db.SelectContext(ctx, &rows, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("getting escrows: %w", err)
+	}
+
+	escrows := make([]*store.EscrowWithCursor, 0, len(rows))
+	for _, row := range rows {
+		escrow, err := row.toEscrow()
+		if err != nil {
+			return nil, err
+		}
+		escrows = append(escrows, escrow)
+	}
+	return escrows, nil
+}
+
+// SearchEscrows returns a page of the escrows matching a full-text search, best ranked first.
+// The query is parsed by websearch_to_tsquery: words must all match unless separated by "or",
+// "quoted phrases" match in order and -word excludes a word.
+func (s *Store) SearchEscrows(ctx context.Context, search string, filter store.EscrowFilter, page store.Page[store.SearchCursor]) ([]*store.EscrowSearchResult, error) {
+	page = page.Normalize()
+	q := &query{}
+	q.where(`e.search_vector @@ websearch_to_tsquery('simple', ?)`, search)
+	const rank = `ts_rank_cd(e.search_vector, websearch_to_tsquery('simple', $1))`
+	whereEscrow(q, filter)
+	if page.Cursor != nil {
+		q.args = append(q.args, page.Cursor.Rank, page.Cursor.EscrowID)
+		q.conditions = append(q.conditions, fmt.Sprintf(`(%s, e.id) < ($%d::real, $%d)`, rank, len(q.args)-1, len(q.args)))
+	}
+
+	sqlQuery := `SELECT ` + escrowSelectColumns + `, ` + rank + ` AS rank FROM escrows e` + q.whereClause() +
+		` ORDER BY rank DESC, e.id DESC` + q.limit(page.Limit)
+
+	var rows []struct {
+		escrowRow
+		Rank float32 `db:"rank"`
+	}
+	if err := s.db.SelectContext(ctx, &rows, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("searching escrows: %w", err)
+	}
+
+	results := make([]*store.EscrowSearchResult, 0, len(rows))
+	for _, row := range rows {
+		escrow, err := row.toEscrow()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &store.EscrowSearchResult{EscrowWithCursor: *escrow, Rank: row.Rank})
+	}
+	return results, nil
+}
+
+// whereEscrow adds the conditions of an escrow filter.
+func whereEscrow(q *query, filter store.EscrowFilter) {
+	if filter.EngagementID != "" {
+		q.where(`e.engagement_id = ?`, filter.EngagementID)
+	}
+	if filter.EscrowType != "" {
+		q.where(`e.escrow_type = ?`, filter.EscrowType)
+	}
+	whereRoles(q, filter.Roles)
+	whereFlags(q, filter.Flags)
+	if len(filter.PlatformAddresses) > 0 {
+		q.where(`e.role_platform_address = ANY(?)`, pq.Array(filter.PlatformAddresses))
+	}
+	if filter.FromLedger != 0 {
+		q.where(`e.ledger_number >= ?`, filter.FromLedger)
+	}
+	if filter.ToLedger != 0 {
+		q.where(`e.ledger_number <= ?`, filter.ToLedger)
+	}
+}
+
+func whereRoles(q *query, roles entities.EscrowRoles) {
+	columns := []struct{ column, address string }{
+		{"role_service_provider", roles.ServiceProvider},
+		{"role_approver", roles.Approver},
+		{"role_release_signer", roles.ReleaseSigner},
+		{"role_dispute_resolver", roles.DisputeResolver},
+		{"role_platform_address", roles.PlatformAddress},
+	}
+	for _, c := range columns {
+		if c.address != "" {
+			q.where(`e.`+c.column+` = ?`, c.address)
+		}
+	}
+	if roles.Receiver != "" {
+		// Milestones are stored as the JSON of []entities.Milestone, see its json tags.
+		receiver, _ := json.Marshal([]map[string]string{{"receiver": roles.Receiver}})
+		q.args = append(q.args, roles.Receiver, string(receiver))
+		q.conditions = append(q.conditions, fmt.Sprintf(`(e.role_receiver = $%d OR e.milestones @> $%d::jsonb)`, len(q.args)-1, len(q.args)))
+	}
+}
+
+func whereFlags(q *query, flags store.EscrowFlagsFilter) {
+	filters := []struct {
+		name  string
+		value *bool
+	}{
+		{"approved", flags.Approved},
+		{"disputed", flags.Disputed},
+		{"released", flags.Released},
+		{"resolved", flags.Resolved},
+	}
+	for _, f := range filters {
+		if f.value == nil {
+			continue
+		}
+		q.where(fmt.Sprintf(`(e.flag_%s OR EXISTS (SELECT 1 FROM jsonb_array_elements(e.milestones) m WHERE (m->'flags'->>'%s')::boolean)) = ?`,
+			f.name, f.name), *f.value)
+	}
+}
@@ -0,0 +1,66 @@
+// Package postgres stores the indexed data in PostgreSQL and serves the queries of the
+// APIs (GraphQL, REST).
+//
+// The schema mirrors the types package: one table per entity, with the participants of
+// transactions and operations in the transactions_accounts/operations_accounts join tables.
+// Rows are written once per ledger, in a single database transaction that also advances
+// the ingestion cursor, so a ledger is either fully stored or not at all.
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+const (
+	defaultMaxOpenConns    = 20
+	defaultConnMaxIdleTime = 5 * time.Minute
+)
+
+// Store is the PostgreSQL store of indexed data.
+type Store struct {
+	db *sqlx.DB
+}
+
+var _ store.Reader = (*Store)(nil)
+
+// Open connects to the database and checks the connection.
+func Open(ctx context.Context, dsn string) (*Store, error) {
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening database: %w", err)
+	}
+	db.SetMaxOpenConns(defaultMaxOpenConns)
+	db.SetConnMaxIdleTime(defaultConnMaxIdleTime)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("pinging database: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// DB returns the underlying connection pool.
+func (s *Store) DB() *sqlx.DB {
+	return s.db
+}
+
+// Ping checks the database connection.
+func (s *Store) Ping(ctx context.Context) error {
+	if err := s.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("pinging database: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("closing database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/lib/pq"
+)
+
+// GetAccountTimeline returns a page of the transactions, operations and state changes of an
+// account, interleaved in TOID order. The page is located with a first query on the keys of
+// the entries, then the entries are loaded by kind.
+func (s *Store) GetAccountTimeline(ctx context.Context, accountID string, page store.Page[store.TimelineCursor]) ([]*store.TimelineEntry, error) {
+	page = page.Normalize()
+	q := &query{}
+	q.args = append(q.args, accountID)
+	if page.Cursor != nil {
+		q.args = append(q.args, page.Cursor.ToID, page.Cursor.Rank, page.Cursor.Order)
+		q.conditions = append(q.conditions, fmt.Sprintf(`(to_id, rank, sc_order) %s ($2, $3, $4)`, cursorComparison(page.Order)))
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT to_id, rank, sc_order FROM (
+			SELECT t.to_id, %[1]d AS rank, 0::bigint AS sc_order
+			FROM transactions t JOIN transactions_accounts ta ON ta.tx_hash = t.hash
+			WHERE ta.account_id = $1
+			UNION ALL
+			SELECT oa.operation_id, %[2]d, 0 FROM operations_accounts oa WHERE oa.account_id = $1
+			UNION ALL
+			SELECT sc.to_id, %[3]d, sc.state_change_order FROM state_changes sc WHERE sc.account_id = $1
+		) timeline`, store.TimelineRankTransaction, store.TimelineRankOperation, store.TimelineRankStateChange) +
+		q.whereClause() +
+		fmt.Sprintf(` ORDER BY to_id %[1]s, rank %[1]s, sc_order %[1]s`, page.Order) + q.limit(page.Limit)
+
+	keys := make([]store.TimelineCursor, 0)
+	if err := s.db.SelectContext(ctx, &keys, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("getting timeline of %s: %w", accountID, err)
+	}
+
+	var txIDs, opIDs, scToIDs, scOrders []int64
+	for _, key := range keys {
+		switch key.Rank {
+		case store.TimelineRankTransaction:
+			txIDs = append(txIDs, key.ToID)
+		case store.TimelineRankOperation:
+			opIDs = append(opIDs, key.ToID)
+		default:
+			scToIDs = append(scToIDs, key.ToID)
+			scOrders = append(scOrders, key.Order)
+		}
+	}
+
+	txs := make([]types.Transaction, 0, len(txIDs))
+	if len(txIDs) > 0 {
+		err := s.db.SelectContext(ctx, &txs, `SELECT `+transactionColumns+` FROM transactions t WHERE t.to_id = ANY($1)`, pq.Array(txIDs))
+		if err != nil {
+			return nil, fmt.Errorf("getting timeline transactions: %w", err)
+		}
+	}
+	ops := make([]types.Operation, 0, len(opIDs))
+	if len(opIDs) > 0 {
+		err := s.db.SelectContext(ctx, &ops, `SELECT `+operationColumns+` FROM operations o WHERE o.id = ANY($1)`, pq.Array(opIDs))
+		if err != nil {
+			return nil, fmt.Errorf("getting timeline operations: %w", err)
+		}
+	}
+	stateChanges := make([]types.StateChange, 0, len(scToIDs))
+	if len(scToIDs) > 0 {
+		err := s.db.SelectContext(ctx, &stateChanges, `SELECT `+stateChangeSelectColumns+` FROM state_changes sc
+			WHERE (sc.to_id, sc.state_change_order) IN (SELECT * FROM unnest($1::bigint[], $2::bigint[]))`,
+			pq.Array(scToIDs), pq.Array(scOrders))
+		if err != nil {
+			return nil, fmt.Errorf("getting timeline state changes: %w", err)
+		}
+	}
+
+	txsByToID := make(map[int64]*types.Transaction, len(txs))
+	for i := range txs {
+		txsByToID[txs[i].ToID] = &txs[i]
+	}
+	opsByID := make(map[int64]*types.Operation, len(ops))
+	for i := range ops {
+		opsByID[ops[i].ID] = &ops[i]
+	}
+	stateChangesByCursor := make(map[types.StateChangeCursor]*types.StateChange, len(stateChanges))
+	for i := range stateChanges {
+		stateChangesByCursor[stateChanges[i].GetCursor()] = &stateChanges[i]
+	}
+
+	entries := make([]*store.TimelineEntry, 0, len(keys))
+	for _, key := range keys {
+		entry := &store.TimelineEntry{Cursor: key}
+		switch key.Rank {
+		case store.TimelineRankTransaction:
+			entry.Type = store.TimelineTransaction
+			entry.Transaction = txsByToID[key.ToID]
+		case store.TimelineRankOperation:
+			entry.Type = store.TimelineOperation
+			entry.Operation = opsByID[key.ToID]
+		default:
+			entry.Type = store.TimelineStateChange
+			entry.StateChange = stateChangesByCursor[types.StateChangeCursor{ToID: key.ToID, StateChangeOrder: key.Order}]
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// GetStateChangesByOperationIDs returns every state change of the operations, in cursor order.
+func (s *Store) GetStateChangesByOperationIDs(ctx context.Context, operationIDs []int64) ([]types.StateChange, error) {
+	stateChanges := make([]types.StateChange, 0)
+	if len(operationIDs) == 0 {
+		return stateChanges, nil
+	}
+	err := s.db.SelectContext(ctx, &stateChanges, `SELECT `+stateChangeSelectColumns+` FROM state_changes sc
+		WHERE sc.operation_id = ANY($1) ORDER BY sc.to_id, sc.state_change_order`, pq.Array(operationIDs))
+	if err != nil {
+		return nil, fmt.Errorf("getting state changes of operations: %w", err)
+	}
+	return stateChanges, nil
+}
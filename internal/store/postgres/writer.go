@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxInsertParams keeps multi-row inserts under the PostgreSQL limit of 65535 parameters.
+const maxInsertParams = 60000
+
+// WriteLedger stores the content of a ledger buffer and moves the cursor to ledgerSeq, in a
+// single transaction. An empty cursorName leaves every cursor untouched, for the ledgers
+// older than the cursor (backfills). Rows that already exist are left untouched, so
+// re-writing a ledger is harmless.
+func (s *Store) WriteLedger(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32, cursorName string) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	closedAt := time.Time{}
+	if txs := buffer.GetTransactions(); len(txs) > 0 {
+		closedAt = txs[0].LedgerCreatedAt
+	}
+
+	// The balances past the ledger, e.g. during a backfill, don't hold its balance changes.
+	var balancesAhead map[balanceKey]bool
+	writers := []struct {
+		name  string
+		write func(context.Context, *sqlx.Tx, indexer.IndexerBufferInterface) error
+	}{
+		{"accounts", writeAccounts},
+		{"transactions", writeTransactions},
+		{"operations", writeOperations},
+		{"balances", func(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) (err error) {
+			balancesAhead, err = writeBalances(ctx, tx, buffer, ledgerSeq)
+			return err
+		}},
+		{"state changes", func(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+			return writeStateChanges(ctx, tx, buffer, balancesAhead)
+		}},
+		{"trustlines", writeTrustlines},
+		{"contract changes", writeContractChanges},
+		{"escrows", func(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+			return writeEscrows(ctx, tx, buffer.GetEscrows(), ledgerSeq, closedAt)
+		}},
+	}
+	for _, w := range writers {
+		if err := w.write(ctx, tx, buffer); err != nil {
+			return fmt.Errorf("writing %s: %w", w.name, err)
+		}
+	}
+
+	if cursorName != "" {
+		if err := setCursor(ctx, tx, cursorName, ledgerSeq); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing ledger %d: %w", ledgerSeq, err)
+	}
+	return nil
+}
+
+// LatestLedger returns the ledger of the cursor, or 0 if the cursor doesn't exist.
+func (s *Store) LatestLedger(ctx context.Context, cursorName string) (uint32, error) {
+	var ledger uint32
+	err := s.db.GetContext(ctx, &ledger, `SELECT ledger_number FROM ingest_cursors WHERE name = $1`, cursorName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting cursor %q: %w", cursorName, err)
+	}
+	return ledger, nil
+}
+
+func setCursor(ctx context.Context, tx *sqlx.Tx, cursorName string, ledgerSeq uint32) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO ingest_cursors (name, ledger_number) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET ledger_number = EXCLUDED.ledger_number, updated_at = NOW()`,
+		cursorName, ledgerSeq)
+	if err != nil {
+		return fmt.Errorf("updating cursor %q: %w", cursorName, err)
+	}
+	return nil
+}
+
+func writeAccounts(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+	rows := make([][]any, 0)
+	for _, address := range buffer.GetAllParticipants() {
+		if address == "" {
+			continue
+		}
+		rows = append(rows, []any{address})
+	}
+	return insertRows(ctx, tx, "accounts", []string{"stellar_address"}, rows)
+}
+
+func writeTransactions(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+	txRows := make([][]any, 0, buffer.GetNumberOfTransactions())
+	for _, t := range buffer.GetTransactions() {
+		txRows = append(txRows, []any{t.Hash, t.ToID, t.EnvelopeXDR, t.ResultXDR, t.MetaXDR, t.LedgerNumber, t.LedgerCreatedAt})
+	}
+	if err := insertRows(ctx, tx, "transactions",
+		[]string{"hash", "to_id", "envelope_xdr", "result_xdr", "meta_xdr", "ledger_number", "ledger_created_at"}, txRows); err != nil {
+		return err
+	}
+
+	participantRows := make([][]any, 0)
+	for txHash, participants := range buffer.GetTransactionsParticipants() {
+		for _, participant := range participants.ToSlice() {
+			participantRows = append(participantRows, []any{txHash, participant})
+		}
+	}
+	return insertRows(ctx, tx, "transactions_accounts", []string{"tx_hash", "account_id"}, participantRows)
+}
+
+func writeOperations(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface) error {
+	opRows := make([][]any, 0, buffer.GetNumberOfOperations())
+	for _, op := range buffer.GetOperations() {
+		opRows = append(opRows, []any{op.ID, op.TxHash, op.OperationType, op.OperationXDR, op.LedgerNumber, op.LedgerCreatedAt})
+	}
+	if err := insertRows(ctx, tx, "operations",
+		[]string{"id", "tx_hash", "operation_type", "operation_xdr", "ledger_number", "ledger_created_at"}, opRows); err != nil {
+		return err
+	}
+
+	participantRows := make([][]any, 0)
+	for opID, participants := range buffer.GetOperationsParticipants() {
+		for _, participant := range participants.ToSlice() {
+			participantRows = append(participantRows, []any{opID, participant})
+		}
+	}
+	return insertRows(ctx, tx, "operations_accounts", []string{"operation_id", "account_id"}, participantRows)
+}
+
+var stateChangeColumns = []string{
+	"to_id", "state_change_order", "state_change_category", "state_change_reason", "ledger_number",
+	"ledger_created_at", "account_id", "operation_id", "tx_hash", "token_id", "amount", "offer_id",
+	"signer_account_id", "spender_account_id", "sponsored_account_id", "sponsor_account_id",
+	"deployer_account_id", "funder_account_id", "signer_weights", "thresholds", "trustline_limit",
+	"flags", "key_value", "balance_applied",
+}
+
+// writeStateChanges inserts the state changes of the ledger. The balance changes of the
+// balances in balancesAhead are marked as not applied to their balance.
+func writeStateChanges(ctx context.Context, tx *sqlx.Tx, buffer indexer.IndexerBufferInterface, balancesAhead map[balanceKey]bool) error {
+	rows := make([][]any, 0)
+	for _, sc := range buffer.GetStateChanges() {
+		applied := !balancesAhead[balanceKey{account: sc.AccountID, token: sc.TokenID.String}]
+		rows = append(rows, []any{
+			sc.ToID, sc.StateChangeOrder, sc.StateChangeCategory, sc.StateChangeReason, sc.LedgerNumber,
+			sc.LedgerCreatedAt, sc.AccountID, sc.OperationID, sc.TxHash, sc.TokenID, sc.Amount, sc.OfferID,
+			sc.SignerAccountID, sc.SpenderAccountID, sc.SponsoredAccountID, sc.SponsorAccountID,
+			sc.DeployerAccountID, sc.FunderAccountID, sc.SignerWeights, sc.Thresholds, sc.TrustlineLimit,
+			sc.Flags, sc.KeyValue, applied,
+		})
+	}
+	return insertRows(ctx, tx, "state_changes", stateChangeColumns, rows)
+}
+
+var escrowColumns = []string{
+	"contract_id", "escrow_type", "deployer", "factory_contract", "deployer_salt", "wasm_hash",
+	"init_function", "amount", "description", "engagement_id", "title", "platform_fee", "receiver_memo",
+	"flag_approved", "flag_disputed", "flag_released", "flag_resolved",
+	"role_service_provider", "role_receiver", "role_approver", "role_release_signer",
+	"role_dispute_resolver", "role_platform_address", "milestones", "trustline_address",
+	"ledger_number", "ledger_created_at", "updated_ledger_number",
+}
+
+// escrowUpsert replaces the state of an escrow with a newer one. The deployment ledger is
+// kept, and so are the deployment details, which the updates of an escrow don't carry.
+var escrowUpsert = func() string {
+	var set []string
+	for _, column := range escrowColumns {
+		switch column {
+		case "contract_id", "ledger_number", "ledger_created_at":
+		case "deployer", "factory_contract", "deployer_salt", "wasm_hash", "init_function":
+			set = append(set, fmt.Sprintf("%[1]s = COALESCE(NULLIF(EXCLUDED.%[1]s, ''), escrows.%[1]s)", column))
+		default:
+			set = append(set, fmt.Sprintf("%[1]s = EXCLUDED.%[1]s", column))
+		}
+	}
+	return "ON CONFLICT (contract_id) DO UPDATE SET " + strings.Join(set, ", ") +
+		" WHERE escrows.updated_ledger_number <= EXCLUDED.updated_ledger_number"
+}()
+
+// writeEscrows upserts the escrows deployed or changed in the ledger. An escrow changed several
+// times in the ledger is written once, with its last state.
+func writeEscrows(ctx context.Context, tx *sqlx.Tx, escrows []entities.Escrow, ledgerSeq uint32, closedAt time.Time) error {
+	latest := make([]entities.Escrow, 0, len(escrows))
+	indexes := make(map[string]int, len(escrows))
+	for _, e := range escrows {
+		if i, ok := indexes[e.ContractID]; ok {
+			latest[i] = latest[i].Apply(e)
+			continue
+		}
+		indexes[e.ContractID] = len(latest)
+		latest = append(latest, e)
+	}
+
+	rows := make([][]any, 0, len(latest))
+	for _, e := range latest {
+		milestones, err := json.Marshal(e.Milestones)
+		if err != nil {
+			return fmt.Errorf("marshalling milestones of escrow %s: %w", e.ContractID, err)
+		}
+		rows = append(rows, []any{
+			e.ContractID, e.EscrowType, e.Deployer, e.FactoryContract, e.DeployerSalt, e.WasmHash,
+			e.InitFunction, strconv.FormatUint(e.Amount, 10), e.Description, e.EngagementID, e.Title, e.PlatformFee, e.ReceiverMemo,
+			e.Flags.Approved, e.Flags.Disputed, e.Flags.Released, e.Flags.Resolved,
+			e.Roles.ServiceProvider, e.Roles.Receiver, e.Roles.Approver, e.Roles.ReleaseSigner,
+			e.Roles.DisputeResolver, e.Roles.PlatformAddress, string(milestones), e.TrustlineAddress,
+			ledgerSeq, sql.NullTime{Time: closedAt, Valid: !closedAt.IsZero()}, ledgerSeq,
+		})
+	}
+	return writeRows(ctx, tx, "escrows", escrowColumns, rows, escrowUpsert)
+}
+
+// insertRows inserts the rows with multi-row INSERT statements, ignoring the rows that
+// already exist.
+func insertRows(ctx context.Context, tx *sqlx.Tx, table string, columns []string, rows [][]any) error {
+	return writeRows(ctx, tx, table, columns, rows, "ON CONFLICT DO NOTHING")
+}
+
+// writeRows inserts the rows with multi-row INSERT statements, resolving conflicts with the
+// onConflict clause. The rows of a statement must not conflict with each other.
+func writeRows(ctx context.Context, tx *sqlx.Tx, table string, columns []string, rows [][]any, onConflict string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	batchSize := max(1, maxInsertParams/len(columns))
+	for start := 0; start < len(rows); start += batchSize {
+		batch := rows[start:min(start+batchSize, len(rows))]
+
+		var query strings.Builder
+		fmt.Fprintf(&query, "INSERT INTO %s (%s) VALUES ", table, strings.Join(columns, ", "))
+		args := make([]any, 0, len(batch)*len(columns))
+		for i, row := range batch {
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString("(")
+			for j := range row {
+				if j > 0 {
+					query.WriteString(", ")
+				}
+				args = append(args, row[j])
+				fmt.Fprintf(&query, "$%d", len(args))
+			}
+			query.WriteString(")")
+		}
+		query.WriteString(" " + onConflict)
+
+		if _, err := tx.ExecContext(ctx, query.String(), args...); err != nil {
+			return fmt.Errorf("inserting into %s: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+// The readers are the read side of a storage backend. The APIs only depend on them, so they
+// serve the same data whether it lives in Postgres (see store/postgres) or in memory (see
+// store/memory). Single entity lookups return an error wrapping ErrNotFound when there is no
+// result, and pages are normalized by the implementation (see Page.Normalize).
+
+// TransactionReader reads transactions and their operations.
+type TransactionReader interface {
+	GetTransactionByHash(ctx context.Context, hash string) (*types.Transaction, error)
+	// GetTransactions returns a page of transactions, using the transaction TOID as cursor.
+	GetTransactions(ctx context.Context, filter TransactionFilter, page Page[int64]) ([]*types.TransactionWithCursor, error)
+	GetOperationByID(ctx context.Context, id int64) (*types.Operation, error)
+	// GetOperations returns a page of operations, using the operation ID as cursor.
+	GetOperations(ctx context.Context, filter OperationFilter, page Page[int64]) ([]*types.OperationWithCursor, error)
+}
+
+// StateChangeReader reads state changes.
+type StateChangeReader interface {
+	// GetStateChanges returns a page of state changes, using (TOID, order) as cursor.
+	GetStateChanges(ctx context.Context, filter StateChangeFilter, page Page[types.StateChangeCursor]) ([]*types.StateChangeWithCursor, error)
+	// GetStateChangesByOperationIDs returns every state change of the operations, in cursor order.
+	GetStateChangesByOperationIDs(ctx context.Context, operationIDs []int64) ([]types.StateChange, error)
+}
+
+// AccountReader reads the accounts that participated in indexed transactions, and their activity.
+type AccountReader interface {
+	GetAccount(ctx context.Context, address string) (*types.Account, error)
+	// GetAccountsByTxHash returns the participants of a transaction, ordered by address.
+	GetAccountsByTxHash(ctx context.Context, txHash string) ([]*types.Account, error)
+	// GetAccountsByOperationID returns the participants of an operation, ordered by address.
+	GetAccountsByOperationID(ctx context.Context, operationID int64) ([]*types.Account, error)
+	// GetAccountTimeline returns a page of the transactions, operations and state changes of
+	// an account, interleaved in timeline order (see TimelineCursor).
+	GetAccountTimeline(ctx context.Context, accountID string, page Page[TimelineCursor]) ([]*TimelineEntry, error)
+}
+
+// EscrowReader reads escrows.
+type EscrowReader interface {
+	GetEscrow(ctx context.Context, contractID string) (*EscrowWithCursor, error)
+	// GetEscrows returns a page of escrows, in deployment order.
+	GetEscrows(ctx context.Context, filter EscrowFilter, page Page[int64]) ([]*EscrowWithCursor, error)
+	// SearchEscrows returns a page of the escrows matching filter whose title, description,
+	// milestone descriptions and evidence, or receiver memo match a web search style query,
+	// best ranked first. Page.Order is ignored.
+	SearchEscrows(ctx context.Context, query string, filter EscrowFilter, page Page[SearchCursor]) ([]*EscrowSearchResult, error)
+}
+
+// Reader reads every entity served by the APIs.
+type Reader interface {
+	TransactionReader
+	StateChangeReader
+	AccountReader
+	EscrowReader
+}
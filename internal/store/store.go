@@ -0,0 +1,259 @@
+// Package store defines the read side shared by the store implementations and the APIs: the
+// reader interfaces and their query model (pagination, filters and the rows returned with
+// their pagination cursor).
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+)
+
+// ErrNotFound is returned when a single entity lookup has no result.
+var ErrNotFound = errors.New("not found")
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 200
+)
+
+// SortOrder is the order of a page, by cursor.
+type SortOrder string
+
+const (
+	// SortDesc returns the newest entities first. Cursor is an exclusive upper bound.
+	SortDesc SortOrder = "DESC"
+	// SortAsc returns the oldest entities first. Cursor is an exclusive lower bound.
+	SortAsc SortOrder = "ASC"
+)
+
+// Page requests a page of entities after (in sort order) an optional cursor.
+type Page[C any] struct {
+	Limit  int
+	Cursor *C
+	Order  SortOrder
+}
+
+// Normalize applies the default limit and order, and bounds the limit. APIs bound the page
+// size of their clients to MaxPageLimit and may request one extra row to detect a next page.
+func (p Page[C]) Normalize() Page[C] {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageLimit
+	}
+	p.Limit = min(p.Limit, MaxPageLimit+1)
+	if p.Order != SortAsc {
+		p.Order = SortDesc
+	}
+	return p
+}
+
+// TransactionFilter filters transactions. Zero values don't filter.
+type TransactionFilter struct {
+	AccountID string
+}
+
+// OperationFilter filters operations. Zero values don't filter.
+type OperationFilter struct {
+	AccountID string
+	TxHash    string
+}
+
+// StateChangeFilter filters state changes. Zero values don't filter.
+type StateChangeFilter struct {
+	AccountID   string
+	TxHash      string
+	OperationID int64
+	Category    types.StateChangeCategory
+}
+
+// EscrowFilter filters escrows. Zero values don't filter.
+type EscrowFilter struct {
+	EngagementID string
+	EscrowType   entities.EscrowType
+	// Roles matches escrows where every non-empty role is held by the given address. The
+	// receiver also matches the receivers of multi release milestones.
+	Roles entities.EscrowRoles
+	Flags EscrowFlagsFilter
+	// PlatformAddresses matches escrows whose platform address is one of these.
+	PlatformAddresses []string
+	// FromLedger and ToLedger bound, inclusively, the ledger the escrow was deployed in.
+	FromLedger uint32
+	ToLedger   uint32
+}
+
+// EscrowFlagsFilter filters escrows by flag state. A flag is set when it is set at the escrow
+// level (single release) or on any milestone (multi release). Nil values don't filter.
+type EscrowFlagsFilter struct {
+	Approved *bool
+	Disputed *bool
+	Released *bool
+	Resolved *bool
+}
+
+// EscrowWithCursor is an escrow with the ledger it was deployed in, the ledger of its last
+// change and its pagination cursor.
+type EscrowWithCursor struct {
+	entities.Escrow
+	LedgerNumber        uint32
+	LedgerCreatedAt     time.Time
+	UpdatedLedgerNumber uint32
+	Cursor              int64
+}
+
+// EscrowSearchResult is an escrow matching a full-text search, with its rank. Higher ranks
+// match better.
+type EscrowSearchResult struct {
+	EscrowWithCursor
+	Rank float32
+}
+
+// SearchCursor is the pagination cursor of search results, which are ordered by descending
+// rank then descending escrow cursor.
+type SearchCursor struct {
+	Rank     float32
+	EscrowID int64
+}
+
+// SearchCursor returns the pagination cursor of the result.
+func (r *EscrowSearchResult) SearchCursor() SearchCursor {
+	return SearchCursor{Rank: r.Rank, EscrowID: r.Cursor}
+}
+
+// TimelineEntryType is the kind of entity of a timeline entry.
+type TimelineEntryType string
+
+const (
+	TimelineTransaction TimelineEntryType = "transaction"
+	TimelineOperation   TimelineEntryType = "operation"
+	TimelineStateChange TimelineEntryType = "state_change"
+)
+
+// TimelineCursor is the position of an entry in an account timeline. Entries are ordered by
+// TOID, then a transaction comes before its operations and an operation before its state
+// changes (Rank), then state changes by order.
+type TimelineCursor struct {
+	ToID  int64 `db:"to_id"`
+	Rank  int   `db:"rank"`
+	Order int64 `db:"sc_order"`
+}
+
+// Ranks of the entity kinds within a TOID, see TimelineCursor.
+const (
+	TimelineRankTransaction = 0
+	TimelineRankOperation   = 1
+	TimelineRankStateChange = 2
+)
+
+// Less reports whether c comes before other in a timeline.
+func (c TimelineCursor) Less(other TimelineCursor) bool {
+	if c.ToID != other.ToID {
+		return c.ToID < other.ToID
+	}
+	if c.Rank != other.Rank {
+		return c.Rank < other.Rank
+	}
+	return c.Order < other.Order
+}
+
+// TimelineEntry is a transaction, operation or state change of an account timeline. Only the
+// field matching Type is set.
+type TimelineEntry struct {
+	Type        TimelineEntryType
+	Cursor      TimelineCursor
+	Transaction *types.Transaction
+	Operation   *types.Operation
+	StateChange *types.StateChange
+}
+
+// Balance is the balance of a token held by an account, in the smallest unit of the token,
+// as of LedgerNumber. The reconciled fields hold the value last read from the ledger entries
+// of the network, if any.
+type Balance struct {
+	AccountID        string     `db:"account_id"`
+	TokenID          string     `db:"token_id"`
+	Amount           string     `db:"amount"`
+	LedgerNumber     uint32     `db:"ledger_number"`
+	ReconciledAmount *string    `db:"reconciled_amount"`
+	ReconciledLedger *uint32    `db:"reconciled_ledger"`
+	ReconciledAt     *time.Time `db:"reconciled_at"`
+}
+
+// APIKey is an API key of the query server. Only the hash of its secret is stored. Requests
+// made with a key are limited to RateLimit per second, with bursts of Burst requests. A key
+// with PlatformAddresses only reads the escrows of these platforms and their participants.
+type APIKey struct {
+	ID                string
+	Name              string
+	SecretHash        string
+	PlatformAddresses []string
+	RateLimit         float64
+	Burst             int
+	CreatedAt         time.Time
+	RevokedAt         *time.Time
+}
+
+// AuditEntry is a request served by the query server, or an action of the admin API whose
+// KeyID is "admin:<operator>". KeyID is empty for unauthenticated requests.
+type AuditEntry struct {
+	KeyID      string
+	Method     string
+	Path       string
+	Query      string
+	Status     int
+	Duration   time.Duration
+	RemoteAddr string
+	At         time.Time
+}
+
+// LedgerStats are the stats of the ingestion of a ledger. The delay between ClosedAt and
+// IngestedAt is how long the entities of the ledger took to become visible in the sink.
+type LedgerStats struct {
+	Network string
+	Ledger  uint32
+	// Mode is how the ledger was ingested: live, catchup or backfill. Only live ledgers count
+	// in the IngestSLO.
+	Mode         string
+	ClosedAt     time.Time
+	IngestedAt   time.Time
+	Transactions int
+	Operations   int
+	Participants int
+	StateChanges int
+	Escrows      int
+	// FetchDuration, ProcessDuration and SinkDuration are the phases of the ingestion of the
+	// ledger, and SinkDurations the write to each sink of a multi sink, by name.
+	FetchDuration   time.Duration
+	ProcessDuration time.Duration
+	SinkDuration    time.Duration
+	SinkDurations   map[string]time.Duration
+}
+
+// LedgerStatsFilter selects the ledgers of a network closed in [From, To). Zero times don't
+// filter.
+type LedgerStatsFilter struct {
+	Network string
+	From    time.Time
+	To      time.Time
+}
+
+// IngestSLO summarizes the stats of the live ledgers selected by a LedgerStatsFilter against a
+// visibility target: the delay between the close of a ledger and its ingestion. Ledgers
+// ingested while catching up or backfilling are expected to miss it and don't count.
+type IngestSLO struct {
+	Target  time.Duration
+	Ledgers int
+	Escrows int
+	// LedgersWithinTarget and EscrowsWithinTarget are the ledgers, and the escrows of the
+	// ledgers, ingested within Target of their close.
+	LedgersWithinTarget int
+	EscrowsWithinTarget int
+	// DelayP50, DelayP99 and DelayMax are percentiles of the visibility delay of the ledgers.
+	DelayP50 time.Duration
+	DelayP99 time.Duration
+	DelayMax time.Duration
+	// ProcessP99 and SinkP99 are the 99th percentile of the process and sink phases.
+	ProcessP99 time.Duration
+	SinkP99    time.Duration
+}
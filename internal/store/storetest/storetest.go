@@ -0,0 +1,316 @@
+// Package storetest checks that a store.Reader implementation follows the contract of the
+// reader interfaces, so the APIs serve the same data from every storage backend.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/entities"
+	"github.com/Trustless-Work/Indexer/internal/indexer"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/toid"
+)
+
+// WriteLedgerFunc writes a ledger buffer to the store under test.
+type WriteLedgerFunc func(ctx context.Context, buffer indexer.IndexerBufferInterface, ledgerSeq uint32) error
+
+// Accounts, ledgers and escrows of the fixture.
+const (
+	alice = "GALICE"
+	bob   = "GBOB"
+
+	firstLedger  = 100
+	secondLedger = 101
+
+	singleRelease = "CSINGLE"
+	multiRelease  = "CMULTI"
+)
+
+// ledger is a ledger of the fixture: a transaction of alice with one operation, and its
+// state changes.
+type ledger struct {
+	tx           types.Transaction
+	op           types.Operation
+	stateChanges []types.StateChange
+}
+
+func newLedger(seq uint32, accounts ...string) ledger {
+	closedAt := time.Date(2026, 1, 1, 0, 0, int(seq), 0, time.UTC)
+	l := ledger{
+		tx: types.Transaction{
+			Hash:            fmt.Sprintf("hash-%d", seq),
+			ToID:            toid.New(int32(seq), 1, 0).ToInt64(),
+			ResultXDR:       "AAAA",
+			LedgerNumber:    seq,
+			LedgerCreatedAt: closedAt,
+		},
+		op: types.Operation{
+			ID:              toid.New(int32(seq), 1, 1).ToInt64(),
+			OperationType:   types.OperationTypePayment,
+			LedgerNumber:    seq,
+			LedgerCreatedAt: closedAt,
+		},
+	}
+	l.op.TxHash = l.tx.Hash
+	for i, account := range accounts {
+		reason := types.StateChangeReasonCredit
+		l.stateChanges = append(l.stateChanges, types.StateChange{
+			ToID:                l.op.ID,
+			StateChangeOrder:    int64(i + 1),
+			StateChangeCategory: types.StateChangeCategoryBalance,
+			StateChangeReason:   &reason,
+			LedgerNumber:        seq,
+			LedgerCreatedAt:     closedAt,
+			AccountID:           account,
+			OperationID:         l.op.ID,
+			TxHash:              l.tx.Hash,
+		})
+	}
+	return l
+}
+
+func (l ledger) buffer(escrows ...entities.Escrow) *indexer.IndexerBuffer {
+	buffer := indexer.NewIndexerBuffer()
+	buffer.PushTransaction(alice, l.tx)
+	buffer.PushOperation(alice, l.op, l.tx)
+	for _, sc := range l.stateChanges {
+		buffer.PushStateChange(l.tx, l.op, sc)
+	}
+	for _, escrow := range escrows {
+		buffer.PushEscrow(escrow)
+	}
+	return buffer
+}
+
+func fixtureEscrows() (single, multi, dispute entities.Escrow) {
+	single = entities.Escrow{
+		ContractID:      singleRelease,
+		EscrowType:      entities.EscrowTypeSingleRelease,
+		Deployer:        alice,
+		FactoryContract: "CFACTORY",
+		InitFunction:    "tw_new_single_release_escrow",
+		Amount:          1000,
+		Description:     "Logo design for the brand",
+		EngagementID:    "engagement-1",
+		Title:           "Logo",
+		Roles:           entities.EscrowRoles{Receiver: bob, PlatformAddress: "GPLATFORM"},
+		Milestones:      []entities.Milestone{{Description: "First draft", Status: "pending"}},
+	}
+	multi = entities.Escrow{
+		ContractID:      multiRelease,
+		EscrowType:      entities.EscrowTypeMultiRelease,
+		Deployer:        alice,
+		FactoryContract: "CFACTORY",
+		InitFunction:    "tw_new_multi_release_escrow",
+		Description:     "Online store",
+		EngagementID:    "engagement-2",
+		Title:           "Website",
+		Roles:           entities.EscrowRoles{PlatformAddress: "GPLATFORM"},
+		Milestones: []entities.Milestone{{
+			Description: "Checkout page",
+			Status:      "pending",
+			Amount:      500,
+			Flags:       &entities.EscrowFlags{},
+			Receiver:    "GMILESTONE",
+		}},
+	}
+	// The dispute of the single release escrow carries its storage, without the deployment details.
+	dispute = single
+	dispute.Deployer, dispute.FactoryContract, dispute.InitFunction = "", "", ""
+	dispute.Flags.Disputed = true
+	dispute.Function = "dispute_escrow"
+	return single, multi, dispute
+}
+
+// TestReader writes a fixture of two ledgers with write, then checks what reader returns for
+// it. The store must be empty.
+func TestReader(t *testing.T, reader store.Reader, write WriteLedgerFunc) {
+	ctx := context.Background()
+	first := newLedger(firstLedger, alice, bob)
+	second := newLedger(secondLedger, alice)
+	single, multi, dispute := fixtureEscrows()
+	if err := write(ctx, first.buffer(single, multi), firstLedger); err != nil {
+		t.Fatalf("writing ledger %d: %v", firstLedger, err)
+	}
+	if err := write(ctx, second.buffer(dispute), secondLedger); err != nil {
+		t.Fatalf("writing ledger %d: %v", secondLedger, err)
+	}
+	// Re-writing a ledger is harmless, and never brings back an older escrow state.
+	if err := write(ctx, first.buffer(single, multi), firstLedger); err != nil {
+		t.Fatalf("re-writing ledger %d: %v", firstLedger, err)
+	}
+
+	t.Run("transactions", func(t *testing.T) {
+		tx, err := reader.GetTransactionByHash(ctx, first.tx.Hash)
+		if err != nil || tx.ToID != first.tx.ToID {
+			t.Fatalf("GetTransactionByHash = %+v, %v, want %d", tx, err, first.tx.ToID)
+		}
+		if _, err := reader.GetTransactionByHash(ctx, "missing"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetTransactionByHash(missing) = %v, want ErrNotFound", err)
+		}
+
+		page, err := reader.GetTransactions(ctx, store.TransactionFilter{AccountID: alice}, store.Page[int64]{Limit: 1})
+		if err != nil || len(page) != 1 || page[0].ToID != second.tx.ToID {
+			t.Fatalf("first page = %v, %v, want the transaction of ledger %d", page, err, secondLedger)
+		}
+		page, err = reader.GetTransactions(ctx, store.TransactionFilter{AccountID: alice}, store.Page[int64]{Limit: 1, Cursor: &page[0].Cursor})
+		if err != nil || len(page) != 1 || page[0].ToID != first.tx.ToID {
+			t.Fatalf("second page = %v, %v, want the transaction of ledger %d", page, err, firstLedger)
+		}
+		page, err = reader.GetTransactions(ctx, store.TransactionFilter{AccountID: bob}, store.Page[int64]{Order: store.SortAsc})
+		if err != nil || len(page) != 1 || page[0].ToID != first.tx.ToID {
+			t.Errorf("transactions of bob = %v, %v, want the transaction of ledger %d", page, err, firstLedger)
+		}
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		op, err := reader.GetOperationByID(ctx, first.op.ID)
+		if err != nil || op.TxHash != first.tx.Hash {
+			t.Fatalf("GetOperationByID = %+v, %v, want an operation of %s", op, err, first.tx.Hash)
+		}
+		if _, err := reader.GetOperationByID(ctx, 1); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetOperationByID(1) = %v, want ErrNotFound", err)
+		}
+
+		page, err := reader.GetOperations(ctx, store.OperationFilter{TxHash: second.tx.Hash}, store.Page[int64]{})
+		if err != nil || len(page) != 1 || page[0].ID != second.op.ID {
+			t.Errorf("operations of %s = %v, %v, want %d", second.tx.Hash, page, err, second.op.ID)
+		}
+	})
+
+	t.Run("state changes", func(t *testing.T) {
+		page, err := reader.GetStateChanges(ctx, store.StateChangeFilter{}, store.Page[types.StateChangeCursor]{Order: store.SortAsc})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []types.StateChangeCursor{first.stateChanges[0].GetCursor(), first.stateChanges[1].GetCursor(), second.stateChanges[0].GetCursor()}
+		if len(page) != len(want) {
+			t.Fatalf("got %d state changes, want %d", len(page), len(want))
+		}
+		for i, sc := range page {
+			if sc.Cursor != want[i] || sc.GetCursor() != want[i] {
+				t.Errorf("state change %d = %+v, want cursor %+v", i, sc.Cursor, want[i])
+			}
+		}
+
+		page, err = reader.GetStateChanges(ctx, store.StateChangeFilter{AccountID: bob}, store.Page[types.StateChangeCursor]{})
+		if err != nil || len(page) != 1 || page[0].AccountID != bob {
+			t.Errorf("state changes of bob = %v, %v, want one", page, err)
+		}
+
+		byOperation, err := reader.GetStateChangesByOperationIDs(ctx, []int64{first.op.ID})
+		if err != nil || len(byOperation) != 2 || byOperation[0].StateChangeOrder != 1 || byOperation[1].StateChangeOrder != 2 {
+			t.Errorf("state changes of operation %d = %v, %v, want orders 1 and 2", first.op.ID, byOperation, err)
+		}
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		account, err := reader.GetAccount(ctx, bob)
+		if err != nil || account.StellarAddress != bob {
+			t.Fatalf("GetAccount = %+v, %v, want %s", account, err, bob)
+		}
+		if _, err := reader.GetAccount(ctx, "GMISSING"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetAccount(GMISSING) = %v, want ErrNotFound", err)
+		}
+
+		accounts, err := reader.GetAccountsByTxHash(ctx, first.tx.Hash)
+		if err != nil || len(accounts) != 2 || accounts[0].StellarAddress != alice || accounts[1].StellarAddress != bob {
+			t.Errorf("participants of %s = %v, %v, want %s and %s", first.tx.Hash, accounts, err, alice, bob)
+		}
+		accounts, err = reader.GetAccountsByOperationID(ctx, second.op.ID)
+		if err != nil || len(accounts) != 1 || accounts[0].StellarAddress != alice {
+			t.Errorf("participants of operation %d = %v, %v, want %s", second.op.ID, accounts, err, alice)
+		}
+	})
+
+	t.Run("timeline", func(t *testing.T) {
+		entries, err := reader.GetAccountTimeline(ctx, alice, store.Page[store.TimelineCursor]{Order: store.SortAsc})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []store.TimelineEntryType{
+			store.TimelineTransaction, store.TimelineOperation, store.TimelineStateChange,
+			store.TimelineTransaction, store.TimelineOperation, store.TimelineStateChange,
+		}
+		if len(entries) != len(want) {
+			t.Fatalf("got %d timeline entries, want %d", len(entries), len(want))
+		}
+		for i, entry := range entries {
+			if entry.Type != want[i] {
+				t.Errorf("entry %d is a %s, want a %s", i, entry.Type, want[i])
+			}
+		}
+
+		page, err := reader.GetAccountTimeline(ctx, alice, store.Page[store.TimelineCursor]{Limit: 2, Cursor: &entries[3].Cursor})
+		if err != nil || len(page) != 2 || page[0].Cursor != entries[2].Cursor || page[1].Cursor != entries[1].Cursor {
+			t.Errorf("page before entry 3 = %v, %v, want entries 2 and 1", page, err)
+		}
+	})
+
+	t.Run("escrows", func(t *testing.T) {
+		escrow, err := reader.GetEscrow(ctx, singleRelease)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !escrow.Flags.Disputed || escrow.Deployer != alice || escrow.LedgerNumber != firstLedger || escrow.UpdatedLedgerNumber != secondLedger {
+			t.Errorf("GetEscrow = %+v, want the disputed state of ledger %d deployed by %s in ledger %d", escrow, secondLedger, alice, firstLedger)
+		}
+		if _, err := reader.GetEscrow(ctx, "CMISSING"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetEscrow(CMISSING) = %v, want ErrNotFound", err)
+		}
+
+		disputed, notDisputed := true, false
+		filters := []struct {
+			name   string
+			filter store.EscrowFilter
+			want   []string
+		}{
+			{"all", store.EscrowFilter{}, []string{multiRelease, singleRelease}},
+			{"engagement", store.EscrowFilter{EngagementID: "engagement-2"}, []string{multiRelease}},
+			{"type", store.EscrowFilter{EscrowType: entities.EscrowTypeSingleRelease}, []string{singleRelease}},
+			{"receiver", store.EscrowFilter{Roles: entities.EscrowRoles{Receiver: bob}}, []string{singleRelease}},
+			{"milestone receiver", store.EscrowFilter{Roles: entities.EscrowRoles{Receiver: "GMILESTONE"}}, []string{multiRelease}},
+			{"disputed", store.EscrowFilter{Flags: store.EscrowFlagsFilter{Disputed: &disputed}}, []string{singleRelease}},
+			{"not disputed", store.EscrowFilter{Flags: store.EscrowFlagsFilter{Disputed: &notDisputed}}, []string{multiRelease}},
+			{"platform", store.EscrowFilter{PlatformAddresses: []string{"GOTHER", "GPLATFORM"}}, []string{multiRelease, singleRelease}},
+			{"deployment ledger", store.EscrowFilter{FromLedger: secondLedger}, nil},
+		}
+		for _, f := range filters {
+			page, err := reader.GetEscrows(ctx, f.filter, store.Page[int64]{})
+			if err != nil {
+				t.Fatalf("%s: %v", f.name, err)
+			}
+			if got := escrowIDs(page); !slices.Equal(got, f.want) {
+				t.Errorf("%s: got escrows %v, want %v", f.name, got, f.want)
+			}
+		}
+	})
+
+	t.Run("search", func(t *testing.T) {
+		results, err := reader.SearchEscrows(ctx, "checkout", store.EscrowFilter{}, store.Page[store.SearchCursor]{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 || results[0].ContractID != multiRelease || results[0].Rank <= 0 {
+			t.Errorf("search of a milestone description = %v, want %s", results, multiRelease)
+		}
+		results, err = reader.SearchEscrows(ctx, "logo -website", store.EscrowFilter{}, store.Page[store.SearchCursor]{})
+		if err != nil || len(results) != 1 || results[0].ContractID != singleRelease {
+			t.Errorf("search of a title = %v, %v, want %s", results, err, singleRelease)
+		}
+	})
+}
+
+func escrowIDs(escrows []*store.EscrowWithCursor) []string {
+	ids := make([]string, 0, len(escrows))
+	for _, escrow := range escrows {
+		ids = append(ids, escrow.ContractID)
+	}
+	return ids
+}
@@ -0,0 +1,253 @@
+// Package timeline builds the human-readable summaries of the entries of an account timeline,
+// e.g. "received 100.0000000 CDLZ…CYSC from GA7Q…XH4R".
+package timeline
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/Trustless-Work/Indexer/internal/store"
+	"github.com/stellar/go-stellar-sdk/amount"
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// amountDecimals is the number of decimals of Stellar assets and of the Soroban tokens
+// wrapping them.
+const amountDecimals = 7
+
+// Summarize describes a timeline entry from the point of view of account. related holds the
+// state changes of the entry's operation, used to find the counterparty of balance changes;
+// it can be nil.
+func Summarize(account string, entry *store.TimelineEntry, related []types.StateChange) string {
+	switch {
+	case entry.Transaction != nil:
+		return fmt.Sprintf("transaction %s in ledger %d", shortHash(entry.Transaction.Hash), entry.Transaction.LedgerNumber)
+	case entry.Operation != nil:
+		return summarizeOperation(entry.Operation)
+	case entry.StateChange != nil:
+		return summarizeStateChange(account, entry.StateChange, related)
+	default:
+		return string(entry.Type)
+	}
+}
+
+func summarizeOperation(op *types.Operation) string {
+	var decoded xdr.Operation
+	if err := xdr.SafeUnmarshalBase64(op.OperationXDR, &decoded); err != nil {
+		return humanize(string(op.OperationType))
+	}
+
+	switch body := decoded.Body; body.Type {
+	case xdr.OperationTypeCreateAccount:
+		createAccount := body.MustCreateAccountOp()
+		return fmt.Sprintf("created account %s with %s XLM", shortAddress(createAccount.Destination.Address()), amount.String(createAccount.StartingBalance))
+	case xdr.OperationTypePayment:
+		payment := body.MustPaymentOp()
+		return fmt.Sprintf("payment of %s %s to %s", amount.String(payment.Amount), assetName(payment.Asset), shortAddress(payment.Destination.Address()))
+	case xdr.OperationTypeChangeTrust:
+		changeTrust := body.MustChangeTrustOp()
+		if changeTrust.Limit == 0 {
+			return fmt.Sprintf("removed trustline to %s", changeTrustAssetName(changeTrust.Line))
+		}
+		return fmt.Sprintf("changed trustline to %s", changeTrustAssetName(changeTrust.Line))
+	case xdr.OperationTypeInvokeHostFunction:
+		return summarizeHostFunction(body.MustInvokeHostFunctionOp().HostFunction)
+	default:
+		return humanize(string(op.OperationType))
+	}
+}
+
+func summarizeHostFunction(fn xdr.HostFunction) string {
+	switch fn.Type {
+	case xdr.HostFunctionTypeHostFunctionTypeInvokeContract:
+		invoke := fn.MustInvokeContract()
+		return fmt.Sprintf("invoked %s on %s", invoke.FunctionName, shortAddress(scAddress(invoke.ContractAddress)))
+	case xdr.HostFunctionTypeHostFunctionTypeCreateContract, xdr.HostFunctionTypeHostFunctionTypeCreateContractV2:
+		return "deployed a contract"
+	case xdr.HostFunctionTypeHostFunctionTypeUploadContractWasm:
+		return "uploaded contract code"
+	default:
+		return "invoked a host function"
+	}
+}
+
+func summarizeStateChange(account string, sc *types.StateChange, related []types.StateChange) string {
+	reason := ""
+	if sc.StateChangeReason != nil {
+		reason = string(*sc.StateChangeReason)
+	}
+
+	switch sc.StateChangeCategory {
+	case types.StateChangeCategoryBalance:
+		value := fmt.Sprintf("%s %s", FormatAmount(sc.Amount.String), shortAddress(sc.TokenID.String))
+		counterparty := Counterparty(account, sc, related)
+		switch types.StateChangeReason(reason) {
+		case types.StateChangeReasonCredit:
+			if counterparty != "" {
+				return fmt.Sprintf("received %s from %s", value, shortAddress(counterparty))
+			}
+			return "received " + value
+		case types.StateChangeReasonDebit:
+			if counterparty != "" {
+				return fmt.Sprintf("sent %s to %s", value, shortAddress(counterparty))
+			}
+			return "sent " + value
+		case types.StateChangeReasonMint:
+			return "minted " + value
+		case types.StateChangeReasonBurn:
+			return "burned " + value
+		}
+	case types.StateChangeCategoryAccount:
+		switch types.StateChangeReason(reason) {
+		case types.StateChangeReasonCreate:
+			if sc.FunderAccountID.Valid {
+				return "account created by " + shortAddress(sc.FunderAccountID.String)
+			}
+			return "account created"
+		case types.StateChangeReasonMerge:
+			return "account merged"
+		}
+	case types.StateChangeCategorySigner:
+		if sc.SignerAccountID.Valid {
+			return fmt.Sprintf("signer %s %s", shortAddress(sc.SignerAccountID.String), pastTense(reason))
+		}
+	case types.StateChangeCategorySignatureThreshold:
+		return "signature thresholds updated"
+	case types.StateChangeCategoryMetadata:
+		if types.StateChangeReason(reason) == types.StateChangeReasonHomeDomain {
+			return "home domain updated"
+		}
+		return "data entry updated"
+	case types.StateChangeCategoryFlags:
+		if len(sc.Flags) > 0 {
+			return fmt.Sprintf("flags %s %s", strings.Join(sc.Flags, ", "), pastTense(reason))
+		}
+	case types.StateChangeCategoryTrustline:
+		if sc.TokenID.Valid {
+			return fmt.Sprintf("trustline to %s %s", shortAddress(sc.TokenID.String), pastTense(reason))
+		}
+	case types.StateChangeCategoryReserves:
+		switch types.StateChangeReason(reason) {
+		case types.StateChangeReasonSponsor:
+			if sc.SponsorAccountID.Valid {
+				return "reserves sponsored by " + shortAddress(sc.SponsorAccountID.String)
+			}
+		case types.StateChangeReasonUnsponsor:
+			return "reserves sponsorship revoked"
+		}
+	case types.StateChangeCategoryEscrow:
+		if sc.TokenID.Valid {
+			return fmt.Sprintf("escrow %s %s", shortAddress(sc.TokenID.String), humanize(reason))
+		}
+	}
+
+	if reason == "" {
+		return humanize(string(sc.StateChangeCategory)) + " changed"
+	}
+	return fmt.Sprintf("%s %s", humanize(string(sc.StateChangeCategory)), humanize(reason))
+}
+
+// Counterparty returns the account on the other side of a balance change: the account whose
+// balance of the same token moved by the same amount, the other way, in the same operation.
+func Counterparty(account string, sc *types.StateChange, related []types.StateChange) string {
+	if sc.StateChangeReason == nil {
+		return ""
+	}
+	var opposite types.StateChangeReason
+	switch *sc.StateChangeReason {
+	case types.StateChangeReasonCredit:
+		opposite = types.StateChangeReasonDebit
+	case types.StateChangeReasonDebit:
+		opposite = types.StateChangeReasonCredit
+	default:
+		return ""
+	}
+
+	for _, other := range related {
+		if other.StateChangeCategory != types.StateChangeCategoryBalance || other.StateChangeReason == nil ||
+			*other.StateChangeReason != opposite || other.OperationID != sc.OperationID ||
+			other.TokenID != sc.TokenID || other.Amount != sc.Amount || other.AccountID == account {
+			continue
+		}
+		return other.AccountID
+	}
+	return ""
+}
+
+// FormatAmount formats an amount of the smallest unit of a token with amountDecimals
+// decimals. Soroban amounts are i128, hence the big.Int.
+func FormatAmount(value string) string {
+	units, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return value
+	}
+	return new(big.Rat).SetFrac(units, big.NewInt(10_000_000)).FloatString(amountDecimals)
+}
+
+func assetName(asset xdr.Asset) string {
+	if asset.IsNative() {
+		return "XLM"
+	}
+	var assetType, code string
+	if err := asset.Extract(&assetType, &code, nil); err != nil {
+		return asset.StringCanonical()
+	}
+	return code
+}
+
+func changeTrustAssetName(asset xdr.ChangeTrustAsset) string {
+	if asset.Type == xdr.AssetTypeAssetTypePoolShare {
+		return "a liquidity pool"
+	}
+	return assetName(asset.ToAsset())
+}
+
+func scAddress(addr xdr.ScAddress) string {
+	address, err := addr.String()
+	if err != nil {
+		return ""
+	}
+	return address
+}
+
+// shortAddress abbreviates Stellar addresses to their first and last 4 characters.
+func shortAddress(address string) string {
+	if !strkey.IsValidEd25519PublicKey(address) && !strkey.IsValidContractAddress(address) &&
+		!strkey.IsValidMuxedAccountEd25519PublicKey(address) {
+		return address
+	}
+	return address[:4] + "…" + address[len(address)-4:]
+}
+
+func shortHash(hash string) string {
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) < 8 {
+		return hash
+	}
+	return hash[:8]
+}
+
+func pastTense(reason string) string {
+	switch types.StateChangeReason(reason) {
+	case types.StateChangeReasonAdd:
+		return "added"
+	case types.StateChangeReasonRemove:
+		return "removed"
+	case types.StateChangeReasonUpdate:
+		return "updated"
+	case types.StateChangeReasonSet:
+		return "set"
+	case types.StateChangeReasonClear:
+		return "cleared"
+	default:
+		return humanize(reason)
+	}
+}
+
+// humanize turns enum values such as PATH_PAYMENT_STRICT_SEND into "path payment strict send".
+func humanize(value string) string {
+	return strings.ToLower(strings.ReplaceAll(value, "_", " "))
+}
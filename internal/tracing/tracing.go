@@ -0,0 +1,129 @@
+// Package tracing sets up the OpenTelemetry tracing of the indexer.
+//
+// Spans are recorded through the global tracer provider, so the ingestion pipeline, the
+// processors and the sinks start spans without threading a tracer through their constructors.
+// Until Setup is called the global provider is a no-op and starting a span costs next to nothing.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/Trustless-Work/Indexer"
+	defaultServiceName  = "trustless-work-indexer"
+)
+
+// Span attributes shared by the instrumented packages.
+const (
+	AttrNetwork          = attribute.Key("stellar.network")
+	AttrLedgerSequence   = attribute.Key("stellar.ledger.sequence")
+	AttrTransactionCount = attribute.Key("stellar.ledger.transaction_count")
+	AttrTxHash           = attribute.Key("stellar.tx.hash")
+	AttrOperationID      = attribute.Key("stellar.operation.id")
+	AttrProcessor        = attribute.Key("indexer.processor")
+	AttrSink             = attribute.Key("indexer.sink")
+	AttrSinkType         = attribute.Key("indexer.sink.type")
+	AttrSinkAttempts     = attribute.Key("indexer.sink.attempts")
+)
+
+// Config selects where spans are exported. With neither OTLPEndpoint nor File set, tracing
+// stays disabled.
+type Config struct {
+	// ServiceName is the service.name of the spans. Defaults to "trustless-work-indexer".
+	ServiceName string
+	// OTLPEndpoint is the URL of an OTLP/HTTP collector, e.g. "http://localhost:4318".
+	OTLPEndpoint string
+	// File is a path spans are appended to as JSON, one span per line. Meant for local tests.
+	File string
+}
+
+// Enabled reports whether the config exports spans anywhere.
+func (c Config) Enabled() bool {
+	return c.OTLPEndpoint != "" || c.File != ""
+}
+
+// Setup installs the global tracer provider and the W3C trace context propagator. The
+// returned function flushes the pending spans and releases the exporters.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("creating tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	var closers []func() error
+	if cfg.OTLPEndpoint != "" {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	if cfg.File != "" {
+		file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("opening trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("creating file exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		closers = append(closers, file.Close)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func(ctx context.Context) error {
+		errs := []error{provider.Shutdown(ctx)}
+		for _, closeFn := range closers {
+			errs = append(errs, closeFn())
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// Start starts a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends the span, marking it as failed when err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject writes the trace context of ctx into carrier, e.g. the headers of an outgoing message.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
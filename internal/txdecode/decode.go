@@ -0,0 +1,437 @@
+// Package txdecode decodes the envelope, result and meta XDR of a stored transaction into
+// structured JSON: operation details, Soroban invocations and their authorizations, emitted
+// events and resource usage.
+package txdecode
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Trustless-Work/Indexer/internal/indexer/processors"
+	"github.com/Trustless-Work/Indexer/internal/indexer/types"
+	"github.com/stellar/go-stellar-sdk/ingest"
+	"github.com/stellar/go-stellar-sdk/toid"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+var ErrNoEnvelope = errors.New("transaction envelope was not stored")
+
+// Transaction is a decoded transaction. Events, Soroban and the operation details that
+// depend on the ledger changes are only set when the meta XDR was stored.
+type Transaction struct {
+	Hash            string         `json:"hash"`
+	LedgerNumber    uint32         `json:"ledgerNumber"`
+	LedgerCreatedAt time.Time      `json:"ledgerCreatedAt"`
+	Successful      bool           `json:"successful"`
+	ResultCode      string         `json:"resultCode"`
+	SourceAccount   string         `json:"sourceAccount"`
+	FeeAccount      string         `json:"feeAccount"`
+	SequenceNumber  string         `json:"sequenceNumber"`
+	MaxFee          int64          `json:"maxFee"`
+	FeeCharged      int64          `json:"feeCharged"`
+	Memo            *Memo          `json:"memo,omitempty"`
+	FeeBump         *FeeBump       `json:"feeBump,omitempty"`
+	Operations      []Operation    `json:"operations"`
+	Events          []Event        `json:"events,omitempty"`
+	Soroban         *Soroban       `json:"soroban,omitempty"`
+	Preconditions   map[string]any `json:"preconditions,omitempty"`
+}
+
+// Memo is the memo of a transaction. Hash and return memos are hex encoded.
+type Memo struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// FeeBump describes the inner transaction of a fee bump transaction.
+type FeeBump struct {
+	InnerHash          string `json:"innerHash"`
+	InnerSourceAccount string `json:"innerSourceAccount"`
+	InnerMaxFee        int64  `json:"innerMaxFee"`
+}
+
+// Operation is a decoded operation. Details are the ones stored by the indexer processors
+// (TransactionOperationWrapper.Details).
+type Operation struct {
+	Index         uint32         `json:"index"`
+	ID            int64          `json:"id"`
+	Type          string         `json:"type"`
+	SourceAccount string         `json:"sourceAccount"`
+	ResultCode    string         `json:"resultCode,omitempty"`
+	Details       map[string]any `json:"details,omitempty"`
+	DetailsError  string         `json:"detailsError,omitempty"`
+	Invocation    *Invocation    `json:"invocation,omitempty"`
+	Auth          []AuthEntry    `json:"auth,omitempty"`
+	Events        []Event        `json:"events,omitempty"`
+}
+
+// Invocation is a node of a Soroban invocation tree: a contract call or a contract creation.
+type Invocation struct {
+	Type            string        `json:"type"`
+	ContractID      string        `json:"contractId,omitempty"`
+	Function        string        `json:"function,omitempty"`
+	Args            []any         `json:"args,omitempty"`
+	WasmHash        string        `json:"wasmHash,omitempty"`
+	Deployer        string        `json:"deployer,omitempty"`
+	Salt            string        `json:"salt,omitempty"`
+	Asset           string        `json:"asset,omitempty"`
+	SubInvocations  []*Invocation `json:"subInvocations,omitempty"`
+	UploadedWasmLen int           `json:"uploadedWasmLength,omitempty"`
+}
+
+// AuthEntry is a Soroban authorization: who authorized which invocation tree.
+// SourceAccount entries are authorized by the signature of the operation source.
+type AuthEntry struct {
+	Credentials               string      `json:"credentials"`
+	Address                   string      `json:"address,omitempty"`
+	Nonce                     string      `json:"nonce,omitempty"`
+	SignatureExpirationLedger uint32      `json:"signatureExpirationLedger,omitempty"`
+	Signature                 any         `json:"signature,omitempty"`
+	RootInvocation            *Invocation `json:"rootInvocation"`
+}
+
+// Event is a contract event. Stage is set for transaction level events (fees) and
+// InSuccessfulContractCall for diagnostic events.
+type Event struct {
+	Type                     string `json:"type"`
+	ContractID               string `json:"contractId,omitempty"`
+	Topics                   []any  `json:"topics"`
+	Data                     any    `json:"data"`
+	Stage                    string `json:"stage,omitempty"`
+	InSuccessfulContractCall *bool  `json:"inSuccessfulContractCall,omitempty"`
+}
+
+// Soroban holds the resources declared and charged for a Soroban transaction.
+type Soroban struct {
+	Instructions                   uint32   `json:"instructions"`
+	DiskReadBytes                  uint32   `json:"diskReadBytes"`
+	WriteBytes                     uint32   `json:"writeBytes"`
+	ReadOnlyFootprint              []string `json:"readOnlyFootprint"`
+	ReadWriteFootprint             []string `json:"readWriteFootprint"`
+	ResourceFee                    int64    `json:"resourceFee"`
+	NonRefundableResourceFeeCharge *int64   `json:"nonRefundableResourceFeeCharged,omitempty"`
+	RefundableResourceFeeCharged   *int64   `json:"refundableResourceFeeCharged,omitempty"`
+	RentFeeCharged                 *int64   `json:"rentFeeCharged,omitempty"`
+	ReturnValue                    any      `json:"returnValue,omitempty"`
+	DiagnosticEvents               []Event  `json:"diagnosticEvents,omitempty"`
+}
+
+// Decode decodes a stored transaction of the network identified by networkPassphrase.
+func Decode(tx *types.Transaction, networkPassphrase string) (*Transaction, error) {
+	if tx.EnvelopeXDR == nil {
+		return nil, ErrNoEnvelope
+	}
+	ledgerTx, err := ledgerTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	hasMeta := tx.MetaXDR != nil
+
+	sourceAccount, feeAccount := ledgerTx.Envelope.SourceAccount(), ledgerTx.FeeAccount()
+	decoded := &Transaction{
+		Hash:            tx.Hash,
+		LedgerNumber:    tx.LedgerNumber,
+		LedgerCreatedAt: tx.LedgerCreatedAt,
+		Successful:      ledgerTx.Result.Successful(),
+		ResultCode:      ledgerTx.Result.Result.Result.Code.String(),
+		SourceAccount:   sourceAccount.Address(),
+		FeeAccount:      feeAccount.Address(),
+		SequenceNumber:  fmt.Sprint(ledgerTx.Envelope.SeqNum()),
+		MaxFee:          int64(ledgerTx.Envelope.Fee()),
+		FeeCharged:      int64(ledgerTx.Result.Result.FeeCharged),
+		Memo:            memo(ledgerTx.Envelope.Memo()),
+		Preconditions:   preconditions(ledgerTx.Envelope),
+	}
+	if ledgerTx.Envelope.IsFeeBump() {
+		innerHash := ledgerTx.Result.Result.Result.MustInnerResultPair().TransactionHash
+		decoded.FeeBump = &FeeBump{
+			InnerHash:          hex.EncodeToString(innerHash[:]),
+			InnerSourceAccount: ledgerTx.Envelope.FeeBump.Tx.InnerTx.V1.Tx.SourceAccount.Address(),
+			InnerMaxFee:        int64(ledgerTx.Envelope.FeeBump.Tx.InnerTx.V1.Tx.Fee),
+		}
+	}
+
+	var txEvents ingest.TransactionEvents
+	if hasMeta {
+		if txEvents, err = ledgerTx.GetTransactionEvents(); err != nil {
+			return nil, fmt.Errorf("getting events of transaction %s: %w", tx.Hash, err)
+		}
+		for _, event := range txEvents.TransactionEvents {
+			e := newEvent(event.Event)
+			e.Stage = event.Stage.String()
+			decoded.Events = append(decoded.Events, e)
+		}
+	}
+
+	results, _ := ledgerTx.Result.OperationResults()
+	decoded.Operations = make([]Operation, 0, len(ledgerTx.Envelope.Operations()))
+	for i, op := range ledgerTx.Envelope.Operations() {
+		wrapper := &processors.TransactionOperationWrapper{
+			Index:          uint32(i),
+			Transaction:    ledgerTx,
+			Operation:      op,
+			LedgerSequence: tx.LedgerNumber,
+			Network:        networkPassphrase,
+			LedgerClosed:   tx.LedgerCreatedAt,
+		}
+		operation := Operation{
+			Index:         uint32(i),
+			ID:            wrapper.ID(),
+			Type:          op.Body.Type.String(),
+			SourceAccount: wrapper.SourceAccount().Address(),
+		}
+		if i < len(results) {
+			operation.ResultCode = results[i].Code.String()
+		}
+		if hasMeta {
+			if operation.Details, err = wrapper.Details(); err != nil {
+				operation.DetailsError = err.Error()
+			}
+			if i < len(txEvents.OperationEvents) {
+				for _, event := range txEvents.OperationEvents[i] {
+					operation.Events = append(operation.Events, newEvent(event))
+				}
+			}
+		} else {
+			operation.DetailsError = "transaction meta was not stored"
+		}
+		if invokeOp, ok := op.Body.GetInvokeHostFunctionOp(); ok {
+			operation.Invocation = hostFunctionInvocation(invokeOp.HostFunction)
+			for _, entry := range invokeOp.Auth {
+				operation.Auth = append(operation.Auth, newAuthEntry(entry))
+			}
+		}
+		decoded.Operations = append(decoded.Operations, operation)
+	}
+
+	if sorobanData, ok := ledgerTx.GetSorobanData(); ok {
+		decoded.Soroban = newSoroban(sorobanData, ledgerTx.UnsafeMeta, txEvents.DiagnosticEvents)
+	}
+	return decoded, nil
+}
+
+// ledgerTransaction rebuilds the ingest transaction from the stored XDR, as read by the
+// processors. The ledger close meta isn't stored, so Ledger is left empty.
+func ledgerTransaction(tx *types.Transaction) (ingest.LedgerTransaction, error) {
+	ledgerTx := ingest.LedgerTransaction{Index: uint32(toid.Parse(tx.ToID).TransactionOrder)}
+	if err := xdr.SafeUnmarshalBase64(*tx.EnvelopeXDR, &ledgerTx.Envelope); err != nil {
+		return ledgerTx, fmt.Errorf("decoding envelope of transaction %s: %w", tx.Hash, err)
+	}
+	if err := xdr.SafeUnmarshalBase64(tx.ResultXDR, &ledgerTx.Result); err != nil {
+		return ledgerTx, fmt.Errorf("decoding result of transaction %s: %w", tx.Hash, err)
+	}
+	if tx.MetaXDR != nil {
+		if err := xdr.SafeUnmarshalBase64(*tx.MetaXDR, &ledgerTx.UnsafeMeta); err != nil {
+			return ledgerTx, fmt.Errorf("decoding meta of transaction %s: %w", tx.Hash, err)
+		}
+	}
+	hash, err := hex.DecodeString(tx.Hash)
+	if err != nil || len(hash) != len(ledgerTx.Hash) {
+		return ledgerTx, fmt.Errorf("decoding hash of transaction %s", tx.Hash)
+	}
+	copy(ledgerTx.Hash[:], hash)
+	return ledgerTx, nil
+}
+
+func memo(m xdr.Memo) *Memo {
+	switch m.Type {
+	case xdr.MemoTypeMemoText:
+		return &Memo{Type: "text", Value: m.MustText()}
+	case xdr.MemoTypeMemoId:
+		return &Memo{Type: "id", Value: fmt.Sprint(m.MustId())}
+	case xdr.MemoTypeMemoHash:
+		hash := m.MustHash()
+		return &Memo{Type: "hash", Value: hex.EncodeToString(hash[:])}
+	case xdr.MemoTypeMemoReturn:
+		hash := m.MustRetHash()
+		return &Memo{Type: "return", Value: hex.EncodeToString(hash[:])}
+	default:
+		return nil
+	}
+}
+
+func preconditions(envelope xdr.TransactionEnvelope) map[string]any {
+	result := map[string]any{}
+	if tb := envelope.TimeBounds(); tb != nil {
+		result["timeBounds"] = map[string]uint64{"minTime": uint64(tb.MinTime), "maxTime": uint64(tb.MaxTime)}
+	}
+	if lb := envelope.LedgerBounds(); lb != nil {
+		result["ledgerBounds"] = map[string]uint32{"minLedger": uint32(lb.MinLedger), "maxLedger": uint32(lb.MaxLedger)}
+	}
+	if minSeqNum := envelope.MinSeqNum(); minSeqNum != nil {
+		result["minSeqNum"] = fmt.Sprint(*minSeqNum)
+	}
+	if minSeqAge := envelope.MinSeqAge(); minSeqAge != nil && *minSeqAge > 0 {
+		result["minSeqAge"] = uint64(*minSeqAge)
+	}
+	if gap := envelope.MinSeqLedgerGap(); gap != nil && *gap > 0 {
+		result["minSeqLedgerGap"] = uint32(*gap)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func hostFunctionInvocation(fn xdr.HostFunction) *Invocation {
+	switch fn.Type {
+	case xdr.HostFunctionTypeHostFunctionTypeInvokeContract:
+		return invokeContractInvocation(fn.MustInvokeContract())
+	case xdr.HostFunctionTypeHostFunctionTypeCreateContract:
+		args := fn.MustCreateContract()
+		return createContractInvocation(args.ContractIdPreimage, args.Executable, nil)
+	case xdr.HostFunctionTypeHostFunctionTypeCreateContractV2:
+		args := fn.MustCreateContractV2()
+		return createContractInvocation(args.ContractIdPreimage, args.Executable, args.ConstructorArgs)
+	case xdr.HostFunctionTypeHostFunctionTypeUploadContractWasm:
+		return &Invocation{Type: "upload_wasm", UploadedWasmLen: len(fn.MustWasm())}
+	default:
+		return &Invocation{Type: fn.Type.String()}
+	}
+}
+
+func invokeContractInvocation(args xdr.InvokeContractArgs) *Invocation {
+	contractID, _ := args.ContractAddress.String()
+	return &Invocation{
+		Type:       "invoke_contract",
+		ContractID: contractID,
+		Function:   string(args.FunctionName),
+		Args:       scValsJSON(args.Args),
+	}
+}
+
+func createContractInvocation(preimage xdr.ContractIdPreimage, executable xdr.ContractExecutable, constructorArgs []xdr.ScVal) *Invocation {
+	invocation := &Invocation{Type: "create_contract"}
+	if len(constructorArgs) > 0 {
+		invocation.Args = scValsJSON(constructorArgs)
+	}
+	if hash, ok := executable.GetWasmHash(); ok {
+		invocation.WasmHash = hex.EncodeToString(hash[:])
+	}
+	if fromAddress, ok := preimage.GetFromAddress(); ok {
+		invocation.Deployer, _ = fromAddress.Address.String()
+		invocation.Salt = hex.EncodeToString(fromAddress.Salt[:])
+	}
+	if asset, ok := preimage.GetFromAsset(); ok {
+		invocation.Asset = asset.StringCanonical()
+	}
+	return invocation
+}
+
+func authorizedInvocation(invocation xdr.SorobanAuthorizedInvocation) *Invocation {
+	var node *Invocation
+	switch fn := invocation.Function; fn.Type {
+	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn:
+		node = invokeContractInvocation(fn.MustContractFn())
+	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn:
+		args := fn.MustCreateContractHostFn()
+		node = createContractInvocation(args.ContractIdPreimage, args.Executable, nil)
+	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractV2HostFn:
+		args := fn.MustCreateContractV2HostFn()
+		node = createContractInvocation(args.ContractIdPreimage, args.Executable, args.ConstructorArgs)
+	default:
+		node = &Invocation{Type: fn.Type.String()}
+	}
+	for _, sub := range invocation.SubInvocations {
+		node.SubInvocations = append(node.SubInvocations, authorizedInvocation(sub))
+	}
+	return node
+}
+
+func newAuthEntry(entry xdr.SorobanAuthorizationEntry) AuthEntry {
+	auth := AuthEntry{
+		Credentials:    "source_account",
+		RootInvocation: authorizedInvocation(entry.RootInvocation),
+	}
+	if credentials, ok := entry.Credentials.GetAddress(); ok {
+		auth.Credentials = "address"
+		auth.Address, _ = credentials.Address.String()
+		auth.Nonce = fmt.Sprint(credentials.Nonce)
+		auth.SignatureExpirationLedger = uint32(credentials.SignatureExpirationLedger)
+		auth.Signature = ScValJSON(credentials.Signature)
+	}
+	return auth
+}
+
+func newEvent(event xdr.ContractEvent) Event {
+	e := Event{Type: event.Type.String(), Topics: []any{}}
+	if event.ContractId != nil {
+		e.ContractID, _ = xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: event.ContractId}.String()
+	}
+	if body, ok := event.Body.GetV0(); ok {
+		e.Topics = scValsJSON(body.Topics)
+		e.Data = ScValJSON(body.Data)
+	}
+	return e
+}
+
+func newSoroban(data xdr.SorobanTransactionData, meta xdr.TransactionMeta, diagnosticEvents []xdr.DiagnosticEvent) *Soroban {
+	resources := data.Resources
+	soroban := &Soroban{
+		Instructions:       uint32(resources.Instructions),
+		DiskReadBytes:      uint32(resources.DiskReadBytes),
+		WriteBytes:         uint32(resources.WriteBytes),
+		ReadOnlyFootprint:  ledgerKeys(resources.Footprint.ReadOnly),
+		ReadWriteFootprint: ledgerKeys(resources.Footprint.ReadWrite),
+		ResourceFee:        int64(data.ResourceFee),
+	}
+
+	var ext *xdr.SorobanTransactionMetaExt
+	if v3, ok := meta.GetV3(); ok && v3.SorobanMeta != nil {
+		ext = &v3.SorobanMeta.Ext
+		soroban.ReturnValue = ScValJSON(v3.SorobanMeta.ReturnValue)
+	}
+	if v4, ok := meta.GetV4(); ok && v4.SorobanMeta != nil {
+		ext = &v4.SorobanMeta.Ext
+		if v4.SorobanMeta.ReturnValue != nil {
+			soroban.ReturnValue = ScValJSON(*v4.SorobanMeta.ReturnValue)
+		}
+	}
+	if ext != nil && ext.V1 != nil {
+		nonRefundable := int64(ext.V1.TotalNonRefundableResourceFeeCharged)
+		refundable := int64(ext.V1.TotalRefundableResourceFeeCharged)
+		rent := int64(ext.V1.RentFeeCharged)
+		soroban.NonRefundableResourceFeeCharge = &nonRefundable
+		soroban.RefundableResourceFeeCharged = &refundable
+		soroban.RentFeeCharged = &rent
+	}
+
+	for _, event := range diagnosticEvents {
+		e := newEvent(event.Event)
+		inSuccessfulCall := event.InSuccessfulContractCall
+		e.InSuccessfulContractCall = &inSuccessfulCall
+		soroban.DiagnosticEvents = append(soroban.DiagnosticEvents, e)
+	}
+	return soroban
+}
+
+// ledgerKeys describes footprint entries as TYPE:details, e.g. CONTRACT_DATA:C...
+func ledgerKeys(keys []xdr.LedgerKey) []string {
+	described := make([]string, 0, len(keys))
+	for _, key := range keys {
+		described = append(described, ledgerKeyString(key))
+	}
+	return described
+}
+
+func ledgerKeyString(key xdr.LedgerKey) string {
+	switch key.Type {
+	case xdr.LedgerEntryTypeAccount:
+		return "ACCOUNT:" + key.MustAccount().AccountId.Address()
+	case xdr.LedgerEntryTypeTrustline:
+		trustline := key.MustTrustLine()
+		return fmt.Sprintf("TRUSTLINE:%s:%s", trustline.AccountId.Address(), trustline.Asset.ToAsset().StringCanonical())
+	case xdr.LedgerEntryTypeContractData:
+		contractData := key.MustContractData()
+		contractID, _ := contractData.Contract.String()
+		return fmt.Sprintf("CONTRACT_DATA:%s:%s", contractID, contractData.Durability.String())
+	case xdr.LedgerEntryTypeContractCode:
+		hash := key.MustContractCode().Hash
+		return "CONTRACT_CODE:" + hex.EncodeToString(hash[:])
+	default:
+		encoded, _ := xdr.MarshalBase64(key)
+		return key.Type.String() + ":" + encoded
+	}
+}
@@ -0,0 +1,121 @@
+package txdecode
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+
+	"github.com/stellar/go-stellar-sdk/amount"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// ScValJSON converts a contract value to plain JSON: numbers that fit a float64 without
+// loss (32 bits) as numbers and wider ones as strings, bytes as hex, addresses as strkeys,
+// maps with symbol or string keys as objects. Values without a natural JSON form are kept
+// as {"type", "xdr"}.
+func ScValJSON(val xdr.ScVal) any {
+	switch val.Type {
+	case xdr.ScValTypeScvBool:
+		return val.MustB()
+	case xdr.ScValTypeScvVoid:
+		return nil
+	case xdr.ScValTypeScvU32:
+		return uint32(val.MustU32())
+	case xdr.ScValTypeScvI32:
+		return int32(val.MustI32())
+	case xdr.ScValTypeScvU64:
+		return strconv.FormatUint(uint64(val.MustU64()), 10)
+	case xdr.ScValTypeScvI64:
+		return strconv.FormatInt(int64(val.MustI64()), 10)
+	case xdr.ScValTypeScvTimepoint:
+		return strconv.FormatUint(uint64(val.MustTimepoint()), 10)
+	case xdr.ScValTypeScvDuration:
+		return strconv.FormatUint(uint64(val.MustDuration()), 10)
+	case xdr.ScValTypeScvU128:
+		parts := val.MustU128()
+		return wideInt(new(big.Int).SetUint64(uint64(parts.Hi)), uint64(parts.Lo)).String()
+	case xdr.ScValTypeScvI128:
+		return amount.String128Raw(val.MustI128())
+	case xdr.ScValTypeScvU256:
+		parts := val.MustU256()
+		value := wideInt(new(big.Int).SetUint64(uint64(parts.HiHi)), uint64(parts.HiLo))
+		return wideInt(wideInt(value, uint64(parts.LoHi)), uint64(parts.LoLo)).String()
+	case xdr.ScValTypeScvI256:
+		parts := val.MustI256()
+		value := wideInt(big.NewInt(int64(parts.HiHi)), uint64(parts.HiLo))
+		return wideInt(wideInt(value, uint64(parts.LoHi)), uint64(parts.LoLo)).String()
+	case xdr.ScValTypeScvBytes:
+		return hex.EncodeToString(val.MustBytes())
+	case xdr.ScValTypeScvString:
+		return string(val.MustStr())
+	case xdr.ScValTypeScvSymbol:
+		return string(val.MustSym())
+	case xdr.ScValTypeScvAddress:
+		if address, err := val.MustAddress().String(); err == nil {
+			return address
+		}
+	case xdr.ScValTypeScvVec:
+		vec := val.MustVec()
+		values := make([]any, 0)
+		if vec != nil {
+			for _, item := range *vec {
+				values = append(values, ScValJSON(item))
+			}
+		}
+		return values
+	case xdr.ScValTypeScvMap:
+		return scMapJSON(val.MustMap())
+	}
+	return rawScVal(val)
+}
+
+// wideInt returns high << 64 + low.
+func wideInt(high *big.Int, low uint64) *big.Int {
+	return high.Lsh(high, 64).Add(high, new(big.Int).SetUint64(low))
+}
+
+func scMapJSON(m *xdr.ScMap) any {
+	if m == nil {
+		return map[string]any{}
+	}
+
+	object := make(map[string]any, len(*m))
+	for _, entry := range *m {
+		var key string
+		switch entry.Key.Type {
+		case xdr.ScValTypeScvSymbol:
+			key = string(entry.Key.MustSym())
+		case xdr.ScValTypeScvString:
+			key = string(entry.Key.MustStr())
+		default:
+			return scMapEntries(*m)
+		}
+		object[key] = ScValJSON(entry.Val)
+	}
+	return object
+}
+
+// scMapEntries keeps maps with non textual keys as a list of key/value pairs.
+func scMapEntries(m xdr.ScMap) []map[string]any {
+	entries := make([]map[string]any, 0, len(m))
+	for _, entry := range m {
+		entries = append(entries, map[string]any{"key": ScValJSON(entry.Key), "value": ScValJSON(entry.Val)})
+	}
+	return entries
+}
+
+func rawScVal(val xdr.ScVal) map[string]any {
+	raw := map[string]any{"type": val.Type.String()}
+	if encoded, err := xdr.MarshalBase64(val); err == nil {
+		raw["xdr"] = encoded
+	}
+	return raw
+}
+
+func scValsJSON(vals []xdr.ScVal) []any {
+	values := make([]any, 0, len(vals))
+	for _, val := range vals {
+		values = append(values, ScValJSON(val))
+	}
+	return values
+}